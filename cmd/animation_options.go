@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// animationFlagNames sadece videodan gif/webp üretiminde geçerli flag'lerdir.
+var animationFlagNames = []string{"fps", "colors", "dither", "start", "duration", "loop", "max-size"}
+
+// animationFlagValues convert/batch komutlarının animasyon flag değerlerini taşır.
+type animationFlagValues struct {
+	FPS      int
+	Colors   int
+	Dither   string
+	Start    string
+	Duration string
+	Loop     int
+	MaxSize  string
+}
+
+// resolveAnimationSpec kaynak/hedef çiftine göre animasyon ayarlarını doğrular ve spec üretir.
+// Hedef videodan gif/webp değilse flag verilmişse hata, verilmemişse nil döner.
+func resolveAnimationSpec(cmd *cobra.Command, values animationFlagValues, from string, to string) (*converter.AnimationSpec, error) {
+	if !converter.IsAnimatedTarget(from, to) {
+		if anyFlagChanged(cmd, animationFlagNames...) {
+			return nil, fmt.Errorf("--fps, --colors, --dither, --start, --duration, --loop ve --max-size sadece videodan gif/webp üretiminde kullanılabilir")
+		}
+		return nil, nil
+	}
+
+	var start, duration float64
+	var err error
+	if strings.TrimSpace(values.Start) != "" {
+		start, err = parseVideoTrimToSeconds(strings.ReplaceAll(values.Start, ",", "."))
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz --start: %w", err)
+		}
+	}
+	if strings.TrimSpace(values.Duration) != "" {
+		duration, err = parseVideoTrimToSeconds(strings.ReplaceAll(values.Duration, ",", "."))
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz --duration: %w", err)
+		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("--duration sıfırdan büyük olmalı")
+		}
+	}
+	var maxSize int64
+	if strings.TrimSpace(values.MaxSize) != "" {
+		maxSize, err = converter.ParseSize(values.MaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz --max-size: %w", err)
+		}
+	}
+
+	spec, err := converter.BuildAnimationSpec(values.FPS, values.Colors, values.Dither, start, duration, values.Loop, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := converter.ValidateAnimationSpec(spec, to); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func describeAnimationSpec(spec *converter.AnimationSpec) string {
+	if spec.IsZero() {
+		return ""
+	}
+	parts := make([]string, 0, 6)
+	if spec.FPS > 0 {
+		parts = append(parts, fmt.Sprintf("fps=%d", spec.FPS))
+	}
+	if spec.Colors > 0 {
+		parts = append(parts, fmt.Sprintf("renk=%d", spec.Colors))
+	}
+	if spec.Dither != "" {
+		parts = append(parts, "dither="+spec.Dither)
+	}
+	if spec.Start > 0 || spec.Duration > 0 {
+		parts = append(parts, fmt.Sprintf("aralık=%.2fs+%.2fs", spec.Start, spec.Duration))
+	}
+	if spec.Loop > 0 {
+		parts = append(parts, fmt.Sprintf("döngü=%d", spec.Loop))
+	}
+	if spec.MaxSize > 0 {
+		parts = append(parts, "maks="+formatFileSize(spec.MaxSize))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// addAnimationFlags gif/webp animasyon flag'lerini komuta ekler.
+func addAnimationFlags(cmd *cobra.Command, values *animationFlagValues) {
+	cmd.Flags().IntVar(&values.FPS, "fps", 0, "GIF/WebP kare hızı (varsayılan: kaliteye göre)")
+	cmd.Flags().IntVar(&values.Colors, "colors", 0, "GIF palet renk sayısı (2-256)")
+	cmd.Flags().StringVar(&values.Dither, "dither", "", "GIF dithering: none, bayer, floyd_steinberg, sierra2, sierra2_4a")
+	cmd.Flags().StringVar(&values.Start, "start", "", "GIF/WebP için başlangıç zamanı (ör: 12, 00:01:05)")
+	cmd.Flags().StringVar(&values.Duration, "duration", "", "GIF/WebP için süre (ör: 4.5, 00:00:06)")
+	cmd.Flags().IntVar(&values.Loop, "loop", 0, "Döngü sayısı (0=sonsuz, 1=bir kez oynat)")
+	cmd.Flags().StringVar(&values.MaxSize, "max-size", "", "GIF/WebP için maksimum boyut; aşılırsa fps/genişlik/renk düşürülür (ör: 5mb)")
+}
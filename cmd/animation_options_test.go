@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newAnimationFlagTestCommand(values *animationFlagValues) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	addAnimationFlags(cmd, values)
+	return cmd
+}
+
+func TestResolveAnimationSpec(t *testing.T) {
+	var values animationFlagValues
+	cmd := newAnimationFlagTestCommand(&values)
+	for name, value := range map[string]string{"start": "00:01:05", "duration": "4,5", "max-size": "5mb", "dither": "bayer"} {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("set flag failed: %v", err)
+		}
+	}
+
+	spec, err := resolveAnimationSpec(cmd, values, "mp4", "gif")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Start != 65 || spec.Duration != 4.5 || spec.MaxSize != 5*1024*1024 || spec.Dither != "bayer" {
+		t.Fatalf("unexpected spec: %#v", spec)
+	}
+
+	if _, err := resolveAnimationSpec(cmd, values, "mp4", "webp"); err == nil {
+		t.Fatalf("expected --dither to be rejected for webp")
+	}
+}
+
+func TestResolveAnimationSpecRejectsNonAnimatedTargets(t *testing.T) {
+	var values animationFlagValues
+	cmd := newAnimationFlagTestCommand(&values)
+	spec, err := resolveAnimationSpec(cmd, values, "png", "webp")
+	if err != nil || spec != nil {
+		t.Fatalf("expected no spec for image source without flags, got %#v err=%v", spec, err)
+	}
+
+	if err := cmd.Flags().Set("fps", "10"); err != nil {
+		t.Fatalf("set flag failed: %v", err)
+	}
+	if _, err := resolveAnimationSpec(cmd, values, "mp4", "mp4"); err == nil {
+		t.Fatalf("expected --fps to be rejected for video target")
+	}
+}
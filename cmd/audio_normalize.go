@@ -31,17 +31,19 @@ var audioCmd = &cobra.Command{
 }
 
 var audioNormalizeCmd = &cobra.Command{
-	Use:   "normalize <ses-dosyası>",
+	Use:   "normalize <ses/video-dosyası>",
 	Short: "Ses dosyasının ses seviyesini normalize eder",
 	Long: `Ses dosyasının ses seviyesini EBU R128 standardına göre normalize eder.
 FFmpeg loudnorm filtresi kullanarak hedef LUFS, True Peak ve LRA değerlerine
-göre ses seviyesini ayarlar.
+göre ses seviyesini ayarlar. Video dosyalarında görüntü kopyalanır, gömülü ses izi
+ayrıca çıkarmaya gerek kalmadan normalize edilir.
 
 Örnekler:
   fileconverter-cli audio normalize podcast.mp3
   fileconverter-cli audio normalize song.wav --to mp3
   fileconverter-cli audio normalize voice.ogg --target-lufs -16
-  fileconverter-cli audio normalize music.flac --target-lufs -14 --target-tp -1 --target-lra 9`,
+  fileconverter-cli audio normalize music.flac --target-lufs -14 --target-tp -1 --target-lra 9
+  fileconverter-cli audio normalize ders.mp4 --target-lufs -16`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		input := args[0]
@@ -164,17 +166,33 @@ func runAudioNormalizeFFmpeg(input string, output string, targetFormat string, l
 		return fmt.Errorf("ffmpeg bulunamadi")
 	}
 
+	args := buildAudioNormalizeArgs(input, output, targetFormat, loudnormFilter(lufs, tp, lra), metadataMode, verbose)
+	return runFFmpegCommand(ffmpegPath, args, "ses normalize ffmpeg hatasi")
+}
+
+// buildAudioNormalizeArgs normalize komutunu kurar. Video hedeflerinde görüntü ve altyazı
+// kopyalanır, sadece gömülü ses izi yeniden encode edilir.
+func buildAudioNormalizeArgs(input string, output string, targetFormat string, filter string, metadataMode string, verbose bool) []string {
 	args := []string{}
 	if !verbose {
 		args = append(args, "-loglevel", "error")
 	}
 	args = append(args, "-i", input, "-y")
 
-	filter := fmt.Sprintf("loudnorm=I=%.1f:TP=%.1f:LRA=%.1f", lufs, tp, lra)
-	args = append(args, "-af", filter)
-	args = append(args, normalizeAudioCodecArgs(targetFormat)...)
+	if converter.IsVideoFormat(targetFormat) {
+		args = append(args, "-map", "0:v?", "-map", "0:a", "-map", "0:s?", "-c:v", "copy", "-c:s", "copy")
+		args = append(args, "-af", filter)
+		args = append(args, converter.VideoAudioTrackArgs(targetFormat, nil)...)
+	} else {
+		args = append(args, "-af", filter)
+		args = append(args, normalizeAudioCodecArgs(targetFormat)...)
+	}
 	args = append(args, converter.MetadataFFmpegArgs(metadataMode)...)
 	args = append(args, output)
+	return args
+}
 
-	return runFFmpegCommand(ffmpegPath, args, "ses normalize ffmpeg hatasi")
+// loudnormFilter EBU R128 hedefleri için loudnorm filtre ifadesini üretir.
+func loudnormFilter(lufs float64, tp float64, lra float64) string {
+	return fmt.Sprintf("loudnorm=I=%.1f:TP=%.1f:LRA=%.1f", lufs, tp, lra)
 }
@@ -1,6 +1,9 @@
 package cmd
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestBuildNormalizeOutputPath(t *testing.T) {
 	outputDir = ""
@@ -75,3 +78,17 @@ func TestNormalizeDefaultValues(t *testing.T) {
 		t.Fatalf("expected default LRA 11, got %f", lra)
 	}
 }
+
+func TestBuildAudioNormalizeArgsForVideo(t *testing.T) {
+	args := strings.Join(buildAudioNormalizeArgs("ders.mp4", "out.mp4", "mp4", loudnormFilter(-16, -1.5, 11), "", false), " ")
+	for _, want := range []string{"-c:v copy", "-map 0:a", "-af loudnorm=I=-16.0:TP=-1.5:LRA=11.0", "-c:a aac"} {
+		if !strings.Contains(args, want) {
+			t.Fatalf("video normalize args missing %q: %s", want, args)
+		}
+	}
+
+	args = strings.Join(buildAudioNormalizeArgs("ses.mp3", "out.mp3", "mp3", loudnormFilter(-14, -1.5, 11), "", false), " ")
+	if strings.Contains(args, "-c:v") || !strings.Contains(args, "libmp3lame") {
+		t.Fatalf("unexpected audio normalize args: %s", args)
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/profile"
+)
+
+func anyFlagChanged(cmd *cobra.Command, names ...string) bool {
+	for _, name := range names {
+		if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+			return true
+		}
+	}
+	return false
+}
+
+func applyProfileBitrateMode(cmd *cobra.Command, p profile.Definition, vbrValue *bool, cbrValue *bool) {
+	if p.BitrateMode == "" {
+		return
+	}
+	if cmd.Flags().Changed("vbr") || cmd.Flags().Changed("cbr") {
+		return
+	}
+	switch p.BitrateMode {
+	case converter.AudioBitrateModeVBR:
+		*vbrValue = true
+		*cbrValue = false
+	case converter.AudioBitrateModeCBR:
+		*cbrValue = true
+		*vbrValue = false
+	}
+}
+
+func describeAudioSpec(spec *converter.AudioSpec) string {
+	if spec == nil {
+		return ""
+	}
+	desc := ""
+	add := func(label string, value string) {
+		if desc != "" {
+			desc += ", "
+		}
+		desc += label + "=" + value
+	}
+	if spec.Codec != "" {
+		add("codec", spec.Codec)
+	}
+	if spec.SampleRate > 0 {
+		add("sample-rate", fmt.Sprintf("%d Hz", spec.SampleRate))
+	}
+	if spec.BitDepth != "" {
+		add("bit-depth", spec.BitDepth)
+	}
+	if spec.Channels > 0 {
+		add("channels", fmt.Sprintf("%d", spec.Channels))
+	}
+	if spec.Bitrate != "" {
+		add("bitrate", spec.Bitrate)
+	}
+	if spec.BitrateMode != "" {
+		add("mod", spec.BitrateMode)
+	}
+	return desc
+}
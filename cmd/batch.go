@@ -36,6 +36,33 @@ var (
 	batchUnit         string
 	batchResizeDPI    float64
 	batchResizeMode   string
+	batchTargetSize   string
+	batchSampleRate   int
+	batchBitDepth     string
+	batchChannels     int
+	batchBitrate      string
+	batchVBR          bool
+	batchCBR          bool
+	batchAudioCodec   string
+	batchAudioBR      string
+	batchVideoCodec   string
+	batchCRF          int
+	batchEncPreset    string
+	batchPixFmt       string
+	batchVideoBR      string
+	batchAnimation    animationFlagValues
+	batchTransform    transformFlagValues
+	batchFilter       filterFlagValues
+	batchSchedule     scheduleFlagValues
+	batchSource       sourceFlagValues
+	batchVerify       verifyFlagValues
+	batchNameTmpl     string
+	batchTargetOpts   []string
+	batchRules        string
+	batchIncremental  bool
+	batchForce        bool
+	batchCacheDir     string
+	batchJournal      string
 )
 
 var batchCmd = &cobra.Command{
@@ -55,7 +82,28 @@ Worker pool kullanarak paralel dönüşüm yapar.
   fileconverter-cli batch ./videolar --from mp4 --to mp4 --preset story --resize-mode pad
   fileconverter-cli batch ./fotograflar --from webp --to png --width 10 --height 15 --unit cm --dpi 300
   fileconverter-cli batch ./resimler --from jpg --to png --on-conflict versioned --retry 2 --report json --report-file ./reports/batch.json
-  fileconverter-cli batch ./videolar --from mov --to mp4 --profile archive-lossless --preserve-metadata`,
+  fileconverter-cli batch ./videolar --from mov --to mp4 --profile archive-lossless --preserve-metadata
+  fileconverter-cli batch ./kayitlar --from wav --to mp3 --sample-rate 44100 --channels 1 --bitrate 96k
+  fileconverter-cli batch ./videolar --from mov --to mp4 --video-codec h265 --crf 28 --audio-codec aac --audio-bitrate 128k
+  fileconverter-cli batch ./klipler --from mp4 --to mp4 --target-size 25mb
+  fileconverter-cli batch ./klipler --from mp4 --to gif --duration 5 --fps 12 --max-size 4mb
+  fileconverter-cli batch ./fotograflar --from png --to jpg,webp --target-opts jpg:quality=85 --target-opts webp:quality=70
+  fileconverter-cli batch ./kayitlar --from wav --to mp3,ogg --target-opts mp3:bitrate=192k --target-opts ogg:bitrate=128k
+  fileconverter-cli batch ./inbox --from auto --to pdf
+  fileconverter-cli batch ./inbox --from auto --rules ./inbox-rules.json --recursive --report txt
+  fileconverter-cli batch ./belgeler --from md --to pdf --report junit --report-file ./reports/batch.xml
+  fileconverter-cli batch ./fotograflar --from png --to webp --report html --report-file ./reports/batch.html
+  fileconverter-cli batch "./arsiv/**/*.png" --to webp --exclude "**/raw/**" --modified-since 7d
+  fileconverter-cli batch ./videolar --from mp4 --to mp3 -r --min-duration 60 --max-file-size 2gb
+  fileconverter-cli batch ./fotograflar --from jpg --to webp -o ./cikti --name-template "{date:2006-01}/{index:03}_{name}.{ext}"
+  fileconverter-cli batch ./kutuphane --from jpg --to webp -r -o ./webp --preserve-tree --incremental
+  fileconverter-cli batch ./kutuphane --from jpg --to webp -r -o ./webp --preserve-tree --incremental --force
+  fileconverter-cli batch ./videolar --from mov --to mp4 --journal ./batch.journal
+  fileconverter-cli batch resume ./batch.journal
+  fileconverter-cli batch ./videolar --from mov --to mp4 -w 8 --max-concurrent video=2 --order largest-first
+  fileconverter-cli batch ./raw --from png --to webp -w 32 --memory-budget 6gb --priority low
+  fileconverter-cli batch ./inbox --from png --to webp -r --on-success move:./arsiv --on-failure move:./hatali --manifest ./manifest.json
+  fileconverter-cli batch ./videolar --from mov --to mp4 --verify --verify-tolerance 500ms --retry 2`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		source := args[0]
@@ -67,10 +115,12 @@ Worker pool kullanarak paralel dönüşüm yapar.
 		applyRetryDefaults(cmd, "retry", &batchRetry, "retry-delay", &batchRetryDelay)
 		applyReportDefault(cmd, "report", &batchReport)
 
+		var profileWatermark *converter.WatermarkSpec
 		if p, ok, err := resolveProfile(batchProfile); err != nil {
 			ui.PrintError(err.Error())
 			return err
 		} else if ok {
+			profileWatermark = p.Watermark
 			applyProfileToBatch(cmd, p)
 			applyProfileMetadata(cmd, p, "preserve-metadata", &batchPreserveMD, "strip-metadata", &batchStripMD)
 		}
@@ -87,6 +137,11 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			ui.PrintError(err.Error())
 			return err
 		}
+		if batchForce && !batchIncremental {
+			err := fmt.Errorf("--force sadece --incremental ile kullanılabilir")
+			ui.PrintError(err.Error())
+			return err
+		}
 		reportFormat := batch.NormalizeReportFormat(batchReport)
 		if reportFormat == "" {
 			err := fmt.Errorf("gecersiz report formati: %s", batchReport)
@@ -94,19 +149,55 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			return err
 		}
 
-		// Hedef format kontrolü
-		targetFormat := converter.NormalizeFormat(batchTo)
-		if targetFormat == "" {
-			ui.PrintError("Hedef format belirtilmedi. --to <format> kullanın.")
-			return fmt.Errorf("hedef format belirtilmedi")
-		}
-
 		// Kaynak format kontrolü
 		fromFormat := converter.NormalizeFormat(batchFrom)
 		if fromFormat == "" {
-			ui.PrintError("Kaynak format belirtilmedi. --from <format> kullanın.")
+			ui.PrintError("Kaynak format belirtilmedi. --from <format> veya --from auto kullanın.")
 			return fmt.Errorf("kaynak format belirtilmedi")
 		}
+		autoMode := fromFormat == batchFromAuto
+		var rules *batch.Rules
+		if strings.TrimSpace(batchRules) != "" {
+			if !autoMode {
+				err := fmt.Errorf("--rules sadece --from auto ile kullanılabilir")
+				ui.PrintError(err.Error())
+				return err
+			}
+			loaded, err := batch.LoadRules(batchRules)
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("Kurallar dosyası okunamadı: %s", err.Error()))
+				return err
+			}
+			rules = &loaded
+		}
+
+		// Hedef format kontrolü
+		var targetFormats []string
+		if strings.TrimSpace(batchTo) != "" {
+			targetFormats, err = parseBatchTargetFormats(batchTo)
+			if err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+		} else if rules == nil {
+			ui.PrintError("Hedef format belirtilmedi. --to <format> veya --rules <dosya> kullanın.")
+			return fmt.Errorf("hedef format belirtilmedi")
+		}
+		targetLabel := strings.Join(targetFormats, ",")
+		if targetLabel == "" {
+			targetLabel = "rules"
+		}
+		// Otomatik modda dosyalar farklı hedeflere gidebildiği için mesajlar çıktı yolunu da gösterir.
+		multiTarget := len(targetFormats) > 1 || autoMode
+		knownFormats := targetFormats
+		if autoMode {
+			knownFormats = batchAutoKnownFormats(targetFormats, rules)
+		}
+		targetBlocks, err := parseBatchTargetBlocks(batchTargetOpts, knownFormats)
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Hedef ayarları hatalı: %s", err.Error()))
+			return err
+		}
 
 		resizeSpec, err := converter.BuildResizeSpec(
 			batchPreset,
@@ -120,27 +211,103 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			ui.PrintError(fmt.Sprintf("Boyutlandırma parametreleri hatalı: %s", err.Error()))
 			return err
 		}
-		if resizeSpec != nil && !converter.IsResizableFormat(fromFormat) {
+		if resizeSpec != nil && !autoMode && !converter.IsResizableFormat(fromFormat) {
 			err := fmt.Errorf("boyutlandırma sadece görsel ve video dosyalarında kullanılabilir")
 			ui.PrintError(err.Error())
 			return err
 		}
-		// Aynı format, resize yoksa no-op
-		if fromFormat == targetFormat && resizeSpec == nil {
-			if jsonOutput {
-				return printJSON(map[string]interface{}{
-					"status": "skipped",
-					"reason": "same_format",
-					"from":   fromFormat,
-					"to":     targetFormat,
-				})
+
+		baseValues := batchTargetValues{
+			Quality:    batchQuality,
+			TargetSize: batchTargetSize,
+			Encode: encodeFlagValues{
+				SampleRate:   batchSampleRate,
+				BitDepth:     batchBitDepth,
+				Channels:     batchChannels,
+				Bitrate:      batchBitrate,
+				VBR:          batchVBR,
+				CBR:          batchCBR,
+				AudioCodec:   batchAudioCodec,
+				AudioBitrate: batchAudioBR,
+				VideoCodec:   batchVideoCodec,
+				CRF:          batchCRF,
+				EncodePreset: batchEncPreset,
+				PixFmt:       batchPixFmt,
+				VideoBitrate: batchVideoBR,
+			},
+		}
+		baseOptions := converter.Options{
+			Verbose:      verbose,
+			Resize:       resizeSpec,
+			MetadataMode: metadataMode,
+		}
+		targets := make([]batchTarget, 0, len(targetFormats))
+		router := &batchRouter{
+			cmd:              cmd,
+			rules:            rules,
+			defaultFormats:   targetFormats,
+			blocks:           targetBlocks,
+			baseValues:       baseValues,
+			baseOptions:      baseOptions,
+			profileWatermark: profileWatermark,
+			quiet:            jsonOutput,
+		}
+		if !autoMode {
+			for _, format := range targetFormats {
+				values := baseValues
+				for _, kv := range targetBlocks[format] {
+					if err := applyBatchTargetOption(&values, kv[0], kv[1]); err != nil {
+						ui.PrintError(fmt.Sprintf("%sHedef ayarları hatalı: %s", batchTargetLabel(format, multiTarget), err.Error()))
+						return err
+					}
+				}
+				target, ok, err := resolveBatchTarget(cmd, fromFormat, format, values, baseOptions, profileWatermark, jsonOutput)
+				if err != nil {
+					ui.PrintError(batchTargetLabel(format, multiTarget) + err.Error())
+					return err
+				}
+				if !ok {
+					if multiTarget && !jsonOutput {
+						ui.PrintWarning(fmt.Sprintf("Kaynak ve hedef format aynı (%s), bu hedef atlanıyor.", format))
+					}
+					continue
+				}
+				targets = append(targets, target)
 			}
-			ui.PrintWarning("Kaynak ve hedef format aynı, dönüşüm gerekli değil.")
-			return nil
+			// Aynı format, resize yoksa no-op
+			if len(targets) == 0 {
+				if jsonOutput {
+					return printJSON(map[string]interface{}{
+						"status": "skipped",
+						"reason": "same_format",
+						"from":   fromFormat,
+						"to":     targetLabel,
+					})
+				}
+				ui.PrintWarning("Kaynak ve hedef format aynı, dönüşüm gerekli değil.")
+				return nil
+			}
+		}
+
+		nameTmpl, err := parseNameTemplateFlag(batchNameTmpl, "", "")
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
 		}
 
-		// Dönüşüm desteği kontrolü
-		_, err = converter.FindConverter(fromFormat, targetFormat)
+		fileFilter, err := buildFileFilter(batchFilter)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		schedule, err := buildSchedule(batchSchedule)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		verifyOpts, err := buildVerifyOptions(batchVerify)
 		if err != nil {
 			ui.PrintError(err.Error())
 			return err
@@ -153,14 +320,18 @@ Worker pool kullanarak paralel dönüşüm yapar.
 		if statErr == nil && info.IsDir() {
 			// Dizin modu
 			sourceRoot = source
-			files, err = batch.CollectFiles(source, fromFormat, batchRecursive)
+			collectFormat := fromFormat
+			if autoMode {
+				collectFormat = ""
+			}
+			files, err = batch.CollectFiles(source, collectFormat, batchRecursive, fileFilter)
 			if err != nil {
 				ui.PrintError(fmt.Sprintf("Dizin taranamadı: %s", err.Error()))
 				return err
 			}
 		} else {
 			// Glob pattern modu
-			files, err = batch.CollectFilesFromGlob(source)
+			files, err = batch.CollectFilesFromGlob(source, fileFilter)
 			if err != nil {
 				ui.PrintError(fmt.Sprintf("Glob pattern hatası: %s", err.Error()))
 				return err
@@ -168,22 +339,45 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			// Sadece doğru uzantıya sahip dosyaları filtrele
 			var filtered []string
 			for _, f := range files {
-				if converter.HasFormatExtension(f, fromFormat) {
+				if autoMode || converter.HasFormatExtension(f, fromFormat) {
 					filtered = append(filtered, f)
 				}
 			}
 			files = filtered
 		}
 
+		files = filterJournalInputs(files, batchJournalState)
+
+		sourceHandlerRoot := sourceRoot
+		if sourceHandlerRoot == "" {
+			sourceHandlerRoot = globRoot(source)
+		}
+		sourceHandler, err := buildSourceHandler(batchSource, sourceHandlerRoot)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		if !autoMode {
+			// Otomatik modda aynı formata dönüşüm same_format sebebiyle atlanır
+			if err := checkInPlaceSourceAction(sourceHandler, conflictPolicy, fromFormat, targetFormats); err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+		}
+
 		if len(files) == 0 {
 			if jsonOutput {
 				return printJSON(map[string]interface{}{
 					"status": "empty",
 					"count":  0,
 					"from":   fromFormat,
-					"to":     targetFormat,
+					"to":     targetLabel,
 				})
 			}
+			if autoMode {
+				ui.PrintWarning("Dosya bulunamadı.")
+				return nil
+			}
 			ui.PrintWarning(fmt.Sprintf("'%s' formatında dosya bulunamadı.", converter.FormatFilterLabel(fromFormat)))
 			return nil
 		}
@@ -200,9 +394,35 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			}
 		}
 
+		var incremental *incrementalCache
+		if batchIncremental {
+			dir, err := resolveCacheDir(batchCacheDir)
+			if err == nil {
+				incremental, err = openBatchIncremental(dir, batchForce)
+			}
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("Önbellek açılamadı: %s", err.Error()))
+				return err
+			}
+			if !jsonOutput {
+				if batchForce {
+					ui.PrintInfo(fmt.Sprintf("Artımlı mod (--force): tüm dosyalar yeniden dönüştürülüp önbellek güncellenecek (%s)", incremental.store.Path()))
+				} else {
+					ui.PrintInfo(fmt.Sprintf("Artımlı mod: değişmemiş girdiler önbellekten atlanacak (%s)", incremental.store.Path()))
+				}
+			}
+		}
+
 		// Dosya bilgisi
 		if !jsonOutput {
-			ui.PrintInfo(fmt.Sprintf("%d adet .%s dosyası bulundu", len(files), converter.FormatFilterLabel(fromFormat)))
+			if autoMode {
+				ui.PrintInfo(fmt.Sprintf("%d dosya bulundu (formatlar içerikten tespit edilecek)", len(files)))
+			} else {
+				ui.PrintInfo(fmt.Sprintf("%d adet .%s dosyası bulundu", len(files), converter.FormatFilterLabel(fromFormat)))
+			}
+		}
+		if len(targets) > 1 && !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("Hedefler: %s (dosya başına %d çıktı)", strings.Join(batchTargetFormats(targets), ", "), len(targets)))
 		}
 		if resizeSpec != nil && !jsonOutput {
 			source := "manuel"
@@ -211,6 +431,24 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			}
 			ui.PrintInfo(fmt.Sprintf("Boyutlandırma: %dx%d (%s, mod: %s)", resizeSpec.Width, resizeSpec.Height, source, resizeSpec.Mode))
 		}
+		if !jsonOutput {
+			for _, target := range targets {
+				label := batchTargetLabel(target.Format, multiTarget)
+				opts := target.Options
+				if opts.Transform != nil {
+					ui.PrintInfo(fmt.Sprintf("%sKırpma/döndürme: %s", label, converter.DescribeTransformSpec(opts.Transform)))
+				}
+				if opts.Audio != nil {
+					ui.PrintInfo(fmt.Sprintf("%sSes ayarları: %s", label, describeAudioSpec(opts.Audio)))
+				}
+				if opts.Video != nil {
+					ui.PrintInfo(fmt.Sprintf("%sVideo ayarları: %s", label, describeVideoSpec(opts.Video)))
+				}
+				if opts.Animation != nil {
+					ui.PrintInfo(fmt.Sprintf("%sAnimasyon ayarları: %s", label, describeAnimationSpec(opts.Animation)))
+				}
+			}
+		}
 
 		if verbose && !jsonOutput {
 			for _, f := range files {
@@ -221,44 +459,35 @@ Worker pool kullanarak paralel dönüşüm yapar.
 
 		// İşleri oluştur
 		jobs := make([]batch.Job, 0, len(files))
-		reserved := make(map[string]struct{}, len(files))
-		for _, f := range files {
-			baseOutput := buildBatchOutputPath(f, sourceRoot, targetFormat)
-			if hasResumeSuccess(resumeSuccessSet, f) {
-				jobs = append(jobs, batch.Job{
-					InputPath:  f,
-					OutputPath: baseOutput,
-					From:       fromFormat,
-					To:         targetFormat,
-					SkipReason: "resume_success",
-					Options: converter.Options{
-						Quality:      batchQuality,
-						Verbose:      verbose,
-						Resize:       resizeSpec,
-						MetadataMode: metadataMode,
-					},
-				})
-				continue
+		reserved := make(map[string]struct{}, len(files)*max(len(targets), 1))
+		fileFormats := make([]string, 0, len(files))
+		for i, f := range files {
+			sourceFormat, fileTargets := fromFormat, targets
+			if autoMode {
+				sourceFormat = converter.DetectFormat(f)
+				fileFormats = append(fileFormats, sourceFormat)
+				route, err := router.Route(sourceFormat)
+				if err != nil {
+					ui.PrintError(err.Error())
+					return err
+				}
+				if route.SkipReason != "" {
+					jobs = append(jobs, batch.Job{InputPath: f, From: sourceFormat, SkipReason: route.SkipReason})
+					continue
+				}
+				fileTargets = route.Targets
 			}
-			resolvedOutput, skipReason, err := resolveBatchOutputPath(baseOutput, conflictPolicy, reserved)
+			job, err := buildBatchJob(f, sourceRoot, sourceFormat, fileTargets, conflictPolicy, reserved, hasResumeSuccess(resumeSuccessSet, f), batchNaming{Template: nameTmpl, Index: i + 1}, incremental)
 			if err != nil {
 				ui.PrintError(err.Error())
 				return err
 			}
-			jobs = append(jobs, batch.Job{
-				InputPath:  f,
-				OutputPath: resolvedOutput,
-				From:       fromFormat,
-				To:         targetFormat,
-				SkipReason: skipReason,
-				Options: converter.Options{
-					Quality:      batchQuality,
-					Verbose:      verbose,
-					Resize:       resizeSpec,
-					MetadataMode: metadataMode,
-				},
-			})
+			jobs = append(jobs, job)
 		}
+		if autoMode && !jsonOutput {
+			printBatchRoutes(fileFormats, router.cache)
+		}
+		applyBatchJournalState(jobs, batchJournalState)
 
 		// Dry-run modu
 		if batchDryRun {
@@ -267,17 +496,24 @@ Worker pool kullanarak paralel dönüşüm yapar.
 				fmt.Println()
 			}
 			skipped := 0
-			items := make([]map[string]string, 0, len(jobs))
-			for _, job := range jobs {
+			items := make([]map[string]string, 0, len(jobs)*len(targets))
+			planned := 0
+			for _, job := range expandBatchJobs(jobs) {
+				planned++
 				if job.SkipReason != "" {
 					skipped++
 					if !jsonOutput {
-						ui.PrintWarning(fmt.Sprintf("Atlanacak: %s (sebep: %s)", job.InputPath, job.SkipReason))
+						if multiTarget && job.OutputPath != "" {
+							ui.PrintWarning(fmt.Sprintf("Atlanacak: %s -> %s (sebep: %s)", job.InputPath, job.OutputPath, job.SkipReason))
+						} else {
+							ui.PrintWarning(fmt.Sprintf("Atlanacak: %s (sebep: %s)", job.InputPath, job.SkipReason))
+						}
 					}
 					items = append(items, map[string]string{
 						"status": "skipped",
 						"input":  job.InputPath,
 						"output": job.OutputPath,
+						"format": job.To,
 						"reason": job.SkipReason,
 					})
 					continue
@@ -285,22 +521,42 @@ Worker pool kullanarak paralel dönüşüm yapar.
 				if !jsonOutput {
 					ui.PrintConversion(job.InputPath, job.OutputPath)
 				}
-				items = append(items, map[string]string{
+				item := map[string]string{
 					"status": "planned",
 					"input":  job.InputPath,
 					"output": job.OutputPath,
-				})
+					"format": job.To,
+				}
+				// Otomatik kırpma planda gösterilir; tespit edilemezse dönüşümde de hata verecektir.
+				if t := job.Options.Transform; t != nil && t.AutoCrop {
+					if resolved, err := converter.ResolveAutoCrop(job.InputPath, t); err != nil {
+						item["crop_error"] = err.Error()
+						if !jsonOutput {
+							ui.PrintWarning(fmt.Sprintf("  Otomatik kırpma tespit edilemedi: %s", err.Error()))
+						}
+					} else {
+						item["crop"] = resolved.Crop.String()
+						if !jsonOutput {
+							ui.PrintInfo(fmt.Sprintf("  Otomatik kırpma: %s", resolved.Crop.String()))
+						}
+					}
+				}
+				items = append(items, item)
 			}
 			if jsonOutput {
 				return printJSON(map[string]interface{}{
 					"mode":    "dry-run",
-					"total":   len(jobs),
+					"total":   planned,
 					"skipped": skipped,
 					"items":   items,
 				})
 			}
 			fmt.Println()
-			ui.PrintInfo(fmt.Sprintf("Toplam %d dosya işlenecek (%d atlanacak).", len(jobs), skipped))
+			if multiTarget {
+				ui.PrintInfo(fmt.Sprintf("Toplam %d dosya için %d çıktı planlandı (%d atlanacak).", len(jobs), planned, skipped))
+			} else {
+				ui.PrintInfo(fmt.Sprintf("Toplam %d dosya işlenecek (%d atlanacak).", len(jobs), skipped))
+			}
 			ui.PrintInfo("Dönüşümü başlatmak için --dry-run flag'ini kaldırın.")
 			return nil
 		}
@@ -308,6 +564,38 @@ Worker pool kullanarak paralel dönüşüm yapar.
 		// Worker pool oluştur
 		pool := batch.NewPool(workers)
 		pool.SetRetry(batchRetry, batchRetryDelay)
+		pool.Schedule = schedule
+		pool.Verify = verifyOpts
+		if desc := describeSchedule(schedule); desc != "" && verbose && !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("Zamanlama: %s", desc))
+		}
+
+		if strings.TrimSpace(batchJournal) != "" {
+			journal, err := openRunJournal(batchJournal, "batch", batchJournalState != nil, cmd)
+			if err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+			defer journal.Close()
+			if err := journal.RecordQueued(jobs); err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+			pool.OnStart = func(job batch.Job) {
+				if err := journal.RecordStarted(job); err != nil {
+					ui.PrintWarning(err.Error())
+				}
+			}
+			pool.OnResult = func(result batch.JobResult) {
+				if err := journal.RecordResult(result); err != nil {
+					ui.PrintWarning(err.Error())
+				}
+			}
+			defer journal.Append(batch.JournalEvent{Type: batch.JournalFinished})
+			if !jsonOutput {
+				ui.PrintInfo(fmt.Sprintf("İş günlüğü: %s", batchJournal))
+			}
+		}
 
 		if !jsonOutput {
 			// Progress bar
@@ -332,10 +620,28 @@ Worker pool kullanarak paralel dönüşüm yapar.
 			ui.PrintBatchSummary(summary.Total, summary.Succeeded, summary.Skipped, summary.Failed, totalDuration)
 		}
 
+		if incremental != nil {
+			stored, err := incremental.record(results)
+			if err != nil {
+				ui.PrintWarning(fmt.Sprintf("Önbellek kaydedilemedi: %s", err.Error()))
+			} else if !jsonOutput {
+				ui.PrintInfo(fmt.Sprintf("Önbellek: %d isabet, %d yeni kayıt", summary.CacheHits, stored))
+			}
+		}
+
+		// Kaynak eylemleri çıktılar doğrulandıktan sonra uygulanır; önbellek kaydı
+		// girdinin bilgilerini okuduğu için ondan sonra çalışmalıdır.
+		var sourceFailures int
+		summary.SourceActions, sourceFailures = applySourceActions(sourceHandler, results, jsonOutput)
+
 		// Hataları göster
 		if len(summary.Errors) > 0 && !jsonOutput {
 			ui.PrintError("Başarısız dönüşümler:")
 			for _, e := range summary.Errors {
+				if multiTarget {
+					fmt.Printf("  %s %s -> %s: %s (deneme: %d)\n", ui.IconError, e.InputFile, e.OutputFile, e.Error, e.Attempts)
+					continue
+				}
 				fmt.Printf("  %s %s: %s (deneme: %d)\n", ui.IconError, e.InputFile, e.Error, e.Attempts)
 			}
 			fmt.Println()
@@ -368,16 +674,22 @@ Worker pool kullanarak paralel dönüşüm yapar.
 		}
 
 		if summary.Failed > 0 {
+			if multiTarget {
+				return fmt.Errorf("%d çıktı üretilemedi", summary.Failed)
+			}
 			return fmt.Errorf("%d dosya dönüştürülemedi", summary.Failed)
 		}
+		if sourceFailures > 0 {
+			return fmt.Errorf("%d kaynak dosya eylemi başarısız", sourceFailures)
+		}
 
 		return nil
 	},
 }
 
 func init() {
-	batchCmd.Flags().StringVarP(&batchTo, "to", "t", "", "Hedef format (zorunlu)")
-	batchCmd.Flags().StringVarP(&batchFrom, "from", "f", "", "Kaynak format (zorunlu)")
+	batchCmd.Flags().StringVarP(&batchTo, "to", "t", "", "Hedef format (zorunlu); virgülle birden fazla verilebilir (ör: jpg,webp)")
+	batchCmd.Flags().StringVarP(&batchFrom, "from", "f", "", "Kaynak format (zorunlu); auto verilirse her dosyanın formatı içerikten tespit edilir")
 	batchCmd.Flags().StringVar(&batchProfile, "profile", "", "Hazır profil (ör: social-story, podcast-clean, archive-lossless)")
 	batchCmd.Flags().BoolVarP(&batchRecursive, "recursive", "r", false, "Alt dizinleri de tara")
 	batchCmd.Flags().BoolVar(&batchPreserveTree, "preserve-tree", false, "Dizin modunda --output altına klasör yapısını koru")
@@ -388,19 +700,45 @@ func init() {
 	batchCmd.Flags().BoolVar(&batchStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
 	batchCmd.Flags().IntVar(&batchRetry, "retry", 0, "Başarısız işler için otomatik tekrar sayısı")
 	batchCmd.Flags().DurationVar(&batchRetryDelay, "retry-delay", 500*time.Millisecond, "Retry denemeleri arası bekleme (örn: 500ms, 2s)")
-	batchCmd.Flags().StringVar(&batchReport, "report", batch.ReportOff, "Rapor formatı: off, txt, json")
+	batchCmd.Flags().StringVar(&batchReport, "report", batch.ReportOff, "Rapor formatı: off, txt, json, csv, junit, markdown, html")
 	batchCmd.Flags().StringVar(&batchReportFile, "report-file", "", "Raporu belirtilen dosyaya yaz")
 	batchCmd.Flags().StringVar(&batchResumeReport, "resume-from-report", "", "Önceki JSON rapordaki başarılı girdileri atlayarak devam et")
+	batchCmd.Flags().BoolVar(&batchIncremental, "incremental", false, "Artımlı mod: içeriği, ayarları ve araç sürümleri değişmemiş girdileri önbellekten atla")
+	batchCmd.Flags().BoolVar(&batchForce, "force", false, "Artımlı modda önbelleği yok sayıp yeniden dönüştür ve önbelleği güncelle")
+	batchCmd.Flags().StringVar(&batchCacheDir, "cache-dir", "", "Artımlı mod önbellek dizini (varsayılan: ~/.fileconverter/cache)")
+	batchCmd.Flags().StringVar(&batchJournal, "journal", "", "İş günlüğü (JSONL); yarıda kalan çalışma 'batch resume <journal>' ile sürdürülür")
 	batchCmd.Flags().StringVar(&batchPreset, "preset", "", "Hazır boyut preset'i (ör: story, square, fullhd, 1080x1920)")
 	batchCmd.Flags().Float64Var(&batchWidth, "width", 0, "Manuel hedef genişlik")
 	batchCmd.Flags().Float64Var(&batchHeight, "height", 0, "Manuel hedef yükseklik")
 	batchCmd.Flags().StringVar(&batchUnit, "unit", "px", "Manuel ölçü birimi: px veya cm")
 	batchCmd.Flags().Float64Var(&batchResizeDPI, "dpi", 96, "Birim cm ise kullanılacak DPI değeri")
 	batchCmd.Flags().StringVar(&batchResizeMode, "resize-mode", "pad", "Boyutlandırma modu: pad, fit, fill, stretch")
+	batchCmd.Flags().StringVar(&batchTargetSize, "target-size", "", "Her çıktı için hedef dosya boyutu (ör: 25mb); jpg, video ve kayıplı ses çıktılarında")
+	batchCmd.Flags().IntVar(&batchSampleRate, "sample-rate", 0, "Ses örnekleme hızı (Hz, ör: 44100, 48000)")
+	batchCmd.Flags().StringVar(&batchBitDepth, "bit-depth", "", "Ses bit derinliği: 16, 24, 32f (sadece wav/flac)")
+	batchCmd.Flags().IntVar(&batchChannels, "channels", 0, "Ses kanal sayısı (1=mono, 2=stereo)")
+	batchCmd.Flags().StringVar(&batchBitrate, "bitrate", "", "Bitrate: ses çıktısında ses, video çıktısında video bitrate'i (ör: 192k, 4M)")
+	batchCmd.Flags().BoolVar(&batchVBR, "vbr", false, "Değişken bitrate (VBR) kullan")
+	batchCmd.Flags().BoolVar(&batchCBR, "cbr", false, "Sabit bitrate (CBR) kullan")
+	batchCmd.Flags().StringVar(&batchAudioCodec, "audio-codec", "", "Ses codec'i (ses çıktısı: libmp3lame, alac vb.; video ses izi: aac, opus, copy, none)")
+	batchCmd.Flags().StringVar(&batchAudioBR, "audio-bitrate", "", "Video çıktısında ses izi bitrate'i (ör: 128k)")
+	batchCmd.Flags().StringVar(&batchVideoCodec, "video-codec", "", "Video codec: h264, h265, av1, vp9, copy")
+	batchCmd.Flags().IntVar(&batchCRF, "crf", -1, "Sabit kalite değeri (h264/h265: 0-51, av1/vp9: 0-63)")
+	batchCmd.Flags().StringVar(&batchEncPreset, "encode-preset", "", "Encoder hız preset'i (ör: fast, medium, slow)")
+	batchCmd.Flags().StringVar(&batchPixFmt, "pix-fmt", "", "Piksel formatı (ör: yuv420p, yuv420p10le)")
+	batchCmd.Flags().StringVar(&batchRules, "rules", "", "--from auto için kategori/format -> hedef eşleme kuralları (JSON)")
+	batchCmd.Flags().StringArrayVar(&batchTargetOpts, "target-opts", nil, "Hedefe özel ayar bloğu (ör: webp:quality=80,target-size=200kb); tekrarlanabilir")
+	addAnimationFlags(batchCmd, &batchAnimation)
+	addTransformFlags(batchCmd, &batchTransform)
+	addFilterFlags(batchCmd, &batchFilter)
+	addScheduleFlags(batchCmd, &batchSchedule)
+	addSourceFlags(batchCmd, &batchSource)
+	addVerifyFlags(batchCmd, &batchVerify)
+	addNameTemplateFlag(batchCmd, &batchNameTmpl)
 
-	batchCmd.MarkFlagRequired("to")
 	batchCmd.MarkFlagRequired("from")
 
+	batchCmd.AddCommand(batchResumeCmd)
 	rootCmd.AddCommand(batchCmd)
 }
 
@@ -458,6 +796,20 @@ func writeBatchReport(path, content string) error {
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// buildBatchNamedOutputPath şablon verilmişse çıktı adını şablondan üretir. Şablonun
+// yerleştiği klasör varsayılan adlandırmayla aynıdır (--output, --preserve-tree veya kaynak klasör).
+func buildBatchNamedOutputPath(inputPath, sourceRoot string, target batchTarget, naming batchNaming) (string, error) {
+	defaultPath := buildBatchOutputPath(inputPath, sourceRoot, target.Format)
+	if naming.Template == nil {
+		return defaultPath, nil
+	}
+	rel, err := naming.Template.Render(nameTemplateData(inputPath, target.Format, naming.Index, target.Options))
+	if err != nil {
+		return "", fmt.Errorf("isim şablonu uygulanamadı (%s): %w", inputPath, err)
+	}
+	return filepath.Join(filepath.Dir(defaultPath), rel), nil
+}
+
 func buildBatchOutputPath(inputPath, sourceRoot, targetFormat string) string {
 	if !batchPreserveTree || strings.TrimSpace(outputDir) == "" || strings.TrimSpace(sourceRoot) == "" {
 		return converter.BuildOutputPath(inputPath, outputDir, targetFormat, "")
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+// batchFromAuto --from için içerik tabanlı otomatik format tespiti değeri.
+const batchFromAuto = "auto"
+
+// Otomatik modda yönlendirilemeyen dosyalar için atlama sebepleri.
+const (
+	skipUnknownFormat     = "unknown_format"
+	skipNoRule            = "no_rule"
+	skipUnsupported       = "unsupported_conversion"
+	skipSameFormat        = "same_format"
+	skipResizeUnsupported = "resize_unsupported"
+)
+
+// batchRoute bir kaynak formatın hedeflerini veya neden atlandığını tutar.
+type batchRoute struct {
+	Targets    []batchTarget
+	SkipReason string
+}
+
+// batchRouter --from auto modunda her kaynak formatı kurallara veya --to listesine göre
+// hedeflere yönlendirir. Aynı format için çözülen hedefler önbellekte tutulur.
+type batchRouter struct {
+	cmd              *cobra.Command
+	rules            *batch.Rules
+	defaultFormats   []string
+	blocks           map[string][][2]string
+	baseValues       batchTargetValues
+	baseOptions      converter.Options
+	profileWatermark *converter.WatermarkSpec
+	quiet            bool
+	cache            map[string]batchRoute
+}
+
+// Route kaynak format için hedefleri çözer. Yapılandırma hataları (hatalı ayar, hedefe
+// uymayan flag) hata döner; desteklenmeyen dönüşümler atlama sebebiyle döner.
+func (r *batchRouter) Route(fromFormat string) (batchRoute, error) {
+	if route, ok := r.cache[fromFormat]; ok {
+		return route, nil
+	}
+	route, err := r.resolve(fromFormat)
+	if err != nil {
+		return batchRoute{}, err
+	}
+	if r.cache == nil {
+		r.cache = map[string]batchRoute{}
+	}
+	r.cache[fromFormat] = route
+	return route, nil
+}
+
+func (r *batchRouter) resolve(fromFormat string) (batchRoute, error) {
+	if fromFormat == "" || converter.FormatCategory(fromFormat) == "unknown" {
+		return batchRoute{SkipReason: skipUnknownFormat}, nil
+	}
+	if r.baseOptions.Resize != nil && !converter.IsResizableFormat(fromFormat) {
+		return batchRoute{SkipReason: skipResizeUnsupported}, nil
+	}
+
+	values := r.baseValues
+	formats := r.defaultFormats
+	if r.rules != nil {
+		if rule, ok := r.rules.Match(fromFormat); ok {
+			formats = batch.RuleTargets(rule)
+			for _, kv := range ruleTargetOptions(rule) {
+				if err := applyBatchTargetOption(&values, kv[0], kv[1]); err != nil {
+					return batchRoute{}, fmt.Errorf("%s kuralı hatalı: %w", fromFormat, err)
+				}
+			}
+		}
+	}
+	if len(formats) == 0 {
+		return batchRoute{SkipReason: skipNoRule}, nil
+	}
+
+	route := batchRoute{SkipReason: skipUnsupported}
+	for _, format := range formats {
+		if _, err := converter.FindConverter(fromFormat, format); err != nil {
+			continue
+		}
+		targetValues := values
+		targetValues.Keys = append([]string(nil), values.Keys...)
+		for _, kv := range r.blocks[format] {
+			if err := applyBatchTargetOption(&targetValues, kv[0], kv[1]); err != nil {
+				return batchRoute{}, fmt.Errorf("[%s] hedef ayarları hatalı: %w", format, err)
+			}
+		}
+		target, ok, err := resolveBatchTarget(r.cmd, fromFormat, format, targetValues, r.baseOptions, r.profileWatermark, r.quiet)
+		if err != nil {
+			return batchRoute{}, fmt.Errorf("[%s -> %s] %w", fromFormat, format, err)
+		}
+		if !ok {
+			route.SkipReason = skipSameFormat
+			continue
+		}
+		route.Targets = append(route.Targets, target)
+	}
+	if len(route.Targets) > 0 {
+		route.SkipReason = ""
+	}
+	return route, nil
+}
+
+// ruleTargetOptions kuralın dolu alanlarını --target-opts anahtar/değer çiftlerine çevirir.
+func ruleTargetOptions(rule batch.Rule) [][2]string {
+	var pairs [][2]string
+	addString := func(key, value string) {
+		if strings.TrimSpace(value) != "" {
+			pairs = append(pairs, [2]string{key, strings.TrimSpace(value)})
+		}
+	}
+	addInt := func(key string, value int) {
+		if value != 0 {
+			pairs = append(pairs, [2]string{key, strconv.Itoa(value)})
+		}
+	}
+
+	addInt("quality", rule.Quality)
+	addString("target-size", rule.TargetSize)
+	addString("bitrate", rule.Bitrate)
+	addString("audio-codec", rule.AudioCodec)
+	addString("audio-bitrate", rule.AudioBitrate)
+	addInt("sample-rate", rule.SampleRate)
+	addString("bit-depth", rule.BitDepth)
+	addInt("channels", rule.Channels)
+	addString("video-codec", rule.VideoCodec)
+	if rule.CRF != nil {
+		pairs = append(pairs, [2]string{"crf", strconv.Itoa(*rule.CRF)})
+	}
+	addString("encode-preset", rule.EncodePreset)
+	addString("pix-fmt", rule.PixFmt)
+	return pairs
+}
+
+// batchAutoKnownFormats --target-opts bloklarının başvurabileceği tüm hedef formatları döner.
+func batchAutoKnownFormats(defaultFormats []string, rules *batch.Rules) []string {
+	formats := append([]string(nil), defaultFormats...)
+	if rules == nil {
+		return formats
+	}
+	for _, rule := range rules.Rules {
+		for _, t := range batch.RuleTargets(rule) {
+			if !slices.Contains(formats, t) {
+				formats = append(formats, t)
+			}
+		}
+	}
+	return formats
+}
+
+// batchRouteSummary otomatik modda kaynak format başına dosya sayısını ve hedefleri,
+// atlanan dosyaları da sebep başına sayar.
+type batchRouteSummary struct {
+	Format  string
+	Count   int
+	Targets []string
+}
+
+func summarizeBatchRoutes(formats []string, routes map[string]batchRoute) ([]batchRouteSummary, map[string]int) {
+	counts := map[string]int{}
+	skipped := map[string]int{}
+	for _, f := range formats {
+		route := routes[f]
+		if route.SkipReason != "" {
+			skipped[route.SkipReason]++
+			continue
+		}
+		counts[f]++
+	}
+
+	summaries := make([]batchRouteSummary, 0, len(counts))
+	for f, n := range counts {
+		summaries = append(summaries, batchRouteSummary{
+			Format:  f,
+			Count:   n,
+			Targets: batchTargetFormats(routes[f].Targets),
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Count != summaries[j].Count {
+			return summaries[i].Count > summaries[j].Count
+		}
+		return summaries[i].Format < summaries[j].Format
+	})
+	return summaries, skipped
+}
+
+// printBatchRoutes otomatik modda format başına yönlendirme planını ve atlanan dosyaları yazdırır.
+func printBatchRoutes(fileFormats []string, routes map[string]batchRoute) {
+	summaries, skipped := summarizeBatchRoutes(fileFormats, routes)
+	for _, s := range summaries {
+		ui.PrintInfo(fmt.Sprintf("  %s (%d dosya) -> %s", s.Format, s.Count, strings.Join(s.Targets, ", ")))
+	}
+	reasons := make([]string, 0, len(skipped))
+	for reason := range skipped {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		ui.PrintWarning(fmt.Sprintf("  %d dosya atlanacak (sebep: %s)", skipped[reason], reason))
+	}
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestRuleTargetOptions(t *testing.T) {
+	crf := 0
+	pairs := ruleTargetOptions(batch.Rule{Match: "video", To: "mp4", Quality: 70, VideoCodec: "h265", CRF: &crf})
+	want := [][2]string{{"quality", "70"}, {"video-codec", "h265"}, {"crf", "0"}}
+	if len(pairs) != len(want) {
+		t.Fatalf("unexpected pairs: %v", pairs)
+	}
+	for i := range want {
+		if pairs[i] != want[i] {
+			t.Fatalf("pair %d: expected %v, got %v", i, want[i], pairs[i])
+		}
+	}
+}
+
+func TestBatchRouterRoutesByRules(t *testing.T) {
+	rules := &batch.Rules{Rules: []batch.Rule{
+		{Match: "image", To: "webp", Quality: 80},
+		{Match: "gif", To: "gif"},
+		{Match: "audio", To: "pdf"},
+	}}
+	router := &batchRouter{
+		cmd:        batchCmd,
+		rules:      rules,
+		blocks:     map[string][][2]string{},
+		baseValues: batchTargetValues{Quality: 50, Encode: encodeFlagValues{CRF: -1}},
+	}
+
+	route, err := router.Route("png")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if route.SkipReason != "" || len(route.Targets) != 1 || route.Targets[0].Format != "webp" {
+		t.Fatalf("unexpected png route: %+v", route)
+	}
+	if route.Targets[0].Options.Quality != 80 {
+		t.Fatalf("expected rule quality to override base, got %d", route.Targets[0].Options.Quality)
+	}
+
+	cases := map[string]string{
+		"":    skipUnknownFormat,
+		"xyz": skipUnknownFormat,
+		"gif": skipSameFormat,
+		"mp3": skipUnsupported,
+		"md":  skipNoRule,
+	}
+	for format, want := range cases {
+		route, err := router.Route(format)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", format, err)
+		}
+		if route.SkipReason != want {
+			t.Fatalf("%s: expected %s, got %+v", format, want, route)
+		}
+	}
+	if _, ok := router.cache["png"]; !ok {
+		t.Fatalf("expected resolved routes to be cached")
+	}
+}
+
+func TestBatchRouterFallsBackToDefaultTargets(t *testing.T) {
+	router := &batchRouter{
+		cmd:            batchCmd,
+		defaultFormats: []string{"jpg", "pdf"},
+		blocks:         map[string][][2]string{"jpg": {{"quality", "90"}}},
+		baseValues:     batchTargetValues{Encode: encodeFlagValues{CRF: -1}},
+	}
+	route, err := router.Route("png")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// png -> pdf desteklenmez, sadece jpg kalır.
+	if len(route.Targets) != 1 || route.Targets[0].Format != "jpg" || route.Targets[0].Options.Quality != 90 {
+		t.Fatalf("unexpected route: %+v", route)
+	}
+
+	router.baseOptions = converter.Options{Resize: &converter.ResizeSpec{Width: 10, Height: 10}}
+	router.cache = nil
+	route, err = router.Route("md")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if route.SkipReason != skipResizeUnsupported {
+		t.Fatalf("expected resize skip for documents, got %+v", route)
+	}
+}
+
+func TestBatchRouterRejectsMismatchedRuleOptions(t *testing.T) {
+	router := &batchRouter{
+		cmd:        batchCmd,
+		rules:      &batch.Rules{Rules: []batch.Rule{{Match: "image", To: "jpg", SampleRate: 44100}}},
+		baseValues: batchTargetValues{Encode: encodeFlagValues{CRF: -1}},
+	}
+	if _, err := router.Route("png"); err == nil {
+		t.Fatalf("expected audio-only rule option to fail on image target")
+	}
+}
+
+func TestSummarizeBatchRoutes(t *testing.T) {
+	routes := map[string]batchRoute{
+		"png": {Targets: []batchTarget{{Format: "webp"}}},
+		"jpg": {Targets: []batchTarget{{Format: "webp"}}},
+		"xyz": {SkipReason: skipUnknownFormat},
+	}
+	summaries, skipped := summarizeBatchRoutes([]string{"png", "jpg", "png", "xyz", "xyz"}, routes)
+	if len(summaries) != 2 || summaries[0].Format != "png" || summaries[0].Count != 2 {
+		t.Fatalf("unexpected summaries: %+v", summaries)
+	}
+	if skipped[skipUnknownFormat] != 2 {
+		t.Fatalf("unexpected skipped counts: %v", skipped)
+	}
+}
+
+func TestBatchAutoKnownFormats(t *testing.T) {
+	rules := &batch.Rules{Rules: []batch.Rule{{Match: "image", To: "webp,jpg"}, {Match: "audio", To: "mp3"}}}
+	got := batchAutoKnownFormats([]string{"jpg"}, rules)
+	if len(got) != 3 || got[0] != "jpg" || got[1] != "webp" || got[2] != "mp3" {
+		t.Fatalf("unexpected known formats: %v", got)
+	}
+}
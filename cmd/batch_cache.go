@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/cache"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// resolveCacheDir önbellek dizinini flag > env > varsayılan sırasıyla belirler.
+func resolveCacheDir(flagValue string) (string, error) {
+	if v := strings.TrimSpace(flagValue); v != "" {
+		return v, nil
+	}
+	if v := strings.TrimSpace(os.Getenv(envCacheDir)); v != "" {
+		return v, nil
+	}
+	return cache.DefaultDir()
+}
+
+// incrementalCache --incremental çalışmasında önbellek sorgularını ve başarılı
+// çıktıların kaydını yönetir. nil değer artımlı modun kapalı olduğunu belirtir.
+type incrementalCache struct {
+	store *cache.Store
+	// force isabetleri yok sayar; çıktılar yeniden üretilip önbellek güncellenir.
+	force bool
+	now   time.Time
+
+	mu      sync.Mutex
+	planned map[string]cache.Entry
+	// assets çalışma boyunca hesaplanan yardımcı dosya özetleridir; tüm işler aynı logoyu paylaşır.
+	assets map[string]string
+}
+
+func openBatchIncremental(dir string, force bool) (*incrementalCache, error) {
+	store, err := cache.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &incrementalCache{
+		store:   store,
+		force:   force,
+		now:     time.Now(),
+		planned: map[string]cache.Entry{},
+		assets:  map[string]string{},
+	}, nil
+}
+
+// assetHashes seçeneklerin başvurduğu dosyaların SHA-256 özetlerini döner. Dosya
+// okunamazsa hata döner ve iş önbelleğe bakılmadan dönüştürülür.
+func (b *incrementalCache) assetHashes(opts converter.Options) (map[string]string, error) {
+	if opts.Watermark == nil || strings.TrimSpace(opts.Watermark.Image) == "" {
+		return nil, nil
+	}
+	path := opts.Watermark.Image
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	hash, ok := b.assets[path]
+	if !ok {
+		var err error
+		if hash, err = cache.HashFile(path); err != nil {
+			return nil, err
+		}
+		b.assets[path] = hash
+	}
+	return map[string]string{"watermark_image": hash}, nil
+}
+
+// lookup girdi ve hedef için önbellek kaydını hazırlar; çıktısı güncel bir kayıt
+// varsa hit=true ile o kaydı döner. Anahtar üretilemezse (ör. girdi okunamazsa)
+// boş kayıt döner ve iş normal şekilde dönüştürülür.
+func (b *incrementalCache) lookup(input, fromFormat string, target batchTarget, baseOutput string) (cache.Entry, bool) {
+	if b == nil {
+		return cache.Entry{}, false
+	}
+	hash, err := b.store.InputHash(input)
+	if err != nil {
+		return cache.Entry{}, false
+	}
+	conv, err := converter.FindConverter(fromFormat, target.Format)
+	if err != nil {
+		return cache.Entry{}, false
+	}
+	// Verbose çıktıyı etkilemez; anahtara girmemeli.
+	opts := target.Options
+	opts.Verbose = false
+	assets, err := b.assetHashes(opts)
+	if err != nil {
+		return cache.Entry{}, false
+	}
+	key, err := cache.Key(cache.KeyParts{
+		InputHash: hash,
+		Converter: conv.Name(),
+		From:      fromFormat,
+		To:        target.Format,
+		Output:    baseOutput,
+		Options:   opts,
+		Assets:    assets,
+		Tools:     converter.ToolVersions(fromFormat, target.Format),
+		Version:   appVersion,
+	})
+	if err != nil {
+		return cache.Entry{}, false
+	}
+
+	entry := cache.Entry{Key: key, Input: input, InputHash: hash, Converter: conv.Name(), Format: target.Format}
+	if b.force {
+		return entry, false
+	}
+	if hit, ok := b.store.Lookup(key, b.now); ok {
+		// Kayıt mutlak yol tutar; planlanan yolla aynıysa rapordaki yazım korunur.
+		if abs, err := filepath.Abs(baseOutput); err == nil && abs == hit.Output {
+			hit.Output = baseOutput
+		}
+		return hit, true
+	}
+	return entry, false
+}
+
+// plan önbellekte olmayan bir çıktıyı dönüşüm başarılı olursa kaydedilmek üzere işaretler.
+func (b *incrementalCache) plan(entry cache.Entry, output string) {
+	if b == nil || entry.Key == "" {
+		return
+	}
+	entry.Output = output
+	b.mu.Lock()
+	b.planned[output] = entry
+	b.mu.Unlock()
+}
+
+// record başarılı sonuçları önbelleğe yazar ve diske kaydeder; kaydedilen çıktı sayısını döner.
+func (b *incrementalCache) record(results []batch.JobResult) (int, error) {
+	if b == nil {
+		return 0, nil
+	}
+	stored := 0
+	for _, r := range results {
+		if !r.Success {
+			continue
+		}
+		b.mu.Lock()
+		entry, ok := b.planned[r.Job.OutputPath]
+		b.mu.Unlock()
+		if !ok {
+			continue
+		}
+		if err := b.store.Put(entry, b.now); err == nil {
+			stored++
+		}
+	}
+	return stored, b.store.Save()
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestBuildBatchJobIncremental(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "notes.md")
+	if err := os.WriteFile(input, []byte("# notlar"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	output := filepath.Join(dir, "notes.html")
+	targets := []batchTarget{{Format: "html"}}
+	cacheDir := filepath.Join(dir, "cache")
+
+	build := func(inc *incrementalCache) batch.Job {
+		t.Helper()
+		job, err := buildBatchJob(input, "", "md", targets, converter.ConflictOverwrite, map[string]struct{}{}, false, batchNaming{}, inc)
+		if err != nil {
+			t.Fatalf("build failed: %v", err)
+		}
+		return job
+	}
+
+	inc, err := openBatchIncremental(cacheDir, false)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	job := build(inc)
+	if job.SkipReason != "" || job.OutputPath != output {
+		t.Fatalf("first run should convert, got %+v", job)
+	}
+	if err := os.WriteFile(output, []byte("<h1>notlar</h1>"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	stored, err := inc.record([]batch.JobResult{{Job: job, Success: true}})
+	if err != nil || stored != 1 {
+		t.Fatalf("expected one stored entry, got %d, %v", stored, err)
+	}
+
+	inc, err = openBatchIncremental(cacheDir, false)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	job = build(inc)
+	if job.SkipReason != batch.SkipCacheHit || job.OutputPath != output {
+		t.Fatalf("unchanged input should be a cache hit, got %+v", job)
+	}
+
+	forced, err := openBatchIncremental(cacheDir, true)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	if job := build(forced); job.SkipReason != "" {
+		t.Fatalf("--force should ignore the cache, got %+v", job)
+	}
+
+	if err := os.WriteFile(input, []byte("# yeni notlar"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if job := build(inc); job.SkipReason != "" {
+		t.Fatalf("changed input should be converted again, got %+v", job)
+	}
+	targets[0].Options.Quality = 50
+	if err := os.WriteFile(input, []byte("# notlar"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if job := build(inc); job.SkipReason != "" {
+		t.Fatalf("changed options should be converted again, got %+v", job)
+	}
+}
+
+func TestBatchIncrementalWatermarkImageInKey(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "clip.md")
+	logo := filepath.Join(dir, "logo.png")
+	output := filepath.Join(dir, "clip.html")
+	for path, content := range map[string]string{input: "# klip", logo: "eski logo", output: "<h1>klip</h1>"} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+	target := batchTarget{Format: "html", Options: converter.Options{Watermark: &converter.WatermarkSpec{Image: logo}}}
+	cacheDir := filepath.Join(dir, "cache")
+
+	inc, err := openBatchIncremental(cacheDir, false)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	entry, hit := inc.lookup(input, "md", target, output)
+	if hit || entry.Key == "" {
+		t.Fatalf("first lookup should miss with a key, got %+v (hit=%v)", entry, hit)
+	}
+	inc.plan(entry, output)
+	if _, err := inc.record([]batch.JobResult{{Job: batch.Job{OutputPath: output}, Success: true}}); err != nil {
+		t.Fatalf("record failed: %v", err)
+	}
+
+	inc, err = openBatchIncremental(cacheDir, false)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	if _, hit := inc.lookup(input, "md", target, output); !hit {
+		t.Fatalf("unchanged logo should be a cache hit")
+	}
+
+	// Logo aynı yolda değiştirilirse çıktı yeniden üretilmeli
+	if err := os.WriteFile(logo, []byte("yeni logo"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	inc, err = openBatchIncremental(cacheDir, false)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	if _, hit := inc.lookup(input, "md", target, output); hit {
+		t.Fatalf("replaced watermark image should be a cache miss")
+	}
+}
+
+func TestResolveCacheDir(t *testing.T) {
+	t.Setenv(envCacheDir, "/tmp/env-cache")
+	if got, _ := resolveCacheDir(" ./flag-cache "); got != "./flag-cache" {
+		t.Fatalf("flag should win, got %s", got)
+	}
+	if got, _ := resolveCacheDir(""); got != "/tmp/env-cache" {
+		t.Fatalf("env should be used, got %s", got)
+	}
+}
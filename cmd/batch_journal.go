@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+// batchJournalState "batch resume" sırasında okunan günlük durumudur; normal çalıştırmada nil'dir.
+var batchJournalState *batch.JournalState
+
+var batchResumeCmd = &cobra.Command{
+	Use:   "resume <journal>",
+	Short: "Yarıda kalan batch çalışmasını iş günlüğünden devam ettir",
+	Long: `--journal ile başlatılmış bir batch çalışmasını kaldığı yerden sürdürür.
+
+İlk çalıştırmanın argümanları ve çalışma dizini günlükten okunur. Tamamlanmış
+ve çıktısı yerinde duran hedefler atlanır; kuyrukta bekleyen, yarıda kesilen
+veya başarısız olan hedefler günlükteki çıktı yoluna yeniden dönüştürülür.
+Günlükte olmayan yeni dosyalar bu çalıştırmaya eklenmez.
+
+Örnek:
+  fileconverter-cli batch ./videolar --from mov --to mp4 --journal ./batch.journal
+  fileconverter-cli batch resume ./batch.journal`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		state, journalPath, err := loadResumeJournal(args[0], "batch")
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		if err := batchCmd.ParseFlags(state.Args); err != nil {
+			ui.PrintError(fmt.Sprintf("Journal argümanları okunamadı: %s", err.Error()))
+			return err
+		}
+		runArgs := batchCmd.Flags().Args()
+		if err := batchCmd.Args(batchCmd, runArgs); err != nil {
+			ui.PrintError(fmt.Sprintf("Journal argümanları geçersiz: %s", err.Error()))
+			return err
+		}
+		batchJournal = journalPath
+		batchJournalState = state
+		defer func() { batchJournalState = nil }()
+
+		if !isJSONOutput() {
+			done, pending := state.Counts()
+			ui.PrintInfo(fmt.Sprintf("Journal: %d tamamlanmış, %d bekleyen çıktı (%s)", done, pending, journalPath))
+		}
+		return batchCmd.RunE(batchCmd, runArgs)
+	},
+}
+
+// filterJournalInputs dosya listesini günlükte kayıtlı girdilerle sınırlar.
+func filterJournalInputs(files []string, state *batch.JournalState) []string {
+	if state == nil {
+		return files
+	}
+	known := map[string]struct{}{}
+	for _, input := range state.Inputs() {
+		known[input] = struct{}{}
+	}
+	filtered := make([]string, 0, len(files))
+	for _, f := range files {
+		if _, ok := known[f]; ok {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
+// applyBatchJournalState yeniden oluşturulan işleri günlükle eşler. Tamamlanmış hedefler
+// atlanır, diğerleri günlükteki çıktı yoluna yazılır; böylece çakışma politikası
+// yarıda kalan çıktı için yeni bir ad üretmez.
+func applyBatchJournalState(jobs []batch.Job, state *batch.JournalState) {
+	if state == nil {
+		return
+	}
+	apply := func(input, to string, output, skip *string) {
+		entry, ok := state.Lookup(input, to)
+		switch {
+		case !ok:
+			if *skip == "" {
+				*skip = "not_in_journal"
+			}
+		case entry.Done():
+			*output = entry.Output
+			*skip = batch.SkipJournalDone
+		case *skip == batch.SkipCacheHit && *output == entry.Output:
+		default:
+			*output = entry.Output
+			*skip = ""
+		}
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		if job.To == "" && len(job.Outputs) == 0 {
+			// Kural ile atlanan girdinin hedefi yoktur
+			continue
+		}
+		if len(job.Outputs) == 0 {
+			apply(job.InputPath, job.To, &job.OutputPath, &job.SkipReason)
+			continue
+		}
+		for k := range job.Outputs {
+			out := &job.Outputs[k]
+			apply(job.InputPath, out.To, &out.OutputPath, &out.SkipReason)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/spf13/cobra"
+)
+
+func TestJournalArgsStripsCommandPath(t *testing.T) {
+	cases := []struct {
+		cmd  *cobra.Command
+		args []string
+		want []string
+	}{
+		{pipelineRunCmd, []string{"-w", "4", "pipeline", "run", "p.json", "--journal", "j"}, []string{"-w", "4", "p.json", "--journal", "j"}},
+		// Komut adına eşit flag değerleri komut sanılmamalı
+		{batchCmd, []string{"-o", "batch", "batch", "./src", "--from", "png"}, []string{"-o", "batch", "./src", "--from", "png"}},
+		{pipelineRunCmd, []string{"--output", "pipeline", "-v", "pipeline", "-orun", "run", "run"}, []string{"--output", "pipeline", "-v", "-orun", "run"}},
+		{batchCmd, []string{"--output=batch", "batch", "./batch", "--", "batch"}, []string{"--output=batch", "./batch", "--", "batch"}},
+	}
+	for _, c := range cases {
+		if got := journalArgs(c.cmd, c.args); !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("journalArgs(%v) = %v, want %v", c.args, got, c.want)
+		}
+	}
+}
+
+func TestOpenRunJournalRefusesExisting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	j, err := openRunJournal(path, "batch", false, batchCmd)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	j.Close()
+	if _, err := openRunJournal(path, "batch", false, batchCmd); err == nil {
+		t.Fatalf("expected error for existing journal")
+	}
+	j, err = openRunJournal(path, "batch", true, batchCmd)
+	if err != nil {
+		t.Fatalf("resume should reuse journal: %v", err)
+	}
+	j.Close()
+}
+
+func TestApplyBatchJournalState(t *testing.T) {
+	dir := t.TempDir()
+	doneOut := filepath.Join(dir, "a.html")
+	if err := os.WriteFile(doneOut, []byte("ok"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	path := filepath.Join(dir, "batch.journal")
+	j, err := batch.OpenJournal(path)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	j.Append(
+		batch.JournalEvent{Type: batch.JournalRun, Kind: "batch"},
+		batch.JournalEvent{Type: batch.JournalQueued, Input: "a.md", Output: doneOut, To: "html"},
+		batch.JournalEvent{Type: batch.JournalQueued, Input: "b.md", Output: filepath.Join(dir, "b.html"), To: "html"},
+		batch.JournalEvent{Type: batch.JournalQueued, Input: "b.md", Output: filepath.Join(dir, "b.txt"), To: "txt"},
+		batch.JournalEvent{Type: batch.JournalSucceeded, Input: "a.md", Output: doneOut, To: "html"},
+		batch.JournalEvent{Type: batch.JournalStarted, Input: "b.md", To: "html"},
+	)
+	j.Close()
+	state, err := batch.ReadJournal(path)
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	jobs := []batch.Job{
+		// Çakışma politikası mevcut çıktı için sürümlü ad üretmiş olabilir
+		{InputPath: "a.md", OutputPath: filepath.Join(dir, "a (1).html"), To: "html"},
+		{InputPath: "b.md", Outputs: []batch.JobOutput{
+			{OutputPath: filepath.Join(dir, "b (1).html"), To: "html"},
+			{OutputPath: filepath.Join(dir, "b.txt"), To: "txt", SkipReason: "output_exists"},
+			{OutputPath: filepath.Join(dir, "b.pdf"), To: "pdf"},
+		}},
+		{InputPath: "c.md", SkipReason: "no_rule"},
+	}
+	applyBatchJournalState(jobs, state)
+
+	if jobs[0].SkipReason != batch.SkipJournalDone || jobs[0].OutputPath != doneOut {
+		t.Fatalf("done job should be skipped at its journal output: %+v", jobs[0])
+	}
+	outs := jobs[1].Outputs
+	if outs[0].SkipReason != "" || outs[0].OutputPath != filepath.Join(dir, "b.html") {
+		t.Fatalf("started output should rerun at journal path: %+v", outs[0])
+	}
+	if outs[1].SkipReason != "" || outs[1].OutputPath != filepath.Join(dir, "b.txt") {
+		t.Fatalf("queued output should rerun even if a partial file exists: %+v", outs[1])
+	}
+	if outs[2].SkipReason != "not_in_journal" {
+		t.Fatalf("output missing from journal should be skipped: %+v", outs[2])
+	}
+	if jobs[2].SkipReason != "no_rule" {
+		t.Fatalf("routed skip should be kept: %+v", jobs[2])
+	}
+}
+
+func TestFilterJournalInputs(t *testing.T) {
+	state := &batch.JournalState{Entries: []batch.JournalEntry{{Input: "a.md"}, {Input: "c.md"}}}
+	got := filterJournalInputs([]string{"a.md", "b.md", "c.md"}, state)
+	if !reflect.DeepEqual(got, []string{"a.md", "c.md"}) {
+		t.Fatalf("unexpected files: %v", got)
+	}
+	if got := filterJournalInputs([]string{"x"}, nil); len(got) != 1 {
+		t.Fatalf("nil state should keep files: %v", got)
+	}
+}
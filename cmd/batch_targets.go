@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// batchTargetValues tek bir hedef formata uygulanacak kalite, hedef boyut ve encode ayarları.
+type batchTargetValues struct {
+	Quality    int
+	TargetSize string
+	Encode     encodeFlagValues
+	// Keys hedef bloğunda açıkça verilen anahtarlar; hedefe uymayanlar hata üretir.
+	Keys []string
+}
+
+// batchTarget doğrulanmış bir hedef format ve o hedefin dönüşüm seçenekleri.
+type batchTarget struct {
+	Format  string
+	Options converter.Options
+}
+
+// batchTargetOptionKeys hedef bloklarında kullanılabilecek anahtarlar.
+var batchTargetOptionKeys = []string{
+	"quality", "target-size", "bitrate", "audio-codec", "audio-bitrate",
+	"sample-rate", "bit-depth", "channels", "vbr", "cbr",
+	"video-codec", "crf", "encode-preset", "pix-fmt",
+}
+
+// parseBatchTargetFormats --to değerini virgülle ayrılmış hedef format listesine çevirir.
+// Tekrarlanan formatlar bir kez sayılır, sıra korunur.
+func parseBatchTargetFormats(spec string) ([]string, error) {
+	var formats []string
+	seen := map[string]struct{}{}
+	for _, part := range strings.Split(spec, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		format := converter.NormalizeFormat(part)
+		if format == "" {
+			return nil, fmt.Errorf("geçersiz hedef format: %q", part)
+		}
+		if _, ok := seen[format]; ok {
+			continue
+		}
+		seen[format] = struct{}{}
+		formats = append(formats, format)
+	}
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("hedef format belirtilmedi")
+	}
+	return formats, nil
+}
+
+// parseBatchTargetBlocks "webp:quality=80,target-size=200kb" biçimindeki blokları
+// format -> anahtar/değer listesi olarak ayrıştırır. Bloktaki format --to listesinde olmalıdır.
+func parseBatchTargetBlocks(blocks []string, formats []string) (map[string][][2]string, error) {
+	known := map[string]struct{}{}
+	for _, f := range formats {
+		known[f] = struct{}{}
+	}
+
+	parsed := map[string][][2]string{}
+	for _, block := range blocks {
+		name, body, ok := strings.Cut(block, ":")
+		if !ok {
+			return nil, fmt.Errorf("hedef bloğu <format>:<anahtar>=<değer> biçiminde olmalı: %q", block)
+		}
+		format := converter.NormalizeFormat(name)
+		if _, ok := known[format]; !ok {
+			return nil, fmt.Errorf("hedef bloğundaki format --to listesinde yok: %s", strings.TrimSpace(name))
+		}
+		for _, pair := range strings.Split(body, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(pair, "=")
+			key = strings.ToLower(strings.TrimSpace(key))
+			if !ok || key == "" {
+				return nil, fmt.Errorf("hedef ayarı <anahtar>=<değer> biçiminde olmalı: %q", pair)
+			}
+			if !slices.Contains(batchTargetOptionKeys, key) {
+				return nil, fmt.Errorf("bilinmeyen hedef ayarı: %s (geçerli: %s)", key, strings.Join(batchTargetOptionKeys, ", "))
+			}
+			parsed[format] = append(parsed[format], [2]string{key, strings.TrimSpace(value)})
+		}
+	}
+	return parsed, nil
+}
+
+// applyBatchTargetOption tek bir blok ayarını hedef değerlerine uygular.
+func applyBatchTargetOption(values *batchTargetValues, key, value string) error {
+	parseInt := func() (int, error) {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("%s sayı olmalı: %q", key, value)
+		}
+		return n, nil
+	}
+	parseBool := func() (bool, error) {
+		if value == "" {
+			return true, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, fmt.Errorf("%s true/false olmalı: %q", key, value)
+		}
+		return b, nil
+	}
+
+	var err error
+	switch key {
+	case "quality":
+		values.Quality, err = parseInt()
+	case "target-size":
+		values.TargetSize = value
+	case "bitrate":
+		values.Encode.Bitrate = value
+	case "audio-codec":
+		values.Encode.AudioCodec = value
+	case "audio-bitrate":
+		values.Encode.AudioBitrate = value
+	case "sample-rate":
+		values.Encode.SampleRate, err = parseInt()
+	case "bit-depth":
+		values.Encode.BitDepth = value
+	case "channels":
+		values.Encode.Channels, err = parseInt()
+	case "vbr":
+		values.Encode.VBR, err = parseBool()
+	case "cbr":
+		values.Encode.CBR, err = parseBool()
+	case "video-codec":
+		values.Encode.VideoCodec = value
+	case "crf":
+		values.Encode.CRF, err = parseInt()
+	case "encode-preset":
+		values.Encode.EncodePreset = value
+	case "pix-fmt":
+		values.Encode.PixFmt = value
+	default:
+		return fmt.Errorf("bilinmeyen hedef ayarı: %s", key)
+	}
+	if err != nil {
+		return err
+	}
+	values.Keys = append(values.Keys, key)
+	return nil
+}
+
+// validateBatchTargetKeys bloktaki encode anahtarlarının hedef format türüne uyduğunu doğrular.
+func validateBatchTargetKeys(format string, keys []string) error {
+	isAudio := converter.IsAudioFormat(format)
+	isVideo := converter.IsVideoFormat(format)
+	for _, key := range keys {
+		switch {
+		case slices.Contains(audioOnlyFlagNames, key) && !isAudio:
+			return fmt.Errorf("%s ayarı sadece ses hedeflerinde kullanılabilir", key)
+		case slices.Contains(videoOnlyFlagNames, key) && !isVideo:
+			return fmt.Errorf("%s ayarı sadece video hedeflerinde kullanılabilir", key)
+		case (key == "bitrate" || key == "audio-codec" || key == "audio-bitrate") && !isAudio && !isVideo:
+			return fmt.Errorf("%s ayarı sadece ses ve video hedeflerinde kullanılabilir", key)
+		}
+	}
+	return nil
+}
+
+// resolveBatchTarget bir hedef formatın encode, animasyon, watermark, kırpma ve hedef boyut
+// ayarlarını çözer. Kaynakla aynı formata yapılacak iş yoksa ok=false döner.
+func resolveBatchTarget(cmd *cobra.Command, fromFormat, targetFormat string, values batchTargetValues, base converter.Options, profileWatermark *converter.WatermarkSpec, quiet bool) (batchTarget, bool, error) {
+	if err := validateBatchTargetKeys(targetFormat, values.Keys); err != nil {
+		return batchTarget{}, false, err
+	}
+	audioSpec, videoSpec, err := resolveEncodeSpecs(cmd, values.Encode, targetFormat)
+	if err != nil {
+		return batchTarget{}, false, fmt.Errorf("encode parametreleri hatalı: %w", err)
+	}
+	if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy && base.Resize != nil {
+		return batchTarget{}, false, fmt.Errorf("--video-codec copy ile boyutlandırma birlikte kullanılamaz")
+	}
+	if err := prepareVideoEncoders(videoSpec, targetFormat, quiet); err != nil {
+		return batchTarget{}, false, err
+	}
+	animationSpec, err := resolveAnimationSpec(cmd, batchAnimation, fromFormat, targetFormat)
+	if err != nil {
+		return batchTarget{}, false, fmt.Errorf("animasyon ayarları hatalı: %w", err)
+	}
+	watermarkSpec, err := resolveProfileWatermark(profileWatermark, targetFormat)
+	if err != nil {
+		return batchTarget{}, false, err
+	}
+	transformSpec, err := resolveTransformSpec(cmd, batchTransform, fromFormat, targetFormat)
+	if err != nil {
+		return batchTarget{}, false, fmt.Errorf("kırpma/döndürme ayarları hatalı: %w", err)
+	}
+	if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy && transformSpec != nil {
+		return batchTarget{}, false, fmt.Errorf("--video-codec copy ile kırpma/döndürme birlikte kullanılamaz")
+	}
+	var targetSize int64
+	if values.TargetSize != "" {
+		targetSize, err = converter.ParseSize(values.TargetSize)
+		if err != nil {
+			return batchTarget{}, false, fmt.Errorf("geçersiz hedef boyut: %w", err)
+		}
+		if err := converter.ValidateTargetSizeOptions(targetFormat, targetSize, audioSpec, videoSpec); err != nil {
+			return batchTarget{}, false, err
+		}
+	}
+
+	// Aynı format, resize yoksa no-op
+	if fromFormat == targetFormat && base.Resize == nil && videoSpec == nil && targetSize == 0 && watermarkSpec == nil && transformSpec == nil {
+		return batchTarget{}, false, nil
+	}
+
+	// Dönüşüm desteği kontrolü
+	if _, err := converter.FindConverter(fromFormat, targetFormat); err != nil {
+		return batchTarget{}, false, err
+	}
+
+	opts := base
+	opts.Quality = values.Quality
+	opts.Audio = audioSpec
+	opts.Video = videoSpec
+	opts.Animation = animationSpec
+	opts.TargetSize = targetSize
+	opts.Watermark = watermarkSpec
+	opts.Transform = transformSpec
+	return batchTarget{Format: targetFormat, Options: opts}, true, nil
+}
+
+// batchNaming --name-template şablonunu ve girdinin 1'den başlayan sırasını taşır.
+// Sıfır değer varsayılan <ad>.<uzantı> adlandırmasıdır.
+type batchNaming struct {
+	Template *converter.NameTemplate
+	Index    int
+}
+
+// buildBatchJob bir girdi için tüm hedeflerin çıktılarını planlar. Tek hedefte klasik
+// tek çıktılı iş, birden fazla hedefte ortak kaynağı paylaşan çok çıktılı iş döner.
+// incremental nil değilse önbellekte güncel çıktısı olan hedefler cache_hit olarak atlanır.
+func buildBatchJob(input, sourceRoot, fromFormat string, targets []batchTarget, conflictPolicy string, reserved map[string]struct{}, resumed bool, naming batchNaming, incremental *incrementalCache) (batch.Job, error) {
+	outputs := make([]batch.JobOutput, 0, len(targets))
+	for _, target := range targets {
+		baseOutput, err := buildBatchNamedOutputPath(input, sourceRoot, target, naming)
+		if err != nil {
+			return batch.Job{}, err
+		}
+		out := batch.JobOutput{
+			OutputPath: baseOutput,
+			To:         target.Format,
+			Options:    target.Options,
+		}
+		if resumed {
+			out.SkipReason = batch.SkipResumeSuccess
+		} else if entry, hit := incremental.lookup(input, fromFormat, target, baseOutput); hit {
+			out.OutputPath = entry.Output
+			out.SkipReason = batch.SkipCacheHit
+			reserved[entry.Output] = struct{}{}
+		} else {
+			resolved, skipReason, err := resolveBatchOutputPath(baseOutput, conflictPolicy, reserved)
+			if err != nil {
+				return batch.Job{}, err
+			}
+			out.OutputPath = resolved
+			out.SkipReason = skipReason
+			if skipReason == "" {
+				incremental.plan(entry, resolved)
+			}
+		}
+		outputs = append(outputs, out)
+	}
+
+	job := batch.Job{InputPath: input, From: fromFormat}
+	if len(outputs) == 1 {
+		job.OutputPath = outputs[0].OutputPath
+		job.To = outputs[0].To
+		job.Options = outputs[0].Options
+		job.SkipReason = outputs[0].SkipReason
+		return job, nil
+	}
+	job.Outputs = outputs
+	return job, nil
+}
+
+// expandBatchJobs çok hedefli işleri çıktı başına tek hedefli işlere açar.
+func expandBatchJobs(jobs []batch.Job) []batch.Job {
+	expanded := make([]batch.Job, 0, len(jobs))
+	for _, job := range jobs {
+		expanded = append(expanded, job.Targets()...)
+	}
+	return expanded
+}
+
+func batchTargetFormats(targets []batchTarget) []string {
+	formats := make([]string, 0, len(targets))
+	for _, t := range targets {
+		formats = append(formats, t.Format)
+	}
+	return formats
+}
+
+// batchTargetLabel çok hedefli çalışmada mesajların başına hedef formatı ekler.
+func batchTargetLabel(format string, multi bool) string {
+	if !multi {
+		return ""
+	}
+	return "[" + format + "] "
+}
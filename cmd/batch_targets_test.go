@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestParseBatchTargetFormats(t *testing.T) {
+	got, err := parseBatchTargetFormats(" jpeg, webp ,jpg,,avif")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(got, ",") != "jpg,webp,avif" {
+		t.Fatalf("unexpected formats: %v", got)
+	}
+	if _, err := parseBatchTargetFormats(" , "); err == nil {
+		t.Fatalf("expected error for empty target list")
+	}
+}
+
+func TestParseBatchTargetBlocks(t *testing.T) {
+	blocks, err := parseBatchTargetBlocks([]string{"webp:quality=80,target-size=200kb", "JPEG: quality = 90"}, []string{"jpg", "webp"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(blocks["webp"]) != 2 || blocks["webp"][1] != [2]string{"target-size", "200kb"} {
+		t.Fatalf("unexpected webp block: %v", blocks["webp"])
+	}
+	if len(blocks["jpg"]) != 1 || blocks["jpg"][0] != [2]string{"quality", "90"} {
+		t.Fatalf("unexpected jpg block: %v", blocks["jpg"])
+	}
+
+	cases := []string{"webp", "png:quality=80", "webp:quality", "webp:speed=3"}
+	for _, block := range cases {
+		if _, err := parseBatchTargetBlocks([]string{block}, []string{"jpg", "webp"}); err == nil {
+			t.Fatalf("expected error for block %q", block)
+		}
+	}
+}
+
+func TestApplyBatchTargetOption(t *testing.T) {
+	values := batchTargetValues{Quality: 50, Encode: encodeFlagValues{CRF: -1}}
+	pairs := [][2]string{{"quality", "80"}, {"bitrate", "192k"}, {"vbr", ""}, {"crf", "28"}}
+	for _, kv := range pairs {
+		if err := applyBatchTargetOption(&values, kv[0], kv[1]); err != nil {
+			t.Fatalf("unexpected error for %s: %v", kv[0], err)
+		}
+	}
+	if values.Quality != 80 || values.Encode.Bitrate != "192k" || !values.Encode.VBR || values.Encode.CRF != 28 {
+		t.Fatalf("unexpected values: %+v", values)
+	}
+	if len(values.Keys) != 4 {
+		t.Fatalf("expected explicit keys to be tracked, got %v", values.Keys)
+	}
+	if err := applyBatchTargetOption(&values, "quality", "high"); err == nil {
+		t.Fatalf("expected error for non-numeric quality")
+	}
+	if err := applyBatchTargetOption(&values, "cbr", "maybe"); err == nil {
+		t.Fatalf("expected error for invalid bool")
+	}
+}
+
+func TestValidateBatchTargetKeys(t *testing.T) {
+	if err := validateBatchTargetKeys("mp3", []string{"bitrate", "sample-rate"}); err != nil {
+		t.Fatalf("unexpected error for audio target: %v", err)
+	}
+	if err := validateBatchTargetKeys("mp4", []string{"crf", "audio-bitrate"}); err != nil {
+		t.Fatalf("unexpected error for video target: %v", err)
+	}
+	if err := validateBatchTargetKeys("webp", []string{"quality", "target-size"}); err != nil {
+		t.Fatalf("unexpected error for image target: %v", err)
+	}
+	if err := validateBatchTargetKeys("mp4", []string{"sample-rate"}); err == nil {
+		t.Fatalf("expected audio-only key to fail on video target")
+	}
+	if err := validateBatchTargetKeys("mp3", []string{"crf"}); err == nil {
+		t.Fatalf("expected video-only key to fail on audio target")
+	}
+	if err := validateBatchTargetKeys("jpg", []string{"bitrate"}); err == nil {
+		t.Fatalf("expected encode key to fail on image target")
+	}
+}
+
+func TestBuildBatchJobMultiTarget(t *testing.T) {
+	prevOutput := outputDir
+	prevPreserve := batchPreserveTree
+	t.Cleanup(func() {
+		outputDir = prevOutput
+		batchPreserveTree = prevPreserve
+	})
+	dir := t.TempDir()
+	outputDir = dir
+	batchPreserveTree = false
+
+	if err := os.WriteFile(filepath.Join(dir, "photo.webp"), []byte("x"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	targets := []batchTarget{
+		{Format: "jpg", Options: converter.Options{Quality: 85}},
+		{Format: "webp", Options: converter.Options{Quality: 70}},
+	}
+
+	job, err := buildBatchJob("photo.png", "", "png", targets, converter.ConflictSkip, map[string]struct{}{}, false, batchNaming{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(job.Outputs) != 2 || job.OutputPath != "" {
+		t.Fatalf("expected multi-output job, got %+v", job)
+	}
+	if job.Outputs[0].OutputPath != filepath.Join(dir, "photo.jpg") || job.Outputs[0].Options.Quality != 85 || job.Outputs[0].SkipReason != "" {
+		t.Fatalf("unexpected jpg output: %+v", job.Outputs[0])
+	}
+	if job.Outputs[1].SkipReason != "output_exists" || job.Outputs[1].Options.Quality != 70 {
+		t.Fatalf("expected existing webp output to be skipped, got %+v", job.Outputs[1])
+	}
+
+	resumed, err := buildBatchJob("photo.png", "", "png", targets, converter.ConflictSkip, map[string]struct{}{}, true, batchNaming{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, out := range resumed.Outputs {
+		if out.SkipReason != "resume_success" {
+			t.Fatalf("expected resume skip for every output, got %+v", out)
+		}
+	}
+}
+
+func TestBuildBatchJobSingleTargetKeepsFlatJob(t *testing.T) {
+	prevOutput := outputDir
+	t.Cleanup(func() { outputDir = prevOutput })
+	outputDir = t.TempDir()
+
+	job, err := buildBatchJob("song.wav", "", "wav", []batchTarget{{Format: "mp3"}}, converter.ConflictOverwrite, map[string]struct{}{}, false, batchNaming{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(job.Outputs) != 0 || job.To != "mp3" || job.OutputPath != filepath.Join(outputDir, "song.mp3") {
+		t.Fatalf("unexpected single-target job: %+v", job)
+	}
+	if got := expandBatchJobs(nil); len(got) != 0 {
+		t.Fatalf("expected empty expansion, got %v", got)
+	}
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/cache"
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+var (
+	cacheDir        string
+	cachePruneOlder string
+	cachePruneAll   bool
+	cachePruneDry   bool
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Artımlı batch önbelleğini yönet",
+	Long: `batch --incremental çalışmalarının kullandığı dönüşüm önbelleğini gösterir ve temizler.
+
+Önbellek varsayılan olarak ~/.fileconverter/cache altında tutulur; --cache-dir veya
+FILECONVERTER_CACHE_DIR ile değiştirilebilir.`,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Önbellek istatistiklerini göster",
+	Long: `Kayıt sayısını, kayıtlı çıktıların toplam boyutunu ve girdisi/çıktısı değişmiş
+(geçersizleşmiş) kayıtları gösterir.
+
+Örnek:
+  fileconverter-cli cache stats
+  fileconverter-cli cache stats --output-format json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openCacheStore()
+		if err != nil {
+			return err
+		}
+		stats := store.Stats()
+		if isJSONOutput() {
+			return printJSON(stats)
+		}
+
+		rows := [][]string{
+			{"Dosya", stats.Path},
+			{"Dosya boyutu", formatFileSize(stats.FileSize)},
+			{"Kayıt", strconv.Itoa(stats.Entries)},
+			{"Girdi", strconv.Itoa(stats.Inputs)},
+			{"Çıktı boyutu", formatFileSize(stats.OutputBytes)},
+			{"Geçersiz kayıt", strconv.Itoa(stats.Stale)},
+		}
+		if !stats.Oldest.IsZero() {
+			rows = append(rows,
+				[]string{"En eski kayıt", stats.Oldest.Local().Format("2006-01-02 15:04")},
+				[]string{"En yeni kayıt", stats.Newest.Local().Format("2006-01-02 15:04")},
+			)
+		}
+		ui.PrintTable([]string{"Alan", "Değer"}, rows)
+		if stats.Stale > 0 {
+			ui.PrintInfo("Geçersiz kayıtları silmek için: fileconverter-cli cache prune")
+		}
+		return nil
+	},
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Geçersiz veya eski önbellek kayıtlarını sil",
+	Long: `Girdisi ya da çıktısı silinmiş/değişmiş kayıtları siler. --older-than ile son
+kullanımı belirtilen süreden eski kayıtlar, --all ile tüm kayıtlar da silinir.
+Üretilmiş çıktı dosyalarına dokunulmaz.
+
+Örnek:
+  fileconverter-cli cache prune
+  fileconverter-cli cache prune --older-than 30d
+  fileconverter-cli cache prune --all --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := cache.PruneOptions{All: cachePruneAll, DryRun: cachePruneDry}
+		if strings.TrimSpace(cachePruneOlder) != "" {
+			d, err := filter.ParseRelativeDuration(cachePruneOlder)
+			if err != nil {
+				err = fmt.Errorf("geçersiz --older-than: %w", err)
+				ui.PrintError(err.Error())
+				return err
+			}
+			opts.OlderThan = d
+		}
+
+		store, err := openCacheStore()
+		if err != nil {
+			return err
+		}
+		before := store.Len()
+		removed := store.Prune(opts, time.Now())
+		if !opts.DryRun {
+			if err := store.Save(); err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+		}
+
+		if isJSONOutput() {
+			return printJSON(map[string]interface{}{
+				"path":      store.Path(),
+				"dry_run":   opts.DryRun,
+				"removed":   removed,
+				"remaining": before - removed,
+			})
+		}
+		if opts.DryRun {
+			ui.PrintInfo(fmt.Sprintf("Ön izleme: %d / %d kayıt silinecek.", removed, before))
+			return nil
+		}
+		ui.PrintSuccess(fmt.Sprintf("%d kayıt silindi, %d kayıt kaldı.", removed, before-removed))
+		return nil
+	},
+}
+
+func openCacheStore() (*cache.Store, error) {
+	dir, err := resolveCacheDir(cacheDir)
+	if err != nil {
+		err = fmt.Errorf("önbellek dizini belirlenemedi: %w", err)
+		ui.PrintError(err.Error())
+		return nil, err
+	}
+	store, err := cache.Open(dir)
+	if err != nil {
+		ui.PrintError(err.Error())
+		return nil, err
+	}
+	return store, nil
+}
+
+func init() {
+	cacheCmd.PersistentFlags().StringVar(&cacheDir, "cache-dir", "", "Önbellek dizini (varsayılan: ~/.fileconverter/cache)")
+	cachePruneCmd.Flags().StringVar(&cachePruneOlder, "older-than", "", "Son kullanımı bu süreden eski kayıtları da sil (ör: 30d, 12h)")
+	cachePruneCmd.Flags().BoolVar(&cachePruneAll, "all", false, "Tüm kayıtları sil")
+	cachePruneCmd.Flags().BoolVar(&cachePruneDry, "dry-run", false, "Silmeden kaç kaydın silineceğini göster")
+
+	cacheCmd.AddCommand(cacheStatsCmd, cachePruneCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
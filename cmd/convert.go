@@ -3,7 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"strings"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -28,6 +28,22 @@ var (
 	convertResizeMode string
 	convertOptimize   bool
 	convertTargetSize string
+	convertSampleRate int
+	convertBitDepth   string
+	convertChannels   int
+	convertBitrate    string
+	convertVBR        bool
+	convertCBR        bool
+	convertAudioCodec string
+	convertAudioBR    string
+	convertVideoCodec string
+	convertCRF        int
+	convertEncPreset  string
+	convertPixFmt     string
+	convertVideoBR    string
+	convertAnimation  animationFlagValues
+	convertTransform  transformFlagValues
+	convertNameTmpl   string
 )
 
 var convertCmd = &cobra.Command{
@@ -41,12 +57,22 @@ var convertCmd = &cobra.Command{
   fileconverter-cli convert muzik.mp3 --to wav --quality 80
   fileconverter-cli convert resim.png --to jpg --quality 90 --output ./cikti/
   fileconverter-cli convert video.mp4 --to gif --quality 80
+  fileconverter-cli convert video.mp4 --to gif --start 00:00:12 --duration 4 --fps 15 --dither bayer --max-size 5mb
+  fileconverter-cli convert video.mp4 --to webp --start 3 --duration 5 --loop 0
   fileconverter-cli convert dosya.pdf --to txt --name cikti_adi
+  fileconverter-cli convert foto.jpg --to webp --preset square --name-template "{name}_{width}x{height}_{quality}.{ext}"
   fileconverter-cli convert foto.jpg --to png --preset square --resize-mode pad
   fileconverter-cli convert klip.mp4 --to mp4 --preset story --resize-mode pad
   fileconverter-cli convert foto.webp --to png --width 12 --height 18 --unit cm --dpi 300
   fileconverter-cli convert klip.mp4 --to mp4 --profile social-story
-  fileconverter-cli convert klip.mov --to mp4 --strip-metadata`,
+  fileconverter-cli convert klip.mov --to mp4 --strip-metadata
+  fileconverter-cli convert kayit.wav --to flac --sample-rate 48000 --bit-depth 24
+  fileconverter-cli convert podcast.wav --to mp3 --channels 1 --bitrate 96k --cbr
+  fileconverter-cli convert muzik.flac --to ogg --vbr --quality 80
+  fileconverter-cli convert klip.mov --to mp4 --video-codec h265 --crf 26 --encode-preset slow
+  fileconverter-cli convert klip.mp4 --to webm --video-codec av1 --bitrate 2M --audio-codec opus
+  fileconverter-cli convert klip.mp4 --to mp4 --target-size 25mb
+  fileconverter-cli convert kayit.wav --to mp3 --target-size 8mb`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		inputFile := args[0]
@@ -56,10 +82,12 @@ var convertCmd = &cobra.Command{
 		applyOnConflictDefault(cmd, "on-conflict", &convertOnConflict)
 		applyMetadataDefault(cmd, "preserve-metadata", &convertPreserveMD, "strip-metadata", &convertStripMD)
 
+		var profileWatermark *converter.WatermarkSpec
 		if p, ok, err := resolveProfile(convertProfile); err != nil {
 			ui.PrintError(err.Error())
 			return err
 		} else if ok {
+			profileWatermark = p.Watermark
 			applyProfileToConvert(cmd, p)
 			applyProfileMetadata(cmd, p, "preserve-metadata", &convertPreserveMD, "strip-metadata", &convertStripMD)
 		}
@@ -70,6 +98,12 @@ var convertCmd = &cobra.Command{
 			return err
 		}
 
+		nameTmpl, err := parseNameTemplateFlag(convertNameTmpl, customName, "")
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
 		conflictPolicy := converter.NormalizeConflictPolicy(convertOnConflict)
 		if conflictPolicy == "" {
 			err := fmt.Errorf("gecersiz on-conflict politikasi: %s", convertOnConflict)
@@ -114,8 +148,68 @@ var convertCmd = &cobra.Command{
 			ui.PrintError(err.Error())
 			return err
 		}
+		audioSpec, videoSpec, err := resolveEncodeSpecs(cmd, encodeFlagValues{
+			SampleRate:   convertSampleRate,
+			BitDepth:     convertBitDepth,
+			Channels:     convertChannels,
+			Bitrate:      convertBitrate,
+			VBR:          convertVBR,
+			CBR:          convertCBR,
+			AudioCodec:   convertAudioCodec,
+			AudioBitrate: convertAudioBR,
+			VideoCodec:   convertVideoCodec,
+			CRF:          convertCRF,
+			EncodePreset: convertEncPreset,
+			PixFmt:       convertPixFmt,
+			VideoBitrate: convertVideoBR,
+		}, targetFormat)
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Encode parametreleri hatalı: %s", err.Error()))
+			return err
+		}
+		if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy && resizeSpec != nil {
+			err := fmt.Errorf("--video-codec copy ile boyutlandırma birlikte kullanılamaz")
+			ui.PrintError(err.Error())
+			return err
+		}
+		if err := prepareVideoEncoders(videoSpec, targetFormat, jsonOutput); err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		animationSpec, err := resolveAnimationSpec(cmd, convertAnimation, fromFormat, targetFormat)
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Animasyon ayarları hatalı: %s", err.Error()))
+			return err
+		}
+		watermarkSpec, err := resolveProfileWatermark(profileWatermark, targetFormat)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		transformSpec, err := resolveTransformSpec(cmd, convertTransform, fromFormat, targetFormat)
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Kırpma/döndürme ayarları hatalı: %s", err.Error()))
+			return err
+		}
+		if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy && transformSpec != nil {
+			err := fmt.Errorf("--video-codec copy ile kırpma/döndürme birlikte kullanılamaz")
+			ui.PrintError(err.Error())
+			return err
+		}
+		var targetSize int64
+		if convertTargetSize != "" {
+			targetSize, err = converter.ParseSize(convertTargetSize)
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("Geçersiz hedef boyut: %s", err.Error()))
+				return err
+			}
+			if err := converter.ValidateTargetSizeOptions(targetFormat, targetSize, audioSpec, videoSpec); err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+		}
 		// Aynı format, resize yoksa no-op
-		if fromFormat == targetFormat && resizeSpec == nil {
+		if fromFormat == targetFormat && resizeSpec == nil && videoSpec == nil && targetSize == 0 && watermarkSpec == nil && transformSpec == nil {
 			if jsonOutput {
 				return printJSON(map[string]interface{}{
 					"status": "skipped",
@@ -138,7 +232,13 @@ var convertCmd = &cobra.Command{
 		}
 
 		// Çıktı yolunu oluştur
-		outputFile := converter.BuildOutputPath(inputFile, outputDir, targetFormat, customName)
+		outputFile, err := templatedOutputPath(nameTmpl,
+			nameTemplateData(inputFile, targetFormat, 1, converter.Options{Quality: quality, Resize: resizeSpec}),
+			converter.BuildOutputPath(inputFile, outputDir, targetFormat, customName))
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
 		outputFile, skip, err := converter.ResolveOutputPathConflict(outputFile, conflictPolicy)
 		if err != nil {
 			ui.PrintError(err.Error())
@@ -159,6 +259,18 @@ var convertCmd = &cobra.Command{
 			return nil
 		}
 
+		// Otomatik kırpma dönüşümden önce tespit edilip gösterilir.
+		if transformSpec != nil && transformSpec.AutoCrop {
+			transformSpec, err = converter.ResolveAutoCrop(inputFile, transformSpec)
+			if err != nil {
+				ui.PrintError(err.Error())
+				return err
+			}
+			if !jsonOutput {
+				ui.PrintInfo(fmt.Sprintf("Otomatik kırpma: %s", transformSpec.Crop.String()))
+			}
+		}
+
 		// Dönüşüm bilgisi
 		if verbose && !jsonOutput {
 			ui.PrintInfo(fmt.Sprintf("Dönüştürücü: %s", conv.Name()))
@@ -171,6 +283,21 @@ var convertCmd = &cobra.Command{
 				}
 				ui.PrintInfo(fmt.Sprintf("Boyutlandırma: %dx%d (%s, mod: %s)", resizeSpec.Width, resizeSpec.Height, source, resizeSpec.Mode))
 			}
+			if audioSpec != nil {
+				ui.PrintInfo(fmt.Sprintf("Ses ayarları: %s", describeAudioSpec(audioSpec)))
+			}
+			if videoSpec != nil {
+				ui.PrintInfo(fmt.Sprintf("Video ayarları: %s", describeVideoSpec(videoSpec)))
+			}
+			if animationSpec != nil {
+				ui.PrintInfo(fmt.Sprintf("Animasyon ayarları: %s", describeAnimationSpec(animationSpec)))
+			}
+			if watermarkSpec != nil {
+				ui.PrintInfo(fmt.Sprintf("Watermark: %s", describeWatermarkSpec(watermarkSpec)))
+			}
+			if transformSpec != nil {
+				ui.PrintInfo(fmt.Sprintf("Kırpma/döndürme: %s", converter.DescribeTransformSpec(transformSpec)))
+			}
 		}
 
 		if !jsonOutput {
@@ -186,16 +313,20 @@ var convertCmd = &cobra.Command{
 			Resize:       resizeSpec,
 			MetadataMode: metadataMode,
 			Optimize:     convertOptimize,
+			Audio:        audioSpec,
+			Video:        videoSpec,
+			Animation:    animationSpec,
+			Watermark:    watermarkSpec,
+			Transform:    transformSpec,
 		}
-		if convertTargetSize != "" {
-			parsedSize, err := parseSize(convertTargetSize)
-			if err != nil {
-				ui.PrintError(fmt.Sprintf("Geçersiz hedef boyut: %s", err.Error()))
+		opts.TargetSize = targetSize
+
+		if nameTmpl != nil {
+			if err := os.MkdirAll(filepath.Dir(outputFile), 0755); err != nil {
+				ui.PrintError(fmt.Sprintf("Çıktı dizini oluşturulamadı: %s", err.Error()))
 				return err
 			}
-			opts.TargetSize = parsedSize
 		}
-
 		if err := conv.Convert(inputFile, outputFile, opts); err != nil {
 			ui.PrintError(fmt.Sprintf("Dönüşüm başarısız: %s", err.Error()))
 			return err
@@ -238,6 +369,7 @@ func init() {
 	convertCmd.Flags().StringVar(&convertProfile, "profile", "", "Hazır profil (ör: social-story, podcast-clean, archive-lossless)")
 	convertCmd.Flags().IntVarP(&quality, "quality", "q", 0, "Kalite seviyesi (1-100, görsel/ses dönüşümleri için)")
 	convertCmd.Flags().StringVarP(&customName, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	addNameTemplateFlag(convertCmd, &convertNameTmpl)
 	convertCmd.Flags().StringVar(&convertOnConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
 	convertCmd.Flags().BoolVar(&convertPreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
 	convertCmd.Flags().BoolVar(&convertStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
@@ -248,7 +380,21 @@ func init() {
 	convertCmd.Flags().Float64Var(&convertResizeDPI, "dpi", 96, "Birim cm ise kullanılacak DPI değeri")
 	convertCmd.Flags().StringVar(&convertResizeMode, "resize-mode", "pad", "Boyutlandırma modu: pad, fit, fill, stretch")
 	convertCmd.Flags().BoolVar(&convertOptimize, "optimize", false, "Dosya boyutunu minimize et")
-	convertCmd.Flags().StringVar(&convertTargetSize, "target-size", "", "Hedef dosya boyutu (ör: 500kb, 2mb)")
+	convertCmd.Flags().StringVar(&convertTargetSize, "target-size", "", "Hedef dosya boyutu (ör: 500kb, 25mb); jpg, video ve kayıplı ses çıktılarında")
+	convertCmd.Flags().IntVar(&convertSampleRate, "sample-rate", 0, "Ses örnekleme hızı (Hz, ör: 44100, 48000)")
+	convertCmd.Flags().StringVar(&convertBitDepth, "bit-depth", "", "Ses bit derinliği: 16, 24, 32f (sadece wav/flac)")
+	convertCmd.Flags().IntVar(&convertChannels, "channels", 0, "Ses kanal sayısı (1=mono, 2=stereo)")
+	convertCmd.Flags().StringVar(&convertBitrate, "bitrate", "", "Bitrate: ses çıktısında ses, video çıktısında video bitrate'i (ör: 192k, 4M)")
+	convertCmd.Flags().BoolVar(&convertVBR, "vbr", false, "Değişken bitrate (VBR) kullan")
+	convertCmd.Flags().BoolVar(&convertCBR, "cbr", false, "Sabit bitrate (CBR) kullan")
+	convertCmd.Flags().StringVar(&convertAudioCodec, "audio-codec", "", "Ses codec'i (ses çıktısı: libmp3lame, alac vb.; video ses izi: aac, opus, copy, none)")
+	convertCmd.Flags().StringVar(&convertAudioBR, "audio-bitrate", "", "Video çıktısında ses izi bitrate'i (ör: 128k)")
+	convertCmd.Flags().StringVar(&convertVideoCodec, "video-codec", "", "Video codec: h264, h265, av1, vp9, copy")
+	convertCmd.Flags().IntVar(&convertCRF, "crf", -1, "Sabit kalite değeri (h264/h265: 0-51, av1/vp9: 0-63)")
+	convertCmd.Flags().StringVar(&convertEncPreset, "encode-preset", "", "Encoder hız preset'i (ör: fast, medium, slow)")
+	convertCmd.Flags().StringVar(&convertPixFmt, "pix-fmt", "", "Piksel formatı (ör: yuv420p, yuv420p10le)")
+	addAnimationFlags(convertCmd, &convertAnimation)
+	addTransformFlags(convertCmd, &convertTransform)
 
 	convertCmd.MarkFlagRequired("to")
 
@@ -268,43 +414,3 @@ func formatFileSize(bytes int64) string {
 	}
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
-
-// parseSize "500kb", "2mb" gibi insan okunabilir boyutları byte'a çevirir
-func parseSize(s string) (int64, error) {
-	s = strings.ToLower(strings.TrimSpace(s))
-	if s == "" {
-		return 0, fmt.Errorf("boyut değeri boş")
-	}
-
-	multipliers := map[string]int64{
-		"b":  1,
-		"kb": 1024,
-		"mb": 1024 * 1024,
-		"gb": 1024 * 1024 * 1024,
-	}
-
-	for suffix, mult := range multipliers {
-		if strings.HasSuffix(s, suffix) {
-			numStr := strings.TrimSuffix(s, suffix)
-			numStr = strings.TrimSpace(numStr)
-			var val float64
-			if _, err := fmt.Sscanf(numStr, "%f", &val); err != nil {
-				return 0, fmt.Errorf("geçersiz boyut değeri: %s", s)
-			}
-			if val <= 0 {
-				return 0, fmt.Errorf("boyut sıfırdan büyük olmalı")
-			}
-			return int64(val * float64(mult)), nil
-		}
-	}
-
-	// Saf sayı — byte olarak kabul et
-	var val float64
-	if _, err := fmt.Sscanf(s, "%f", &val); err != nil {
-		return 0, fmt.Errorf("geçersiz boyut değeri: %s", s)
-	}
-	if val <= 0 {
-		return 0, fmt.Errorf("boyut sıfırdan büyük olmalı")
-	}
-	return int64(val), nil
-}
@@ -20,6 +20,7 @@ const (
 	envRetry      = "FILECONVERTER_RETRY"
 	envRetryDelay = "FILECONVERTER_RETRY_DELAY"
 	envReport     = "FILECONVERTER_REPORT"
+	envCacheDir   = "FILECONVERTER_CACHE_DIR"
 )
 
 func applyRootDefaults(cmd *cobra.Command) error {
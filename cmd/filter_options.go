@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
+)
+
+// filterFlagValues batch/watch komutlarının dosya seçim flag değerlerini taşır.
+type filterFlagValues struct {
+	Include        []string
+	Exclude        []string
+	Regex          string
+	ExcludeRegex   string
+	MinSize        string
+	MaxSize        string
+	ModifiedSince  string
+	ModifiedBefore string
+	MinWidth       int
+	MaxWidth       int
+	MinHeight      int
+	MaxHeight      int
+	MinDuration    string
+	MaxDuration    string
+	Hidden         bool
+	NoIgnore       bool
+	Symlinks       string
+}
+
+// Spec flag değerlerini filtre motorunun kural tanımına çevirir.
+func (v filterFlagValues) Spec() filter.Spec {
+	return filter.Spec{
+		Include:        v.Include,
+		Exclude:        v.Exclude,
+		Regex:          v.Regex,
+		ExcludeRegex:   v.ExcludeRegex,
+		MinSize:        v.MinSize,
+		MaxSize:        v.MaxSize,
+		ModifiedSince:  v.ModifiedSince,
+		ModifiedBefore: v.ModifiedBefore,
+		MinWidth:       v.MinWidth,
+		MaxWidth:       v.MaxWidth,
+		MinHeight:      v.MinHeight,
+		MaxHeight:      v.MaxHeight,
+		MinDuration:    v.MinDuration,
+		MaxDuration:    v.MaxDuration,
+		Hidden:         v.Hidden,
+		NoIgnore:       v.NoIgnore,
+		Symlinks:       v.Symlinks,
+	}
+}
+
+// buildFileFilter dosya seçim flag'lerini doğrular ve derlenmiş filtre döner.
+func buildFileFilter(values filterFlagValues) (*filter.Filter, error) {
+	f, err := filter.Compile(values.Spec())
+	if err != nil {
+		return nil, fmt.Errorf("dosya filtresi hatalı: %w", err)
+	}
+	return f, nil
+}
+
+// addFilterFlags dosya seçim flag'lerini komuta ekler.
+func addFilterFlags(cmd *cobra.Command, values *filterFlagValues) {
+	cmd.Flags().StringArrayVar(&values.Include, "include", nil, "Sadece desene uyan dosyaları al (glob, ** destekli; tekrarlanabilir)")
+	cmd.Flags().StringArrayVar(&values.Exclude, "exclude", nil, "Desene uyan dosya ve dizinleri dışla (glob, ** destekli; tekrarlanabilir)")
+	cmd.Flags().StringVar(&values.Regex, "match-regex", "", "Göreli yolu regex'e uyan dosyaları al")
+	cmd.Flags().StringVar(&values.ExcludeRegex, "exclude-regex", "", "Göreli yolu regex'e uyan dosyaları dışla")
+	cmd.Flags().StringVar(&values.MinSize, "min-file-size", "", "Minimum dosya boyutu (ör: 100kb, 2mb)")
+	cmd.Flags().StringVar(&values.MaxSize, "max-file-size", "", "Maksimum dosya boyutu (ör: 500mb)")
+	cmd.Flags().StringVar(&values.ModifiedSince, "modified-since", "", "Bu tarihten sonra değişen dosyalar (YYYY-AA-GG, RFC3339 veya 7d, 12h)")
+	cmd.Flags().StringVar(&values.ModifiedBefore, "modified-before", "", "Bu tarihten önce değişen dosyalar (YYYY-AA-GG, RFC3339 veya 30d)")
+	cmd.Flags().IntVar(&values.MinWidth, "min-width", 0, "Minimum görüntü genişliği (px, probe ile okunur)")
+	cmd.Flags().IntVar(&values.MaxWidth, "max-width", 0, "Maksimum görüntü genişliği (px)")
+	cmd.Flags().IntVar(&values.MinHeight, "min-height", 0, "Minimum görüntü yüksekliği (px)")
+	cmd.Flags().IntVar(&values.MaxHeight, "max-height", 0, "Maksimum görüntü yüksekliği (px)")
+	cmd.Flags().StringVar(&values.MinDuration, "min-duration", "", "Minimum medya süresi (saniye, SS:DD:ss veya 1m30s)")
+	cmd.Flags().StringVar(&values.MaxDuration, "max-duration", "", "Maksimum medya süresi (saniye, SS:DD:ss veya 1m30s)")
+	cmd.Flags().BoolVar(&values.Hidden, "hidden", false, "Gizli (nokta ile başlayan) dosya ve dizinleri de dahil et")
+	cmd.Flags().BoolVar(&values.NoIgnore, "no-ignore", false, ".fileconverterignore dosyalarını yok say")
+	cmd.Flags().StringVar(&values.Symlinks, "symlinks", filter.SymlinksFiles, "Sembolik bağlantı politikası: skip, files, follow")
+}
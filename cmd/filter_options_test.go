@@ -0,0 +1,26 @@
+package cmd
+
+import "testing"
+
+func TestFilterFlagsRegistered(t *testing.T) {
+	for _, name := range []string{"include", "exclude", "match-regex", "exclude-regex", "min-file-size", "max-file-size", "modified-since", "modified-before", "min-width", "max-width", "min-height", "max-height", "min-duration", "max-duration", "hidden", "no-ignore", "symlinks"} {
+		if batchCmd.Flags().Lookup(name) == nil {
+			t.Errorf("batch missing --%s", name)
+		}
+		if watchCmd.Flags().Lookup(name) == nil {
+			t.Errorf("watch missing --%s", name)
+		}
+	}
+}
+
+func TestBuildFileFilter(t *testing.T) {
+	if _, err := buildFileFilter(filterFlagValues{Include: []string{"**/*.png"}, MinSize: "10kb", Symlinks: "follow"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := buildFileFilter(filterFlagValues{Symlinks: "sometimes"}); err == nil {
+		t.Fatalf("expected error for invalid symlink policy")
+	}
+	if _, err := buildFileFilter(filterFlagValues{ModifiedSince: "dün"}); err == nil {
+		t.Fatalf("expected error for invalid date")
+	}
+}
@@ -3,17 +3,22 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 
 	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/inventory"
 	"github.com/mlihgenel/fileconverter-cli/internal/ui"
 )
 
 var infoCmd = &cobra.Command{
-	Use:   "info <dosya>",
+	Use:   "info <dosya|dizin>",
 	Short: "Dosya hakkında detaylı bilgi göster",
 	Long: `Bir dosyanın format, boyut, çözünürlük, codec ve metadata bilgilerini gösterir.
 
@@ -22,11 +27,39 @@ var infoCmd = &cobra.Command{
   fileconverter-cli info video.mp4
   fileconverter-cli info ses.mp3
   fileconverter-cli info belge.pdf
-  fileconverter-cli info foto.jpg --output-format json`,
+  fileconverter-cli info foto.jpg --output-format json
+  fileconverter-cli info film.mkv --streams
+  fileconverter-cli info kitap.m4v --chapters --output-format json
+
+Video ve ses dosyalarında her akış (index, tür, codec, profil/level, dil, bitrate,
+piksel formatı, renk bilgisi/HDR, döndürme ve yan veriler), bölümler ve kapsayıcı
+etiketleri tablo olarak listelenir. --streams ve --chapters sadece ilgili bölümü gösterir.
+
+Dizin verildiğinde envanter modu çalışır: her dosya için format, kategori, boyut, boyutlar,
+süre ve codec satırı ile kategori/format/codec bazında toplamlar, en büyük dosyalar ve
+çözülemeyen dosyalar raporlanır. Dosyalar --workers ile paralel okunur.
+
+  fileconverter-cli info ./arsiv --recursive
+  fileconverter-cli info ./arsiv -r --report html --report-file envanter.html
+  fileconverter-cli info ./arsiv -r --report csv > envanter.csv`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		filePath := args[0]
 
+		if st, err := os.Stat(filePath); err == nil && st.IsDir() {
+			if infoStreams || infoChapters {
+				return fmt.Errorf("--streams ve --chapters dizin envanterinde kullanılamaz")
+			}
+			return runInfoInventory(filePath)
+		}
+		if infoRecursive || cmd.Flags().Changed("report") {
+			return fmt.Errorf("--recursive ve --report sadece dizin envanterinde kullanılabilir")
+		}
+
+		if infoStreams || infoChapters {
+			return runInfoDetails(filePath, infoStreams, infoChapters)
+		}
+
 		info, err := converter.GetFileInfo(filePath)
 		if err != nil {
 			ui.PrintError(err.Error())
@@ -43,14 +76,361 @@ var infoCmd = &cobra.Command{
 		}
 
 		printFileInfo(info)
+		printMediaDetailTables(info.Streams, info.Chapters, info.Tags)
 		return nil
 	},
 }
 
+var (
+	infoStreams    bool
+	infoChapters   bool
+	infoRecursive  bool
+	infoReport     string
+	infoReportFile string
+)
+
 func init() {
+	infoCmd.Flags().BoolVar(&infoStreams, "streams", false, "Sadece akış listesini göster")
+	infoCmd.Flags().BoolVar(&infoChapters, "chapters", false, "Sadece bölüm listesini göster")
+	infoCmd.Flags().BoolVarP(&infoRecursive, "recursive", "r", false, "Dizin envanterinde alt klasörleri de tara")
+	infoCmd.Flags().StringVar(&infoReport, "report", inventory.FormatOff, "Envanter rapor formatı: off, json, csv, html")
+	infoCmd.Flags().StringVar(&infoReportFile, "report-file", "", "Envanter raporunu belirtilen dosyaya yaz")
 	rootCmd.AddCommand(infoCmd)
 }
 
+// runInfoInventory dizindeki dosyaları paralel okuyup envanter ve istatistik raporu üretir.
+func runInfoInventory(dir string) error {
+	jsonOutput := isJSONOutput()
+	reportFormat := inventory.NormalizeFormat(infoReport)
+	if reportFormat == "" {
+		return fmt.Errorf("gecersiz report formati: %s (off, json, csv, html)", infoReport)
+	}
+	if strings.TrimSpace(infoReportFile) != "" && reportFormat == inventory.FormatOff {
+		return fmt.Errorf("--report-file için --report json, csv veya html belirtin")
+	}
+
+	paths, err := inventory.CollectPaths(dir, infoRecursive)
+	if err != nil {
+		return err
+	}
+	started := time.Now()
+	entries := inventory.Scan(paths, workers, converter.GetFileInfo)
+	report := inventory.BuildReport(dir, infoRecursive, entries, time.Now())
+
+	if reportFormat != inventory.FormatOff {
+		text, err := inventory.Render(reportFormat, report)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(infoReportFile) == "" {
+			fmt.Print(text)
+			return nil
+		}
+		if err := writeBatchReport(infoReportFile, text); err != nil {
+			ui.PrintError(fmt.Sprintf("Rapor dosyaya yazılamadı: %s", err.Error()))
+			return err
+		}
+		if !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("Rapor yazıldı: %s", infoReportFile))
+		}
+	}
+	if jsonOutput {
+		return printJSON(report)
+	}
+	printInventorySummary(report)
+	ui.PrintDuration(time.Since(started))
+	return nil
+}
+
+func printInventorySummary(report inventory.Report) {
+	stats := report.Stats
+	ui.PrintInfo(fmt.Sprintf("Envanter: %s — %d dosya, %s, toplam süre %s, çözülemeyen %d",
+		report.Root, stats.Files, inventory.HumanSize(stats.Size), valueOrDash(inventory.HumanDuration(stats.Duration)), stats.Failed))
+	if stats.Files == 0 {
+		return
+	}
+	printInventoryGroups("Kategoriye göre", stats.ByCategory)
+	printInventoryGroups("Formata göre", stats.ByFormat)
+	printInventoryGroups("Video codec'e göre", stats.ByVideoCodec)
+	printInventoryGroups("Ses codec'e göre", stats.ByAudioCodec)
+
+	fmt.Println()
+	ui.PrintInfo("En büyük dosyalar")
+	rows := make([][]string, 0, len(stats.Largest))
+	for _, e := range stats.Largest {
+		rows = append(rows, []string{e.Path, valueOrDash(e.Format), inventory.HumanSize(e.Size)})
+	}
+	ui.PrintTable([]string{"Dosya", "Format", "Boyut"}, rows)
+
+	if len(stats.Failures) > 0 {
+		fmt.Println()
+		ui.PrintWarning(fmt.Sprintf("Çözülemeyen dosyalar (%d)", len(stats.Failures)))
+		rows = rows[:0]
+		for _, e := range stats.Failures {
+			rows = append(rows, []string{e.Path, e.Error})
+		}
+		ui.PrintTable([]string{"Dosya", "Hata"}, rows)
+	}
+}
+
+func printInventoryGroups(title string, groups []inventory.Group) {
+	if len(groups) == 0 {
+		return
+	}
+	fmt.Println()
+	ui.PrintInfo(title)
+	ui.PrintTable([]string{"Anahtar", "Adet", "Boyut", "Süre"}, inventoryGroupRows(groups))
+}
+
+func inventoryGroupRows(groups []inventory.Group) [][]string {
+	rows := make([][]string, 0, len(groups))
+	for _, g := range groups {
+		rows = append(rows, []string{g.Key, strconv.Itoa(g.Count), inventory.HumanSize(g.Size), valueOrDash(inventory.HumanDuration(g.Duration))})
+	}
+	return rows
+}
+
+// runInfoDetails --streams/--chapters ile istenen bölümleri ffprobe'dan okuyup gösterir.
+func runInfoDetails(filePath string, showStreams bool, showChapters bool) error {
+	info, err := converter.GetFileInfo(filePath)
+	if err != nil {
+		ui.PrintError(err.Error())
+		return err
+	}
+	if info.Category != "video" && info.Category != "audio" {
+		return fmt.Errorf("akış ve bölüm bilgisi sadece video/ses dosyaları için gösterilebilir: %s", filePath)
+	}
+	details, err := converter.ProbeMediaDetails(filePath)
+	if err != nil {
+		return err
+	}
+
+	if isJSONOutput() {
+		return printJSON(buildInfoDetailsJSON(filePath, details, showStreams, showChapters))
+	}
+	if showStreams {
+		if len(details.Streams) == 0 {
+			ui.PrintInfo("Akış bulunamadı.")
+		} else {
+			printStreamsTable(details.Streams)
+		}
+	}
+	if showChapters {
+		if len(details.Chapters) == 0 {
+			ui.PrintInfo("Bölüm bulunamadı.")
+		} else {
+			printChaptersTable(details.Chapters)
+		}
+	}
+	return nil
+}
+
+// buildInfoDetailsJSON istenen bölümleri her zaman dizi olarak içeren kararlı JSON çıktısını üretir.
+func buildInfoDetailsJSON(filePath string, details converter.MediaDetails, showStreams bool, showChapters bool) map[string]interface{} {
+	payload := map[string]interface{}{"path": filePath}
+	if showStreams {
+		streams := details.Streams
+		if streams == nil {
+			streams = []converter.StreamInfo{}
+		}
+		payload["streams"] = streams
+	}
+	if showChapters {
+		chapters := details.Chapters
+		if chapters == nil {
+			chapters = []converter.Chapter{}
+		}
+		payload["chapters"] = chapters
+	}
+	return payload
+}
+
+func printMediaDetailTables(streams []converter.StreamInfo, chapters []converter.Chapter, tags map[string]string) {
+	if len(streams) > 0 {
+		printStreamsTable(streams)
+	}
+	if len(chapters) > 0 {
+		printChaptersTable(chapters)
+	}
+	if len(tags) > 0 {
+		fmt.Println()
+		ui.PrintInfo("Etiketler")
+		ui.PrintTable([]string{"Anahtar", "Değer"}, tagTableRows(tags))
+	}
+}
+
+func printStreamsTable(streams []converter.StreamInfo) {
+	fmt.Println()
+	ui.PrintInfo(fmt.Sprintf("Akışlar (%d)", len(streams)))
+	ui.PrintTable([]string{"#", "Tür", "Codec", "Profil", "Dil", "Ayrıntı", "Bitrate", "Renk", "Bayraklar"}, streamTableRows(streams))
+}
+
+func printChaptersTable(chapters []converter.Chapter) {
+	fmt.Println()
+	ui.PrintInfo(fmt.Sprintf("Bölümler (%d)", len(chapters)))
+	ui.PrintTable([]string{"#", "Başlangıç", "Bitiş", "Süre", "Başlık"}, chapterTableRows(chapters))
+}
+
+func streamTableRows(streams []converter.StreamInfo) [][]string {
+	rows := make([][]string, 0, len(streams))
+	for _, s := range streams {
+		lang := s.Language
+		if lang == "" {
+			lang = "-"
+		}
+		bitrate := "-"
+		if s.BitRate > 0 {
+			bitrate = fmt.Sprintf("%d kbps", s.BitRate/1000)
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(s.Index),
+			streamTypeLabel(s.Type),
+			valueOrDash(s.Codec),
+			valueOrDash(formatStreamProfile(s)),
+			lang,
+			valueOrDash(formatStreamDetail(s)),
+			bitrate,
+			valueOrDash(formatStreamColor(s)),
+			valueOrDash(formatStreamFlags(s)),
+		})
+	}
+	return rows
+}
+
+func chapterTableRows(chapters []converter.Chapter) [][]string {
+	rows := make([][]string, 0, len(chapters))
+	for _, c := range chapters {
+		rows = append(rows, []string{
+			strconv.Itoa(c.Index),
+			formatTrimSecondsHuman(c.Start),
+			formatTrimSecondsHuman(c.End),
+			formatTrimSecondsHuman(c.End - c.Start),
+			valueOrDash(c.Title),
+		})
+	}
+	return rows
+}
+
+func tagTableRows(tags map[string]string) [][]string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	rows := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, []string{k, tags[k]})
+	}
+	return rows
+}
+
+func streamTypeLabel(streamType string) string {
+	switch streamType {
+	case "video":
+		return "Video"
+	case "audio":
+		return "Ses"
+	case "subtitle":
+		return "Altyazı"
+	case "attachment":
+		return "Ek"
+	case "data":
+		return "Veri"
+	default:
+		return valueOrDash(streamType)
+	}
+}
+
+// formatStreamProfile profil ve level'ı okunur biçimde birleştirir (ör: High@4.1, Main 10@5.1).
+func formatStreamProfile(s converter.StreamInfo) string {
+	if s.Level <= 0 {
+		return s.Profile
+	}
+	level := strconv.Itoa(s.Level)
+	switch s.Codec {
+	case "h264":
+		level = strconv.FormatFloat(float64(s.Level)/10, 'f', 1, 64)
+	case "hevc":
+		level = strconv.FormatFloat(float64(s.Level)/30, 'f', 1, 64)
+	}
+	if s.Profile == "" {
+		return "L" + level
+	}
+	return s.Profile + "@" + level
+}
+
+func formatStreamDetail(s converter.StreamInfo) string {
+	var parts []string
+	switch s.Type {
+	case "video":
+		if s.Width > 0 && s.Height > 0 {
+			parts = append(parts, fmt.Sprintf("%dx%d", s.Width, s.Height))
+		}
+		if s.PixFmt != "" {
+			parts = append(parts, s.PixFmt)
+		}
+		if s.FPS > 0 {
+			parts = append(parts, strconv.FormatFloat(s.FPS, 'f', -1, 64)+" fps")
+		}
+		if s.Rotation != 0 {
+			parts = append(parts, fmt.Sprintf("döndürme %d°", s.Rotation))
+		}
+	case "audio":
+		if s.SampleRate > 0 {
+			parts = append(parts, fmt.Sprintf("%d Hz", s.SampleRate))
+		}
+		if s.ChannelLayout != "" {
+			parts = append(parts, s.ChannelLayout)
+		} else if s.Channels > 0 {
+			parts = append(parts, fmt.Sprintf("%d kanal", s.Channels))
+		}
+	case "attachment":
+		if name := s.Tags["filename"]; name != "" {
+			parts = append(parts, name)
+		}
+		if mime := s.Tags["mimetype"]; mime != "" {
+			parts = append(parts, mime)
+		}
+	}
+	if s.Title != "" && s.Type != "attachment" {
+		parts = append(parts, fmt.Sprintf("%q", s.Title))
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatStreamColor(s converter.StreamInfo) string {
+	var parts []string
+	if s.ColorPrimaries != "" || s.ColorTransfer != "" {
+		parts = append(parts, valueOrDash(s.ColorPrimaries)+"/"+valueOrDash(s.ColorTransfer))
+	}
+	if s.ColorRange != "" {
+		parts = append(parts, s.ColorRange)
+	}
+	if s.HDR != "" {
+		parts = append(parts, s.HDR)
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatStreamFlags(s converter.StreamInfo) string {
+	var flags []string
+	if s.Default {
+		flags = append(flags, "varsayılan")
+	}
+	if s.Forced {
+		flags = append(flags, "zorunlu")
+	}
+	flags = append(flags, s.SideData...)
+	return strings.Join(flags, ", ")
+}
+
+func valueOrDash(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "-"
+	}
+	return value
+}
+
 func printFileInfo(info converter.FileInfo) {
 	headerStyle := lipgloss.NewStyle().
 		Bold(true).
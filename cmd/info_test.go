@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestFormatStreamProfile(t *testing.T) {
+	cases := []struct {
+		stream converter.StreamInfo
+		want   string
+	}{
+		{converter.StreamInfo{Codec: "h264", Profile: "High", Level: 41}, "High@4.1"},
+		{converter.StreamInfo{Codec: "hevc", Profile: "Main 10", Level: 153}, "Main 10@5.1"},
+		{converter.StreamInfo{Codec: "aac", Profile: "LC"}, "LC"},
+		{converter.StreamInfo{Codec: "vp9", Level: 30}, "L30"},
+	}
+	for _, tc := range cases {
+		if got := formatStreamProfile(tc.stream); got != tc.want {
+			t.Fatalf("formatStreamProfile(%+v) = %q, want %q", tc.stream, got, tc.want)
+		}
+	}
+}
+
+func TestStreamTableRows(t *testing.T) {
+	rows := streamTableRows([]converter.StreamInfo{
+		{Index: 0, Type: "video", Codec: "hevc", Width: 3840, Height: 2160, PixFmt: "yuv420p10le", FPS: 23.976, Rotation: 90,
+			ColorPrimaries: "bt2020", ColorTransfer: "smpte2084", HDR: "HDR10", Default: true, SideData: []string{"Display Matrix"}},
+		{Index: 1, Type: "audio", Codec: "aac", Language: "tur", SampleRate: 48000, Channels: 2, BitRate: 128000},
+	})
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	video := strings.Join(rows[0], "|")
+	for _, want := range []string{"Video", "3840x2160 yuv420p10le 23.976 fps döndürme 90°", "bt2020/smpte2084 HDR10", "varsayılan, Display Matrix"} {
+		if !strings.Contains(video, want) {
+			t.Fatalf("video row missing %q: %s", want, video)
+		}
+	}
+	audio := strings.Join(rows[1], "|")
+	if audio != "1|Ses|aac|-|tur|48000 Hz 2 kanal|128 kbps|-|-" {
+		t.Fatalf("unexpected audio row: %s", audio)
+	}
+}
+
+func TestBuildInfoDetailsJSONUsesStableArrays(t *testing.T) {
+	payload := buildInfoDetailsJSON("film.mkv", converter.MediaDetails{}, true, true)
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if string(data) != `{"chapters":[],"path":"film.mkv","streams":[]}` {
+		t.Fatalf("unexpected json: %s", data)
+	}
+
+	payload = buildInfoDetailsJSON("film.mkv", converter.MediaDetails{Chapters: []converter.Chapter{{Index: 1, End: 5}}}, false, true)
+	if _, ok := payload["streams"]; ok {
+		t.Fatalf("streams should be omitted when only --chapters is requested")
+	}
+}
+
+func TestTagTableRowsSorted(t *testing.T) {
+	rows := tagTableRows(map[string]string{"title": "Film", "encoder": "x", "artist": "A"})
+	if rows[0][0] != "artist" || rows[1][0] != "encoder" || rows[2][0] != "title" {
+		t.Fatalf("tags should be sorted by key: %v", rows)
+	}
+}
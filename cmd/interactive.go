@@ -16,6 +16,7 @@ import (
 	"github.com/mlihgenel/fileconverter-cli/internal/batch"
 	"github.com/mlihgenel/fileconverter-cli/internal/config"
 	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 	"github.com/mlihgenel/fileconverter-cli/internal/installer"
 	convwatch "github.com/mlihgenel/fileconverter-cli/internal/watch"
 )
@@ -120,7 +121,7 @@ var categories = []formatCategory{
 	{Name: "Belgeler", Icon: "📄", Desc: "MD, HTML, PDF, DOCX, TXT, ODT, RTF, CSV", Formats: []string{"md", "html", "pdf", "docx", "txt", "odt", "rtf", "csv"}},
 	{Name: "Ses Dosyaları", Icon: "🎵", Desc: "MP3, WAV, OGG, FLAC, AAC, M4A, WMA, OPUS, WEBM", Formats: []string{"mp3", "wav", "ogg", "flac", "aac", "m4a", "wma", "opus", "webm"}},
 	{Name: "Görseller", Icon: "🖼️ ", Desc: "PNG, JPEG, WEBP, BMP, GIF, TIFF, ICO, HEIC, HEIF", Formats: []string{"png", "jpg", "webp", "bmp", "gif", "tif", "ico", "heic", "heif"}},
-	{Name: "Video Dosyaları", Icon: "🎬", Desc: "MP4, MOV, MKV, AVI, WEBM, M4V, WMV, FLV (GIF'e dönüştürme dahil)", Formats: []string{"mp4", "mov", "mkv", "avi", "webm", "m4v", "wmv", "flv"}},
+	{Name: "Video Dosyaları", Icon: "🎬", Desc: "MP4, MOV, MKV, AVI, WEBM, M4V, WMV, FLV (GIF ve animasyonlu WebP dahil)", Formats: []string{"mp4", "mov", "mkv", "avi", "webm", "m4v", "wmv", "flv"}},
 }
 
 type mainMenuAction string
@@ -275,6 +276,7 @@ const (
 	stateResizeManualUnit
 	stateResizeManualDPI
 	stateResizeModeSelect
+	stateVideoTransformSelect
 	stateWatching
 	stateVideoTrimMode
 	stateVideoTrimStart
@@ -288,6 +290,7 @@ const (
 	stateExtractAudioTarget
 	stateExtractAudioQuality
 	stateExtractAudioCopy
+	stateSnapshotMode
 	stateSnapshotTime
 	stateSnapshotTarget
 	stateSnapshotQuality
@@ -418,6 +421,10 @@ type interactiveModel struct {
 	resizeDPIInput      string
 	resizeValidationErr string
 
+	// Video kırpma/döndürme (boyutlandırma akışı)
+	videoTransform    *converter.TransformSpec
+	videoTransformKey string
+
 	// Video trim
 	trimStartInput     string
 	trimDurationInput  string
@@ -446,6 +453,7 @@ type interactiveModel struct {
 	extractAudioCopyMode     bool
 
 	// Snapshot
+	snapshotMode         string
 	snapshotTimeInput    string
 	snapshotQualityInput string
 
@@ -1103,6 +1111,8 @@ func (m interactiveModel) View() string {
 		return m.viewResizeNumericInput("DPI Değeri", m.resizeDPIInput, "Örnek: 300 (cm için önerilir)")
 	case stateResizeModeSelect:
 		return m.viewResizeModeSelect()
+	case stateVideoTransformSelect:
+		return m.viewVideoTransformSelect()
 	case stateWatching:
 		return m.viewWatching()
 	case stateVideoTrimMode:
@@ -1128,6 +1138,8 @@ func (m interactiveModel) View() string {
 		return m.viewExtractAudioQuality()
 	case stateExtractAudioCopy:
 		return m.viewExtractAudioCopy()
+	case stateSnapshotMode:
+		return m.viewSnapshotMode()
 	case stateSnapshotTime:
 		return m.viewSnapshotTime()
 	case stateSnapshotTarget:
@@ -1476,6 +1488,10 @@ func (m interactiveModel) viewFileBrowser() string {
 		b.WriteString(dimStyle.Render(fmt.Sprintf("  Boyutlandırma: %s", m.resizeSummary())))
 		b.WriteString("\n")
 	}
+	if m.videoTransform != nil {
+		b.WriteString(dimStyle.Render(fmt.Sprintf("  Kırpma/döndürme: %s", m.videoTransformSummary())))
+		b.WriteString("\n")
+	}
 
 	return b.String()
 }
@@ -1634,7 +1650,7 @@ func (m interactiveModel) viewFormats() string {
 	docFormats := map[string]bool{"md": true, "html": true, "pdf": true, "docx": true, "txt": true, "odt": true, "rtf": true, "csv": true}
 	audioFormats := map[string]bool{"mp3": true, "wav": true, "ogg": true, "flac": true, "aac": true, "m4a": true, "wma": true, "opus": true, "webm": true}
 	imgFormats := map[string]bool{"png": true, "jpg": true, "webp": true, "bmp": true, "gif": true, "tif": true, "ico": true, "heic": true, "heif": true}
-	videoFormats := map[string]bool{"mp4": true, "mov": true, "mkv": true, "avi": true, "webm": true, "m4v": true, "wmv": true, "flv": true, "gif": true}
+	videoFormats := map[string]bool{"mp4": true, "mov": true, "mkv": true, "avi": true, "webm": true, "m4v": true, "wmv": true, "flv": true, "gif": true, "webp": true}
 
 	ffmpegStatus := "Var"
 	ffmpegStyle := successStyle
@@ -1679,7 +1695,7 @@ func (m interactiveModel) viewFormats() string {
 	b.WriteString("\n")
 	b.WriteString(renderFormatGroupCard("🖼️ Görsel", imgFormats, pairs, "Yaygın görsel formatları arasında çapraz dönüşüm desteklenir."))
 	b.WriteString("\n")
-	b.WriteString(renderFormatGroupCard("🎬 Video", videoFormats, pairs, "Video dönüştürme ve GIF üretimi FFmpeg ile yapılır."))
+	b.WriteString(renderFormatGroupCard("🎬 Video", videoFormats, pairs, "Video dönüştürme, GIF ve animasyonlu WebP üretimi FFmpeg ile yapılır."))
 
 	b.WriteString("\n\n")
 	b.WriteString(infoStyle.Render("  Hızlı İpucu: Ana menüden önce bölüm seç, sonra ilgili işlemi başlat."))
@@ -1904,11 +1920,8 @@ func (m interactiveModel) handleEnter() (tea.Model, tea.Cmd) {
 						m.cursor = 0
 						return m, nil
 					}
-					m.snapshotTimeInput = "00:00:01"
 					m.snapshotQualityInput = "0"
-					m.state = stateSnapshotTime
-					m.cursor = 0
-					return m, nil
+					return m.goToSnapshotMode(), nil
 				}
 				if m.flowAudioNormalize {
 					if depName, toolName := m.checkRequiredDep(); depName != "" {
@@ -2056,6 +2069,9 @@ func (m interactiveModel) handleEnter() (tea.Model, tea.Cmd) {
 		m.resizeValidationErr = ""
 		return m.proceedAfterResizeSelection()
 
+	case stateVideoTransformSelect:
+		return m.selectVideoTransform()
+
 	case stateVideoTrimMode:
 		if m.cursor == 1 {
 			m.trimMode = trimModeRemove
@@ -2241,6 +2257,10 @@ func (m interactiveModel) handleEnter() (tea.Model, tea.Cmd) {
 		m.state = stateConverting
 		return m, m.doExtractAudio()
 
+	case stateSnapshotMode:
+		m = m.selectSnapshotMode(m.cursor)
+		return m, nil
+
 	case stateSnapshotTime:
 		m.state = stateSnapshotTarget
 		m.cursor = 0
@@ -2579,7 +2599,7 @@ func (m interactiveModel) goBack() interactiveModel {
 			return m.goToParentSection()
 		}
 		if m.flowResizeOnly {
-			return m.goToResizeConfig(false)
+			return m.goBackFromResizeFlow(false)
 		}
 		return m.goToTargetFormatSelect(false)
 	case stateBatchSelectCategory:
@@ -2590,7 +2610,7 @@ func (m interactiveModel) goBack() interactiveModel {
 		return m.goToSourceFormatSelect(true)
 	case stateBatchBrowser:
 		if m.flowResizeOnly {
-			return m.goToResizeConfig(true)
+			return m.goBackFromResizeFlow(true)
 		}
 		return m.goToTargetFormatSelect(true)
 	case stateResizeConfig:
@@ -2605,6 +2625,11 @@ func (m interactiveModel) goBack() interactiveModel {
 		return m.goToResizeManualHeight()
 	case stateResizeManualDPI:
 		return m.goToResizeManualUnitSelect()
+	case stateVideoTransformSelect:
+		if m.resizeMethod == "preset" || m.resizeMethod == "manual" {
+			return m.goToResizeModeSelect()
+		}
+		return m.goToResizeConfig(m.resizeIsBatchFlow)
 	case stateResizeModeSelect:
 		if m.resizeMethod == "preset" {
 			return m.goToResizePresetSelect()
@@ -2667,7 +2692,7 @@ func (m interactiveModel) goBack() interactiveModel {
 		}
 		return m
 
-	case stateExtractAudioTarget, stateSnapshotTime, stateAudioNormalizeTarget:
+	case stateExtractAudioTarget, stateSnapshotMode, stateAudioNormalizeTarget:
 		m.state = stateFileBrowser
 		m.cursor = 0
 		return m
@@ -2693,6 +2718,8 @@ func (m interactiveModel) goBack() interactiveModel {
 			"Maksimum kalite",
 		}
 		return m
+	case stateSnapshotTime:
+		return m.goToSnapshotMode()
 	case stateSnapshotTarget:
 		m.state = stateSnapshotTime
 		m.cursor = 0
@@ -2945,27 +2972,32 @@ func (m *interactiveModel) loadBrowserItems() {
 	var dirs []browserEntry
 	var files []browserEntry
 
+	browserFilter := filter.Default()
 	for _, e := range entries {
-		if strings.HasPrefix(e.Name(), ".") {
-			continue // Gizli dosyaları atla
-		}
-
-		fullPath := filepath.Join(m.browserDir, e.Name())
-
 		if e.IsDir() {
+			// Klasörler de dosyalarla aynı kurallardan geçer (gizli klasörler, .fileconverterignore).
+			if browserFilter.Ignored(m.browserDir, e.Name(), true) {
+				continue
+			}
 			dirs = append(dirs, browserEntry{
 				name:  e.Name(),
-				path:  fullPath,
+				path:  filepath.Join(m.browserDir, e.Name()),
 				isDir: true,
 			})
-		} else if m.isAllowedFileBrowserItem(e.Name()) {
+		}
+	}
+
+	// Dosyalar batch/watch ile aynı seçim kurallarından geçer (gizli dosyalar, .fileconverterignore).
+	_ = browserFilter.Walk(m.browserDir, false, func(path, rel string, _ os.FileInfo) error {
+		if m.isAllowedFileBrowserItem(rel) {
 			files = append(files, browserEntry{
-				name:  e.Name(),
-				path:  fullPath,
+				name:  rel,
+				path:  path,
 				isDir: false,
 			})
 		}
-	}
+		return nil
+	})
 
 	// Önce klasörler, sonra dosyalar
 	m.browserItems = append(m.browserItems, dirs...)
@@ -3013,7 +3045,7 @@ func (m interactiveModel) doConvert() tea.Cmd {
 				output:   fmt.Sprintf("Atlandı (çakışma): %s", resolvedOutput),
 			}
 		}
-		opts := converter.Options{Quality: m.defaultQuality, Verbose: false, Resize: m.resizeSpec}
+		opts := converter.Options{Quality: m.defaultQuality, Verbose: false, Resize: m.resizeSpec, Transform: m.videoTransform}
 
 		// Çıktı dizininin var olduğundan emin ol
 		os.MkdirAll(filepath.Dir(resolvedOutput), 0755)
@@ -3035,13 +3067,7 @@ func (m interactiveModel) doBatchConvert() tea.Cmd {
 	return func() tea.Msg {
 		start := time.Now()
 
-		var files []string
-		entries, _ := os.ReadDir(scanDir)
-		for _, e := range entries {
-			if !e.IsDir() && converter.HasFormatExtension(e.Name(), m.sourceFormat) {
-				files = append(files, filepath.Join(scanDir, e.Name()))
-			}
-		}
+		files, _ := batch.CollectFiles(scanDir, m.sourceFormat, false, nil)
 
 		succeeded := 0
 		skipped := 0
@@ -3064,9 +3090,10 @@ func (m interactiveModel) doBatchConvert() tea.Cmd {
 				To:         m.targetFormat,
 				SkipReason: skipReason,
 				Options: converter.Options{
-					Quality: m.defaultQuality,
-					Verbose: false,
-					Resize:  m.resizeSpec,
+					Quality:   m.defaultQuality,
+					Verbose:   false,
+					Resize:    m.resizeSpec,
+					Transform: m.videoTransform,
 				},
 			})
 		}
@@ -3721,6 +3748,10 @@ func (m interactiveModel) viewBatchBrowser() string {
 		b.WriteString(dimStyle.Render(fmt.Sprintf("  Boyutlandirma: %s", m.resizeSummary())))
 		b.WriteString("\n")
 	}
+	if m.videoTransform != nil {
+		b.WriteString(dimStyle.Render(fmt.Sprintf("  Kirpma/dondurme: %s", m.videoTransformSummary())))
+		b.WriteString("\n")
+	}
 
 	return b.String()
 }
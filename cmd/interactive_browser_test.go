@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
+)
+
+func TestLoadBrowserItemsAppliesIgnoreRulesToDirectories(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"fotograflar", "tmp", ".cache"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, content := range map[string]string{
+		filter.IgnoreFileName: "tmp/\n*.psd\n",
+		"a.png":               "x",
+		"b.psd":               "x",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := newInteractiveModel(nil, false)
+	m.browserDir = dir
+	m.sourceFormat = "png"
+	m.loadBrowserItems()
+
+	var names []string
+	for _, item := range m.browserItems {
+		if item.path == filepath.Dir(dir) {
+			continue // üst dizin
+		}
+		names = append(names, item.name)
+	}
+	want := []string{"fotograflar", "a.png"}
+	if len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Fatalf("unexpected browser items: %v, want %v", names, want)
+	}
+}
@@ -73,11 +73,11 @@ func TestGoBackFromMissingDependencyReturnsFileBrowser(t *testing.T) {
 	}
 }
 
-func TestEscKeyFromSnapshotTimeReturnsFileBrowser(t *testing.T) {
+func TestEscKeyFromSnapshotModeReturnsFileBrowser(t *testing.T) {
 	dir := t.TempDir()
 	m := newInteractiveModel(nil, false)
 	m.mainSection = "video"
-	m.state = stateSnapshotTime
+	m.state = stateSnapshotMode
 	m.flowSnapshot = true
 	m.browserDir = dir
 	m.selectedCategory = videoCategoryIndex()
@@ -94,3 +94,24 @@ func TestEscKeyFromSnapshotTimeReturnsFileBrowser(t *testing.T) {
 		t.Fatalf("expected stateFileBrowser, got %v", next.state)
 	}
 }
+
+func TestEscKeyFromSnapshotTimeReturnsModeSelection(t *testing.T) {
+	m := newInteractiveModel(nil, false)
+	m.mainSection = "video"
+	m.state = stateSnapshotTime
+	m.flowSnapshot = true
+	m.snapshotMode = snapshotModeSheet
+	m.selectedCategory = videoCategoryIndex()
+
+	nextModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	next, ok := nextModel.(interactiveModel)
+	if !ok {
+		t.Fatalf("unexpected model type")
+	}
+	if next.state != stateSnapshotMode {
+		t.Fatalf("expected stateSnapshotMode, got %v", next.state)
+	}
+	if next.cursor != len(snapshotModeOptions)-1 {
+		t.Fatalf("expected cursor on previously selected mode, got %d", next.cursor)
+	}
+}
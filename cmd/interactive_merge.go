@@ -64,7 +64,7 @@ func (m interactiveModel) doMerge() tea.Cmd {
 		if canConcatDemux {
 			err = runMergeConcatDemuxer(m.mergeFiles, resolvedOutput, converter.MetadataAuto, false)
 		} else {
-			err = runMergeReencode(m.mergeFiles, resolvedOutput, targetFormat, quality, converter.MetadataAuto, false)
+			err = runMergeReencode(m.mergeFiles, resolvedOutput, targetFormat, quality, nil, converter.MetadataAuto, false)
 		}
 		return convertDoneMsg{
 			err:      err,
@@ -36,6 +36,8 @@ func (m *interactiveModel) resetResizeState() {
 	m.resizeUnit = "px"
 	m.resizeDPIInput = "96"
 	m.resizeValidationErr = ""
+	m.videoTransform = nil
+	m.videoTransformKey = ""
 }
 
 func (m interactiveModel) canConfigureResize() bool {
@@ -194,7 +196,15 @@ func (m interactiveModel) goToBatchBrowserOrDependencyCheck() (tea.Model, tea.Cm
 	return m, nil
 }
 
+// proceedAfterResizeSelection video kaynaklarında önce kırpma/döndürme adımını açar.
 func (m interactiveModel) proceedAfterResizeSelection() (tea.Model, tea.Cmd) {
+	if m.canConfigureVideoTransform() {
+		return m.goToVideoTransformSelect(), nil
+	}
+	return m.proceedAfterTransformSelection()
+}
+
+func (m interactiveModel) proceedAfterTransformSelection() (tea.Model, tea.Cmd) {
 	if m.resizeIsBatchFlow {
 		return m.goToBatchBrowserOrDependencyCheck()
 	}
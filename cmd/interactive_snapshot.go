@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ func (m interactiveModel) goToSnapshotBrowser() interactiveModel {
 	m.selectedFile = ""
 	m.selectedCategory = videoCategoryIndex()
 
+	m.snapshotMode = snapshotModeSingle
 	m.snapshotTimeInput = "00:00:01"
 	m.snapshotQualityInput = "0"
 
@@ -38,6 +40,76 @@ func (m interactiveModel) goToSnapshotBrowser() interactiveModel {
 	return m
 }
 
+// snapshotModeOptions TUI'de sunulan snapshot modları ve varsayılan girdileri.
+var snapshotModeOptions = []struct {
+	Mode    string
+	Label   string
+	Desc    string
+	Default string
+}{
+	{Mode: snapshotModeSingle, Label: "Tek Kare", Desc: "Belirli bir anda tek kare yakalar", Default: "00:00:01"},
+	{Mode: snapshotModeEvery, Label: "Aralıklı Kareler", Desc: "Her N saniyede bir kare çıkarır", Default: "10"},
+	{Mode: snapshotModeCount, Label: "Eşit Aralıklı N Kare", Desc: "Video boyunca eşit dağılmış N kare çıkarır", Default: "12"},
+	{Mode: snapshotModeScenes, Label: "Sahne Değişimleri", Desc: "Sahne değiştiğinde kare yakalar (eşik 0-1)", Default: "0.3"},
+	{Mode: snapshotModeSheet, Label: "Kontak Sayfası", Desc: "Zaman damgalı kareleri tek görselde toplar", Default: "4x4"},
+}
+
+func (m interactiveModel) goToSnapshotMode() interactiveModel {
+	m.state = stateSnapshotMode
+	m.cursor = 0
+	m.choices = make([]string, 0, len(snapshotModeOptions))
+	m.choiceIcons = make([]string, 0, len(snapshotModeOptions))
+	m.choiceDescs = make([]string, 0, len(snapshotModeOptions))
+	for i, opt := range snapshotModeOptions {
+		if opt.Mode == m.snapshotMode {
+			m.cursor = i
+		}
+		m.choices = append(m.choices, opt.Label)
+		m.choiceIcons = append(m.choiceIcons, "📸")
+		m.choiceDescs = append(m.choiceDescs, opt.Desc)
+	}
+	return m
+}
+
+func (m interactiveModel) selectSnapshotMode(index int) interactiveModel {
+	if index < 0 || index >= len(snapshotModeOptions) {
+		index = 0
+	}
+	opt := snapshotModeOptions[index]
+	if m.snapshotMode != opt.Mode || strings.TrimSpace(m.snapshotTimeInput) == "" {
+		m.snapshotTimeInput = opt.Default
+	}
+	m.snapshotMode = opt.Mode
+	m.state = stateSnapshotTime
+	m.cursor = 0
+	return m
+}
+
+// buildInteractiveSnapshotRequest TUI girdisini çoklu kare isteğine çevirir.
+func buildInteractiveSnapshotRequest(mode string, value string) (snapshotRequest, error) {
+	req := snapshotRequest{Mode: mode}
+	var err error
+	switch mode {
+	case snapshotModeEvery:
+		req.Every, err = parseSnapshotInterval(value)
+	case snapshotModeCount:
+		req.Count, err = strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || req.Count < 1 || req.Count > maxSnapshotFrames {
+			err = fmt.Errorf("kare sayısı 1-%d aralığında olmalı", maxSnapshotFrames)
+		}
+	case snapshotModeScenes:
+		req.SceneThreshold, err = strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || req.SceneThreshold <= 0 || req.SceneThreshold >= 1 {
+			err = fmt.Errorf("sahne eşiği 0 ile 1 arasında olmalı")
+		}
+	case snapshotModeSheet:
+		req.SheetCols, req.SheetRows, err = parseSnapshotSheet(value)
+	default:
+		err = fmt.Errorf("desteklenmeyen snapshot modu: %s", mode)
+	}
+	return req, err
+}
+
 func (m interactiveModel) doSnapshot() tea.Cmd {
 	return func() tea.Msg {
 		started := time.Now()
@@ -57,13 +129,41 @@ func (m interactiveModel) doSnapshot() tea.Cmd {
 			outputBaseDir = filepath.Dir(inputFile)
 		}
 
-		baseName := strings.TrimSuffix(filepath.Base(inputFile), filepath.Ext(inputFile))
-		baseOutput := filepath.Join(outputBaseDir, fmt.Sprintf("%s_snapshot.%s", baseName, targetFormat))
-
 		conflictMode := converter.NormalizeConflictPolicy(m.defaultOnConflict)
 		if conflictMode == "" {
 			conflictMode = converter.ConflictVersioned
 		}
+		quality := m.defaultQuality
+		if m.snapshotQualityInput != "0" && m.snapshotQualityInput != "" {
+			fmt.Sscanf(m.snapshotQualityInput, "%d", &quality)
+		}
+
+		if m.snapshotMode != "" && m.snapshotMode != snapshotModeSingle {
+			req, err := buildInteractiveSnapshotRequest(m.snapshotMode, m.snapshotTimeInput)
+			if err != nil {
+				return convertDoneMsg{err: err, duration: time.Since(started)}
+			}
+			req.Input = inputFile
+			req.Format = targetFormat
+			req.Quality = quality
+			req.OutputDir = outputBaseDir
+			req.Conflict = conflictMode
+			manifest, err := runSnapshotSeries(req)
+			if err != nil {
+				return convertDoneMsg{err: err, duration: time.Since(started)}
+			}
+			manifestPath := buildSnapshotManifestPath(req)
+			if err := writeSnapshotManifest(manifestPath, manifest); err != nil {
+				return convertDoneMsg{err: err, duration: time.Since(started)}
+			}
+			return convertDoneMsg{
+				duration: time.Since(started),
+				output:   fmt.Sprintf("%d kare • manifest: %s", len(manifest.Frames), manifestPath),
+			}
+		}
+
+		baseName := strings.TrimSuffix(filepath.Base(inputFile), filepath.Ext(inputFile))
+		baseOutput := filepath.Join(outputBaseDir, fmt.Sprintf("%s_snapshot.%s", baseName, targetFormat))
 
 		resolvedOutput, skip, err := converter.ResolveOutputPathConflict(baseOutput, conflictMode)
 		if err != nil {
@@ -83,10 +183,6 @@ func (m interactiveModel) doSnapshot() tea.Cmd {
 		}
 
 		timeAt := strings.TrimSpace(m.snapshotTimeInput)
-		quality := m.defaultQuality
-		if m.snapshotQualityInput != "0" && m.snapshotQualityInput != "" {
-			fmt.Sscanf(m.snapshotQualityInput, "%d", &quality)
-		}
 
 		timeSec, err := resolveSnapshotTime(timeAt, inputFile)
 		if err != nil {
@@ -112,7 +208,8 @@ func (m interactiveModel) viewSnapshotTime() string {
 		b.WriteString("\n\n")
 	}
 
-	b.WriteString(dimStyle.Render("  Hangi saniyeden kare yakalanacağını girin."))
+	prompt, example := snapshotModePrompt(m.snapshotMode)
+	b.WriteString(dimStyle.Render("  " + prompt))
 	b.WriteString("\n\n")
 
 	cursor := " "
@@ -122,7 +219,7 @@ func (m interactiveModel) viewSnapshotTime() string {
 
 	b.WriteString(pathStyle.Render(fmt.Sprintf("  > %s%s", m.snapshotTimeInput, cursor)))
 	b.WriteString("\n")
-	b.WriteString(dimStyle.Render("  Örnek: 30, 00:01:30 veya %50 (yüzde hesaplanır)"))
+	b.WriteString(dimStyle.Render("  " + example))
 	b.WriteString("\n")
 
 	b.WriteString("\n")
@@ -131,6 +228,59 @@ func (m interactiveModel) viewSnapshotTime() string {
 	return b.String()
 }
 
+func snapshotModePrompt(mode string) (string, string) {
+	switch mode {
+	case snapshotModeEvery:
+		return "Kaç saniyede bir kare yakalanacağını girin.", "Örnek: 10, 2.5 veya 00:01:00"
+	case snapshotModeCount:
+		return "Video boyunca kaç kare yakalanacağını girin.", "Örnek: 12"
+	case snapshotModeScenes:
+		return "Sahne değişimi eşiğini girin (düşük değer daha fazla kare).", "Örnek: 0.3"
+	case snapshotModeSheet:
+		return "Kontak sayfası düzenini sütun x satır olarak girin.", "Örnek: 4x4, 5x3"
+	default:
+		return "Hangi saniyeden kare yakalanacağını girin.", "Örnek: 30, 00:01:30 veya %50 (yüzde hesaplanır)"
+	}
+}
+
+func (m interactiveModel) viewSnapshotMode() string {
+	var b strings.Builder
+	b.WriteString("\n")
+	b.WriteString(menuTitleStyle.Render(" Kare Yakalama: Mod Seçimi "))
+	b.WriteString("\n\n")
+
+	if m.selectedFile != "" {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("  Video: %s", filepath.Base(m.selectedFile))))
+		b.WriteString("\n\n")
+	}
+
+	for i, choice := range m.choices {
+		icon := ""
+		if i < len(m.choiceIcons) {
+			icon = m.choiceIcons[i]
+		}
+		line := menuLine(icon, choice)
+
+		if i == m.cursor {
+			b.WriteString(selectedItemStyle.Render(fmt.Sprintf("▸ %s", line)))
+		} else {
+			b.WriteString(normalItemStyle.Render(fmt.Sprintf("  %s", line)))
+		}
+		b.WriteString("\n")
+		if i < len(m.choiceDescs) && m.choiceDescs[i] != "" {
+			b.WriteString(dimStyle.Render(fmt.Sprintf("      %s", m.choiceDescs[i])))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(dimStyle.Render("  Çoklu kare modları JSON manifest dosyası da üretir."))
+	b.WriteString("\n")
+	b.WriteString(dimStyle.Render("  ↑↓ Gezin  •  Enter Seç  •  Esc Geri"))
+	b.WriteString("\n")
+	return b.String()
+}
+
 func (m interactiveModel) viewSnapshotTarget() string {
 	var b strings.Builder
 	b.WriteString("\n")
@@ -34,8 +34,8 @@ func (m *interactiveModel) appendSprint2Input(token string) bool {
 	}
 
 	ch := r[0]
-	// Allows digits, minus sign, colon, percentage and dot/comma
-	if (ch >= '0' && ch <= '9') || ch == '-' || ch == ':' || ch == '%' {
+	// Allows digits, minus sign, colon, percentage, sheet separator and dot/comma
+	if (ch >= '0' && ch <= '9') || ch == '-' || ch == ':' || ch == '%' || ch == 'x' {
 		*field += string(ch)
 		return true
 	}
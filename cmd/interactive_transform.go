@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+type videoTransformOption struct {
+	Key   string
+	Icon  string
+	Label string
+	Desc  string
+	Spec  *converter.TransformSpec
+}
+
+// videoTransformOptions boyutlandırma akışında video kaynakları için sunulan kırpma/döndürme seçenekleri.
+var videoTransformOptions = []videoTransformOption{
+	{Key: "none", Icon: "🚫", Label: "Kırpma/döndürme yok", Desc: "Görüntü olduğu gibi kalır"},
+	{Key: "auto-crop", Icon: "🎞️", Label: "Siyah bantları otomatik kırp", Desc: "cropdetect ile letterbox/pillarbox bantları bulunup kırpılır", Spec: &converter.TransformSpec{AutoCrop: true}},
+	{Key: "rotate-90", Icon: "↻", Label: "Sağa 90° döndür", Desc: "Saat yönünde çeyrek tur", Spec: &converter.TransformSpec{Rotate: 90}},
+	{Key: "rotate-270", Icon: "↺", Label: "Sola 90° döndür", Desc: "Saat yönünün tersine çeyrek tur", Spec: &converter.TransformSpec{Rotate: 270}},
+	{Key: "rotate-180", Icon: "🔄", Label: "180° döndür", Desc: "Ters çekilmiş videoyu düzeltir", Spec: &converter.TransformSpec{Rotate: 180}},
+	{Key: "flip-h", Icon: "↔️", Label: "Yatay çevir (ayna)", Desc: "Sol ve sağ yer değiştirir", Spec: &converter.TransformSpec{Flip: converter.FlipHorizontal}},
+	{Key: "flip-v", Icon: "↕️", Label: "Dikey çevir", Desc: "Üst ve alt yer değiştirir", Spec: &converter.TransformSpec{Flip: converter.FlipVertical}},
+	{Key: "aspect-9-16", Icon: "📱", Label: "9:16 dikey kırp", Desc: "Merkezden dikey story/reels oranına kırpar", Spec: &converter.TransformSpec{Aspect: "9:16"}},
+	{Key: "aspect-1-1", Icon: "⬜", Label: "1:1 kare kırp", Desc: "Merkezden kare orana kırpar", Spec: &converter.TransformSpec{Aspect: "1:1"}},
+	{Key: "aspect-16-9", Icon: "🖥️", Label: "16:9 yatay kırp", Desc: "Merkezden geniş ekran oranına kırpar", Spec: &converter.TransformSpec{Aspect: "16:9"}},
+}
+
+// canConfigureVideoTransform kaynak video ise kırpma/döndürme adımının gösterilip gösterilmeyeceğini döner.
+func (m interactiveModel) canConfigureVideoTransform() bool {
+	return converter.IsVideoFormat(m.sourceFormat)
+}
+
+func (m interactiveModel) goToVideoTransformSelect() interactiveModel {
+	m.state = stateVideoTransformSelect
+	m.cursor = 0
+	m.choices = make([]string, len(videoTransformOptions))
+	m.choiceIcons = make([]string, len(videoTransformOptions))
+	m.choiceDescs = make([]string, len(videoTransformOptions))
+	for i, opt := range videoTransformOptions {
+		m.choices[i] = opt.Label
+		m.choiceIcons[i] = opt.Icon
+		m.choiceDescs[i] = opt.Desc
+		if opt.Key == m.videoTransformKey {
+			m.cursor = i
+		}
+	}
+	return m
+}
+
+// selectVideoTransform imleçteki seçeneği uygular ve dosya/klasör seçimine geçer.
+func (m interactiveModel) selectVideoTransform() (tea.Model, tea.Cmd) {
+	if m.cursor >= 0 && m.cursor < len(videoTransformOptions) {
+		opt := videoTransformOptions[m.cursor]
+		m.videoTransformKey = opt.Key
+		m.videoTransform = nil
+		if opt.Spec != nil {
+			spec := *opt.Spec
+			m.videoTransform = &spec
+		}
+	}
+	return m.proceedAfterTransformSelection()
+}
+
+// goBackFromResizeFlow dosya/klasör seçiminden geri dönerken video için kırpma adımına, diğerlerinde boyutlandırmaya döner.
+func (m interactiveModel) goBackFromResizeFlow(isBatch bool) interactiveModel {
+	if m.canConfigureVideoTransform() {
+		m.resizeIsBatchFlow = isBatch
+		return m.goToVideoTransformSelect()
+	}
+	return m.goToResizeConfig(isBatch)
+}
+
+func (m interactiveModel) videoTransformSummary() string {
+	if m.videoTransform == nil {
+		return ""
+	}
+	return converter.DescribeTransformSpec(m.videoTransform)
+}
+
+func (m interactiveModel) viewVideoTransformSelect() string {
+	var b strings.Builder
+	b.WriteString("\n")
+	b.WriteString(menuTitleStyle.Render(" ◆ Kırpma / Döndürme "))
+	b.WriteString("\n\n")
+
+	for i, choice := range m.choices {
+		label := menuLine(m.choiceIcons[i], choice)
+		if i == m.cursor {
+			b.WriteString(selectedItemStyle.Render("▸ " + label))
+			b.WriteString("\n")
+			if i < len(m.choiceDescs) && m.choiceDescs[i] != "" {
+				b.WriteString(lipgloss.NewStyle().PaddingLeft(7).Foreground(dimTextColor).Italic(true).Render(m.choiceDescs[i]))
+				b.WriteString("\n")
+			}
+		} else {
+			b.WriteString(normalItemStyle.Render("  " + label))
+			b.WriteString("\n")
+		}
+	}
+
+	if m.resizeSpec != nil {
+		b.WriteString("\n")
+		b.WriteString(infoStyle.Render(fmt.Sprintf("  Boyutlandırma: %s", m.resizeSummary())))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(dimStyle.Render("  ↑↓ Gezin  •  Enter Onayla  •  Esc Geri"))
+	b.WriteString("\n")
+	return b.String()
+}
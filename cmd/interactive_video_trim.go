@@ -291,6 +291,7 @@ func (m interactiveModel) doVideoTrim() tea.Cmd {
 					execution.TargetFormat,
 					execution.Codec,
 					execution.Quality,
+					nil,
 					converter.MetadataAuto,
 					false,
 				)
@@ -304,6 +305,7 @@ func (m interactiveModel) doVideoTrim() tea.Cmd {
 					execution.TargetFormat,
 					execution.Codec,
 					execution.Quality,
+					nil,
 					converter.MetadataAuto,
 					false,
 				)
@@ -318,6 +320,7 @@ func (m interactiveModel) doVideoTrim() tea.Cmd {
 				execution.TargetFormat,
 				execution.Codec,
 				execution.Quality,
+				nil,
 				converter.MetadataAuto,
 				false,
 			)
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/spf13/cobra"
+)
+
+// openRunJournal yeni bir çalıştırma için iş günlüğünü açar ve başlık kaydını yazar.
+// Dolu bir günlük üzerine yeni çalıştırma başlatılmaz; kaldığı yerden devam için
+// resume komutu kullanılmalıdır. resume true ise başlık yerine "resumed" kaydı eklenir.
+func openRunJournal(path, kind string, resume bool, cmd *cobra.Command) (*batch.Journal, error) {
+	if !resume {
+		if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+			return nil, fmt.Errorf("journal zaten mevcut: %s (kaldığı yerden devam için '%s resume %s' kullanın)", path, kind, path)
+		}
+	}
+	journal, err := batch.OpenJournal(path)
+	if err != nil {
+		return nil, err
+	}
+	event := batch.JournalEvent{Type: batch.JournalResumed, Kind: kind}
+	if !resume {
+		dir, err := os.Getwd()
+		if err != nil {
+			journal.Close()
+			return nil, err
+		}
+		event = batch.JournalEvent{Type: batch.JournalRun, Kind: kind, Args: journalArgs(cmd, os.Args[1:]), Dir: dir}
+	}
+	if err := journal.Append(event); err != nil {
+		journal.Close()
+		return nil, err
+	}
+	return journal, nil
+}
+
+// journalArgs komut satırından cmd'ye giden komut adlarını (ör: "pipeline", "run")
+// konumlarına göre çıkarır; kalan argümanlar resume sırasında komutun flag'lerine
+// yeniden verilir. Değer alan flag'lerin değerleri atlanır, böylece "-o batch" gibi
+// komut adına eşit bir değer komut sanılmaz.
+func journalArgs(cmd *cobra.Command, args []string) []string {
+	var path []*cobra.Command
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		path = append([]*cobra.Command{c}, path...)
+	}
+
+	out := make([]string, 0, len(args))
+	next := 0
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			return append(out, args[i:]...)
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			out = append(out, arg)
+			if flagTakesNextArg(cmd, arg) && i+1 < len(args) {
+				i++
+				out = append(out, args[i])
+			}
+		case next < len(path) && (arg == path[next].Name() || path[next].HasAlias(arg)):
+			next++
+		default:
+			out = append(out, arg)
+		}
+	}
+	return out
+}
+
+// flagTakesNextArg flag'in değerini bir sonraki argümandan alıp almadığını bildirir.
+// "--output=x" ve "-ox" gibi değeri kendi içinde taşıyan biçimler false döner.
+func flagTakesNextArg(cmd *cobra.Command, arg string) bool {
+	flags := cmd.Flags()
+	inherited := cmd.InheritedFlags()
+	if name := strings.TrimPrefix(arg, "--"); name != arg {
+		if strings.Contains(name, "=") {
+			return false
+		}
+		f := flags.Lookup(name)
+		if f == nil {
+			f = inherited.Lookup(name)
+		}
+		return f != nil && f.NoOptDefVal == ""
+	}
+	shorthands := arg[1:]
+	for k := 0; k < len(shorthands); k++ {
+		short := shorthands[k : k+1]
+		f := flags.ShorthandLookup(short)
+		if f == nil {
+			f = inherited.ShorthandLookup(short)
+		}
+		if f == nil {
+			return false
+		}
+		if f.NoOptDefVal == "" {
+			// Değer aynı argümanın devamındaysa sonraki argüman flag'e ait değildir
+			return k == len(shorthands)-1
+		}
+	}
+	return false
+}
+
+// loadResumeJournal günlüğü okur, türünü doğrular ve ilk çalıştırmanın dizinine geçer.
+// Dönen yol mutlak olduğundan dizin değişikliğinden etkilenmez.
+func loadResumeJournal(path, kind string) (*batch.JournalState, string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, "", err
+	}
+	state, err := batch.ReadJournal(abs)
+	if err != nil {
+		return nil, "", fmt.Errorf("journal okunamadı: %w", err)
+	}
+	if state.Kind != kind {
+		return nil, "", fmt.Errorf("journal '%s' komutuna ait, '%s resume' ile kullanılamaz", state.Kind, kind)
+	}
+	if strings.TrimSpace(state.Dir) != "" {
+		if err := os.Chdir(state.Dir); err != nil {
+			return nil, "", fmt.Errorf("çalışma dizinine geçilemedi: %w", err)
+		}
+	}
+	return state, abs, nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+const nameTemplateFlagUsage = "Çıktı adı şablonu (ör: {name}_{width}x{height}.{ext}, {date:2006-01-02}/{name}.{ext}, {index:03})"
+
+// addNameTemplateFlag --name-template flag'ini komuta ekler.
+func addNameTemplateFlag(cmd *cobra.Command, value *string) {
+	cmd.Flags().StringVar(value, "name-template", "", nameTemplateFlagUsage)
+}
+
+// parseNameTemplateFlag şablonu doğrular; --name veya açık çıktı dosyasıyla birlikte verilmesini reddeder.
+func parseNameTemplateFlag(raw, customName, explicitOutput string) (*converter.NameTemplate, error) {
+	tmpl, err := converter.ParseNameTemplate(raw)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl == nil {
+		return nil, nil
+	}
+	if strings.TrimSpace(customName) != "" {
+		return nil, fmt.Errorf("--name ve --name-template birlikte kullanılamaz")
+	}
+	if strings.TrimSpace(explicitOutput) != "" {
+		return nil, fmt.Errorf("--name-template açık çıktı dosyası ile birlikte kullanılamaz")
+	}
+	return tmpl, nil
+}
+
+// nameTemplateData dönüşüm seçeneklerinden şablon değişkenlerini doldurur. Sabit piksel
+// boyutlandırma varsa {width}/{height} çıktı boyutunu gösterir, yoksa kaynaktan okunur.
+func nameTemplateData(input, targetFormat string, index int, opts converter.Options) converter.TemplateData {
+	data := converter.TemplateData{
+		InputPath:    input,
+		TargetFormat: targetFormat,
+		Index:        index,
+		Quality:      opts.Quality,
+	}
+	if opts.Resize != nil && opts.Resize.Width > 0 && opts.Resize.Height > 0 {
+		data.Width, data.Height = opts.Resize.Width, opts.Resize.Height
+	}
+	return data
+}
+
+// templatedOutputPath şablon varsa çıktı yolunu şablondan, yoksa fallback'ten üretir.
+// Şablonda çıktılar --output dizinine, o da yoksa girdinin klasörüne yazılır.
+func templatedOutputPath(tmpl *converter.NameTemplate, data converter.TemplateData, fallback string) (string, error) {
+	if tmpl == nil {
+		return fallback, nil
+	}
+	path, err := tmpl.OutputPath(outputDir, data)
+	if err != nil {
+		return "", fmt.Errorf("isim şablonu uygulanamadı: %w", err)
+	}
+	return path, nil
+}
+
+// resolveNamedOutputPath tek çıktılı komutlarda --name-template'i doğrulayıp uygular;
+// şablon verilmemişse fallback yolu döner.
+func resolveNamedOutputPath(rawTemplate, customName, explicitOutput, input, targetFormat string, quality int, fallback string) (string, error) {
+	tmpl, err := parseNameTemplateFlag(rawTemplate, customName, explicitOutput)
+	if err != nil {
+		return "", err
+	}
+	return templatedOutputPath(tmpl, converter.TemplateData{
+		InputPath:    input,
+		TargetFormat: targetFormat,
+		Index:        1,
+		Quality:      quality,
+	}, fallback)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestParseNameTemplateFlagConflicts(t *testing.T) {
+	if tmpl, err := parseNameTemplateFlag("", "custom", "out.mp4"); err != nil || tmpl != nil {
+		t.Fatalf("empty template should be ignored, got %v, %v", tmpl, err)
+	}
+	if _, err := parseNameTemplateFlag("{name}.{ext}", "custom", ""); err == nil {
+		t.Fatalf("expected error when --name is also set")
+	}
+	if _, err := parseNameTemplateFlag("{name}.{ext}", "", "out.mp4"); err == nil {
+		t.Fatalf("expected error when explicit output is also set")
+	}
+	if _, err := parseNameTemplateFlag("{bogus}", "", ""); err == nil {
+		t.Fatalf("expected error for unknown variable")
+	}
+}
+
+func TestResolveNamedOutputPath(t *testing.T) {
+	prevOutput := outputDir
+	t.Cleanup(func() { outputDir = prevOutput })
+	outputDir = filepath.Join("target", "out")
+
+	got, err := resolveNamedOutputPath("", "", "", "clip.mp4", "gif", 0, "fallback.gif")
+	if err != nil || got != "fallback.gif" {
+		t.Fatalf("expected fallback path, got %q, %v", got, err)
+	}
+	got, err = resolveNamedOutputPath("{name}_q{quality}_{index:02}", "", "", filepath.Join("in", "clip.mp4"), "gif", 70, "fallback.gif")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := filepath.Join("target", "out", "clip_q70_01.gif"); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestBuildBatchNamedOutputPathPreserveTree(t *testing.T) {
+	prevOutput := outputDir
+	prevPreserve := batchPreserveTree
+	t.Cleanup(func() {
+		outputDir = prevOutput
+		batchPreserveTree = prevPreserve
+	})
+	outputDir = filepath.Join("target", "out")
+	batchPreserveTree = true
+
+	tmpl, err := converter.ParseNameTemplate("{name}_{index:03}_{width}x{height}.{ext}")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	target := batchTarget{Format: "png", Options: converter.Options{Resize: &converter.ResizeSpec{Width: 800, Height: 600}}}
+	got, err := buildBatchNamedOutputPath(filepath.Join("src", "nested", "asset.jpg"), "src", target, batchNaming{Template: tmpl, Index: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := filepath.Join("target", "out", "nested", "asset_004_800x600.png")
+	if got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	got, err = buildBatchNamedOutputPath(filepath.Join("src", "asset.jpg"), "src", target, batchNaming{})
+	if err != nil || got != filepath.Join("target", "out", "asset.png") {
+		t.Fatalf("expected default path without template, got %q, %v", got, err)
+	}
+}
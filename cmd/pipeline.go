@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
 	"github.com/mlihgenel/fileconverter-cli/internal/pipeline"
 	"github.com/mlihgenel/fileconverter-cli/internal/ui"
 )
@@ -22,6 +23,8 @@ var (
 	pipelineReportFile string
 	pipelineResumeFile string
 	pipelineKeepTemps  bool
+	pipelineNameTmpl   string
+	pipelineJournal    string
 )
 
 var pipelineCmd = &cobra.Command{
@@ -36,7 +39,14 @@ var pipelineRunCmd = &cobra.Command{
 
 Örnek:
   fileconverter-cli pipeline run ./pipeline.json --profile social-story
-  fileconverter-cli pipeline run ./pipeline.json --strip-metadata --report json --report-file ./reports/pipeline.json`,
+  fileconverter-cli pipeline run ./pipeline.json --strip-metadata --report json --report-file ./reports/pipeline.json
+  fileconverter-cli pipeline run ./pipeline.json --report html --report-file ./reports/pipeline.html
+  fileconverter-cli pipeline run ./pipeline.json --name-template "{parent}/{name}_{index:03}.{ext}"
+  fileconverter-cli pipeline run ./pipeline.json --journal ./pipeline.journal
+
+Spec'teki input bir dizin veya glob deseni ise pipeline seçilen her dosya için
+ayrı çalışır; "recursive" ve "filter" alanları batch/watch ile aynı seçim
+kurallarını (include/exclude, regex, boyut, tarih, medya süresi vb.) uygular.`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		specPath := args[0]
@@ -76,12 +86,58 @@ var pipelineRunCmd = &cobra.Command{
 			return err
 		}
 		spec = resolvePipelinePaths(spec, specPath)
+		execCfg := pipeline.ExecuteConfig{
+			OutputDir:      outputDir,
+			Verbose:        verbose,
+			DefaultQuality: pipelineQuality,
+			MetadataMode:   metadataMode,
+			OnConflict:     conflictPolicy,
+			KeepTemps:      pipelineKeepTemps,
+			NameTemplate:   pipelineNameTmpl,
+		}
+		if _, err := parseNameTemplateFlag(pipelineNameTmpl, "", spec.Output); err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		inputs, multiInput, err := pipeline.ResolveInputs(spec)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		if multiInput {
+			if strings.TrimSpace(pipelineResumeFile) != "" {
+				err := fmt.Errorf("--resume-from-report dizin/glob girdisinde desteklenmez")
+				ui.PrintError(err.Error())
+				return err
+			}
+			return runPipelineInputs(cmd, specPath, spec, inputs, execCfg, reportFormat, jsonOutput)
+		}
+
 		resumePlan, err := buildPipelineResumePlan(spec, pipelineResumeFile)
 		if err != nil {
 			ui.PrintError(err.Error())
 			return err
 		}
 
+		if pipelineJournalDone(spec.Input) {
+			if jsonOutput {
+				return printJSON(map[string]interface{}{
+					"status": "skipped",
+					"reason": batch.SkipJournalDone,
+					"input":  spec.Input,
+				})
+			}
+			ui.PrintInfo("Pipeline journal'a göre zaten tamamlanmış; yeniden çalıştırma atlandı.")
+			return nil
+		}
+		journal, err := openPipelineJournal(cmd, []string{spec.Input})
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		defer journal.Close()
+
 		if !jsonOutput {
 			ui.PrintInfo(fmt.Sprintf("Pipeline çalıştırılıyor: %s", specPath))
 		}
@@ -105,18 +161,14 @@ var pipelineRunCmd = &cobra.Command{
 				ui.PrintInfo("Pipeline zaten tamamlanmış görünüyor; yeniden çalıştırma atlandı.")
 			}
 		} else {
-			partial, runErr := pipeline.Execute(resumePlan.RunSpec, pipeline.ExecuteConfig{
-				OutputDir:      outputDir,
-				Verbose:        verbose,
-				DefaultQuality: pipelineQuality,
-				MetadataMode:   metadataMode,
-				OnConflict:     conflictPolicy,
-				KeepTemps:      pipelineKeepTemps,
-			})
+			journal.Append(batch.JournalEvent{Type: batch.JournalStarted, Input: spec.Input})
+			partial, runErr := pipeline.Execute(resumePlan.RunSpec, execCfg)
 			execErr = runErr
 			result = mergePipelineResumeResult(resumePlan, partial, started)
 		}
 		elapsed := time.Since(started)
+		recordPipelineResult(journal, spec.Input, result, execErr, elapsed)
+		journal.Append(batch.JournalEvent{Type: batch.JournalFinished})
 
 		if !jsonOutput {
 			if execErr != nil {
@@ -143,21 +195,11 @@ var pipelineRunCmd = &cobra.Command{
 			}
 			return reportErr
 		}
-		if strings.TrimSpace(reportText) != "" {
-			if strings.TrimSpace(pipelineReportFile) != "" {
-				if err := writeBatchReport(pipelineReportFile, reportText); err != nil {
-					ui.PrintError(fmt.Sprintf("Rapor yazılamadı: %s", err.Error()))
-					if execErr != nil {
-						return execErr
-					}
-					return err
-				}
-				if !jsonOutput {
-					ui.PrintInfo(fmt.Sprintf("Rapor yazıldı: %s", pipelineReportFile))
-				}
-			} else if !jsonOutput {
-				fmt.Println(reportText)
+		if err := emitPipelineReport(reportText, jsonOutput); err != nil {
+			if execErr != nil {
+				return execErr
 			}
+			return err
 		}
 		if jsonOutput {
 			jsonReport, err := pipeline.RenderReport(pipeline.ReportJSON, result)
@@ -180,15 +222,131 @@ func init() {
 	pipelineRunCmd.Flags().StringVar(&pipelineOnConflict, "on-conflict", "versioned", "Çakışma politikası: overwrite, skip, versioned")
 	pipelineRunCmd.Flags().BoolVar(&pipelinePreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
 	pipelineRunCmd.Flags().BoolVar(&pipelineStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
-	pipelineRunCmd.Flags().StringVar(&pipelineReport, "report", pipeline.ReportTXT, "Rapor formatı: off, txt, json")
+	pipelineRunCmd.Flags().StringVar(&pipelineReport, "report", pipeline.ReportTXT, "Rapor formatı: off, txt, json, csv, junit, markdown, html")
 	pipelineRunCmd.Flags().StringVar(&pipelineReportFile, "report-file", "", "Raporu belirtilen dosyaya yaz")
 	pipelineRunCmd.Flags().StringVar(&pipelineResumeFile, "resume-from-report", "", "Önceki JSON rapordan başarılı step'leri okuyup kaldığı yerden devam et")
 	pipelineRunCmd.Flags().BoolVar(&pipelineKeepTemps, "keep-temps", false, "Ara geçici dosyaları silme")
+	pipelineRunCmd.Flags().StringVar(&pipelineJournal, "journal", "", "İş günlüğü (JSONL); yarıda kalan çalışma 'pipeline resume <journal>' ile sürdürülür")
+	addNameTemplateFlag(pipelineRunCmd, &pipelineNameTmpl)
 
-	pipelineCmd.AddCommand(pipelineRunCmd)
+	pipelineCmd.AddCommand(pipelineRunCmd, pipelineResumeCmd)
 	rootCmd.AddCommand(pipelineCmd)
 }
 
+// emitPipelineReport raporu --report-file'a yazar veya JSON modu dışında ekrana basar.
+func emitPipelineReport(reportText string, jsonOutput bool) error {
+	if strings.TrimSpace(reportText) == "" {
+		return nil
+	}
+	if strings.TrimSpace(pipelineReportFile) != "" {
+		if err := writeBatchReport(pipelineReportFile, reportText); err != nil {
+			ui.PrintError(fmt.Sprintf("Rapor yazılamadı: %s", err.Error()))
+			return err
+		}
+		if !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("Rapor yazıldı: %s", pipelineReportFile))
+		}
+	} else if !jsonOutput {
+		fmt.Println(reportText)
+	}
+	return nil
+}
+
+// runPipelineInputs dizin/glob girdisinde pipeline'ı seçilen her dosya için sırayla çalıştırır.
+// Bir dosyadaki hata diğerlerini durdurmaz; sonuçlar tek raporda birleştirilir.
+func runPipelineInputs(cmd *cobra.Command, specPath string, spec pipeline.Spec, inputs []string, cfg pipeline.ExecuteConfig, reportFormat string, jsonOutput bool) error {
+	if len(inputs) == 0 {
+		err := fmt.Errorf("pipeline girdisine uyan dosya bulunamadı: %s", spec.Input)
+		ui.PrintError(err.Error())
+		return err
+	}
+	if !jsonOutput {
+		ui.PrintInfo(fmt.Sprintf("Pipeline çalıştırılıyor: %s (%d dosya)", specPath, len(inputs)))
+		if pipelineProfile != "" {
+			ui.PrintInfo(fmt.Sprintf("Profil: %s", pipelineProfile))
+		}
+	}
+
+	journal, err := openPipelineJournal(cmd, inputs)
+	if err != nil {
+		ui.PrintError(err.Error())
+		return err
+	}
+	defer journal.Close()
+
+	started := time.Now()
+	results := make([]pipeline.Result, 0, len(inputs))
+	failed, skipped := 0, 0
+	for i, input := range inputs {
+		if pipelineJournalDone(input) {
+			skipped++
+			if !jsonOutput && verbose {
+				ui.PrintInfo(fmt.Sprintf("%s: journal'a göre tamamlanmış, atlandı", input))
+			}
+			continue
+		}
+		fileSpec := spec
+		fileSpec.Input = input
+		fileCfg := cfg
+		fileCfg.Index = i + 1
+		journal.Append(batch.JournalEvent{Type: batch.JournalStarted, Input: input})
+		fileStarted := time.Now()
+		result, err := pipeline.Execute(fileSpec, fileCfg)
+		if result.Input == "" {
+			result.Input = input
+		}
+		recordPipelineResult(journal, input, result, err, time.Since(fileStarted))
+		results = append(results, result)
+		if err != nil {
+			failed++
+			if !jsonOutput {
+				ui.PrintError(fmt.Sprintf("%s: %s", input, err.Error()))
+			}
+			continue
+		}
+		if !jsonOutput {
+			ui.PrintSuccess(fmt.Sprintf("%s -> %s", input, result.FinalOutput))
+		}
+	}
+
+	var execErr error
+	if failed > 0 {
+		execErr = fmt.Errorf("%d dosyada pipeline başarısız", failed)
+	}
+	journal.Append(batch.JournalEvent{Type: batch.JournalFinished})
+	if !jsonOutput {
+		if skipped > 0 {
+			ui.PrintInfo(fmt.Sprintf("Pipeline tamamlandı: %d başarılı, %d başarısız, %d journal'dan atlandı", len(inputs)-failed-skipped, failed, skipped))
+		} else {
+			ui.PrintInfo(fmt.Sprintf("Pipeline tamamlandı: %d başarılı, %d başarısız", len(inputs)-failed, failed))
+		}
+		ui.PrintDuration(time.Since(started))
+	}
+
+	reportText, err := pipeline.RenderReports(reportFormat, results)
+	if err != nil {
+		ui.PrintError(fmt.Sprintf("Rapor üretilemedi: %s", err.Error()))
+		if execErr != nil {
+			return execErr
+		}
+		return err
+	}
+	if err := emitPipelineReport(reportText, jsonOutput); err != nil {
+		if execErr != nil {
+			return execErr
+		}
+		return err
+	}
+	if jsonOutput {
+		jsonReport, err := pipeline.RenderReports(pipeline.ReportJSON, results)
+		if err != nil {
+			return err
+		}
+		fmt.Println(jsonReport)
+	}
+	return execErr
+}
+
 func resolvePipelinePaths(spec pipeline.Spec, specPath string) pipeline.Spec {
 	baseDir := filepath.Dir(specPath)
 
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/pipeline"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+// pipelineJournalState "pipeline resume" sırasında okunan günlük durumudur; normal çalıştırmada nil'dir.
+var pipelineJournalState *batch.JournalState
+
+var pipelineResumeCmd = &cobra.Command{
+	Use:   "resume <journal>",
+	Short: "Yarıda kalan pipeline çalışmasını iş günlüğünden devam ettir",
+	Long: `--journal ile başlatılmış bir pipeline çalışmasını kaldığı yerden sürdürür.
+
+İlk çalıştırmanın argümanları ve çalışma dizini günlükten okunur. Son çıktısı
+üretilmiş ve yerinde duran girdiler atlanır; diğerleri pipeline'ı baştan çalıştırır.
+
+Örnek:
+  fileconverter-cli pipeline run ./pipeline.json --journal ./pipeline.journal
+  fileconverter-cli pipeline resume ./pipeline.journal`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		state, journalPath, err := loadResumeJournal(args[0], "pipeline")
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		if err := pipelineRunCmd.ParseFlags(state.Args); err != nil {
+			ui.PrintError(fmt.Sprintf("Journal argümanları okunamadı: %s", err.Error()))
+			return err
+		}
+		runArgs := pipelineRunCmd.Flags().Args()
+		if err := pipelineRunCmd.Args(pipelineRunCmd, runArgs); err != nil {
+			ui.PrintError(fmt.Sprintf("Journal argümanları geçersiz: %s", err.Error()))
+			return err
+		}
+		pipelineJournal = journalPath
+		pipelineJournalState = state
+		defer func() { pipelineJournalState = nil }()
+
+		if !isJSONOutput() {
+			done, pending := state.Counts()
+			ui.PrintInfo(fmt.Sprintf("Journal: %d tamamlanmış, %d bekleyen girdi (%s)", done, pending, journalPath))
+		}
+		return pipelineRunCmd.RunE(pipelineRunCmd, runArgs)
+	},
+}
+
+// openPipelineJournal --journal verilmişse günlüğü açar ve çalıştırılacak girdileri kuyruğa yazar.
+func openPipelineJournal(cmd *cobra.Command, inputs []string) (*batch.Journal, error) {
+	if strings.TrimSpace(pipelineJournal) == "" {
+		return nil, nil
+	}
+	journal, err := openRunJournal(pipelineJournal, "pipeline", pipelineJournalState != nil, cmd)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]batch.JournalEvent, 0, len(inputs))
+	for _, input := range inputs {
+		if !pipelineJournalDone(input) {
+			events = append(events, batch.JournalEvent{Type: batch.JournalQueued, Input: input})
+		}
+	}
+	if err := journal.Append(events...); err != nil {
+		journal.Close()
+		return nil, err
+	}
+	return journal, nil
+}
+
+// pipelineJournalDone girdi resume edilen günlükte tamamlanmış ve son çıktısı yerindeyse true döner.
+func pipelineJournalDone(input string) bool {
+	entry, ok := pipelineJournalState.Lookup(input, "")
+	return ok && entry.Done()
+}
+
+// recordPipelineResult bir girdinin pipeline sonucunu günlüğe yazar.
+func recordPipelineResult(journal *batch.Journal, input string, result pipeline.Result, err error, elapsed time.Duration) {
+	ev := batch.JournalEvent{
+		Type:       batch.JournalSucceeded,
+		Input:      input,
+		Output:     result.FinalOutput,
+		DurationMS: elapsed.Milliseconds(),
+	}
+	if err != nil {
+		ev.Type = batch.JournalFailed
+		ev.Error = err.Error()
+	}
+	if err := journal.Append(ev); err != nil {
+		ui.PrintWarning(err.Error())
+	}
+}
@@ -45,6 +45,37 @@ func applyProfileToConvert(cmd *cobra.Command, p profile.Definition) {
 	if p.DPI != nil && !cmd.Flags().Changed("dpi") {
 		convertResizeDPI = *p.DPI
 	}
+	if p.SampleRate != nil && !cmd.Flags().Changed("sample-rate") {
+		convertSampleRate = *p.SampleRate
+	}
+	if p.BitDepth != "" && !cmd.Flags().Changed("bit-depth") {
+		convertBitDepth = p.BitDepth
+	}
+	if p.Channels != nil && !cmd.Flags().Changed("channels") {
+		convertChannels = *p.Channels
+	}
+	if p.AudioBitrate != "" && !cmd.Flags().Changed("audio-bitrate") && !cmd.Flags().Changed("bitrate") {
+		convertAudioBR = p.AudioBitrate
+	}
+	if p.AudioCodec != "" && !cmd.Flags().Changed("audio-codec") {
+		convertAudioCodec = p.AudioCodec
+	}
+	if p.VideoCodec != "" && !cmd.Flags().Changed("video-codec") {
+		convertVideoCodec = p.VideoCodec
+	}
+	if p.CRF != nil && !cmd.Flags().Changed("crf") {
+		convertCRF = *p.CRF
+	}
+	if p.VideoBitrate != "" && !cmd.Flags().Changed("bitrate") {
+		convertVideoBR = p.VideoBitrate
+	}
+	if p.EncodePreset != "" && !cmd.Flags().Changed("encode-preset") {
+		convertEncPreset = p.EncodePreset
+	}
+	if p.PixFmt != "" && !cmd.Flags().Changed("pix-fmt") {
+		convertPixFmt = p.PixFmt
+	}
+	applyProfileBitrateMode(cmd, p, &convertVBR, &convertCBR)
 }
 
 func applyProfileToBatch(cmd *cobra.Command, p profile.Definition) {
@@ -81,6 +112,37 @@ func applyProfileToBatch(cmd *cobra.Command, p profile.Definition) {
 	if p.DPI != nil && !cmd.Flags().Changed("dpi") {
 		batchResizeDPI = *p.DPI
 	}
+	if p.SampleRate != nil && !cmd.Flags().Changed("sample-rate") {
+		batchSampleRate = *p.SampleRate
+	}
+	if p.BitDepth != "" && !cmd.Flags().Changed("bit-depth") {
+		batchBitDepth = p.BitDepth
+	}
+	if p.Channels != nil && !cmd.Flags().Changed("channels") {
+		batchChannels = *p.Channels
+	}
+	if p.AudioBitrate != "" && !cmd.Flags().Changed("audio-bitrate") && !cmd.Flags().Changed("bitrate") {
+		batchAudioBR = p.AudioBitrate
+	}
+	if p.AudioCodec != "" && !cmd.Flags().Changed("audio-codec") {
+		batchAudioCodec = p.AudioCodec
+	}
+	if p.VideoCodec != "" && !cmd.Flags().Changed("video-codec") {
+		batchVideoCodec = p.VideoCodec
+	}
+	if p.CRF != nil && !cmd.Flags().Changed("crf") {
+		batchCRF = *p.CRF
+	}
+	if p.VideoBitrate != "" && !cmd.Flags().Changed("bitrate") {
+		batchVideoBR = p.VideoBitrate
+	}
+	if p.EncodePreset != "" && !cmd.Flags().Changed("encode-preset") {
+		batchEncPreset = p.EncodePreset
+	}
+	if p.PixFmt != "" && !cmd.Flags().Changed("pix-fmt") {
+		batchPixFmt = p.PixFmt
+	}
+	applyProfileBitrateMode(cmd, p, &batchVBR, &batchCBR)
 }
 
 func applyProfileToWatch(cmd *cobra.Command, p profile.Definition) {
@@ -96,6 +158,37 @@ func applyProfileToWatch(cmd *cobra.Command, p profile.Definition) {
 	if p.RetryDelay != nil && !cmd.Flags().Changed("retry-delay") {
 		watchRetryDelay = *p.RetryDelay
 	}
+	if p.SampleRate != nil && !cmd.Flags().Changed("sample-rate") {
+		watchSampleRate = *p.SampleRate
+	}
+	if p.BitDepth != "" && !cmd.Flags().Changed("bit-depth") {
+		watchBitDepth = p.BitDepth
+	}
+	if p.Channels != nil && !cmd.Flags().Changed("channels") {
+		watchChannels = *p.Channels
+	}
+	if p.AudioBitrate != "" && !cmd.Flags().Changed("audio-bitrate") && !cmd.Flags().Changed("bitrate") {
+		watchAudioBR = p.AudioBitrate
+	}
+	if p.AudioCodec != "" && !cmd.Flags().Changed("audio-codec") {
+		watchAudioCodec = p.AudioCodec
+	}
+	if p.VideoCodec != "" && !cmd.Flags().Changed("video-codec") {
+		watchVideoCodec = p.VideoCodec
+	}
+	if p.CRF != nil && !cmd.Flags().Changed("crf") {
+		watchCRF = *p.CRF
+	}
+	if p.VideoBitrate != "" && !cmd.Flags().Changed("bitrate") {
+		watchVideoBR = p.VideoBitrate
+	}
+	if p.EncodePreset != "" && !cmd.Flags().Changed("encode-preset") {
+		watchEncPreset = p.EncodePreset
+	}
+	if p.PixFmt != "" && !cmd.Flags().Changed("pix-fmt") {
+		watchPixFmt = p.PixFmt
+	}
+	applyProfileBitrateMode(cmd, p, &watchVBR, &watchCBR)
 }
 
 func applyProfileToPipeline(cmd *cobra.Command, p profile.Definition) {
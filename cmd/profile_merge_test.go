@@ -96,3 +96,45 @@ func TestApplyProfileToBatch(t *testing.T) {
 		t.Fatalf("expected retry delay 2s, got %s", batchRetryDelay)
 	}
 }
+
+func TestApplyProfileToConvertAudio(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	for _, name := range []string{"quality", "on-conflict", "preset", "resize-mode", "width", "height", "unit", "dpi"} {
+		cmd.Flags().String(name, "", "")
+	}
+	cmd.Flags().Int("sample-rate", 0, "")
+	cmd.Flags().String("bit-depth", "", "")
+	cmd.Flags().Int("channels", 0, "")
+	cmd.Flags().String("bitrate", "", "")
+	cmd.Flags().Bool("vbr", false, "")
+	cmd.Flags().Bool("cbr", false, "")
+	cmd.Flags().String("audio-codec", "", "")
+
+	prevRate, prevChannels, prevBitrate, prevVBR, prevCBR := convertSampleRate, convertChannels, convertAudioBR, convertVBR, convertCBR
+	t.Cleanup(func() {
+		convertSampleRate, convertChannels, convertAudioBR, convertVBR, convertCBR = prevRate, prevChannels, prevBitrate, prevVBR, prevCBR
+	})
+
+	convertChannels = 2
+	if err := cmd.Flags().Set("channels", "2"); err != nil {
+		t.Fatalf("set flag failed: %v", err)
+	}
+
+	p := profile.Definition{
+		SampleRate:   profile.IntPtr(44100),
+		Channels:     profile.IntPtr(1),
+		AudioBitrate: "96k",
+		BitrateMode:  "cbr",
+	}
+	applyProfileToConvert(cmd, p)
+
+	if convertSampleRate != 44100 || convertAudioBR != "96k" {
+		t.Fatalf("audio profile values did not apply: rate=%d bitrate=%s", convertSampleRate, convertAudioBR)
+	}
+	if convertChannels != 2 {
+		t.Fatalf("explicit --channels should win over profile, got %d", convertChannels)
+	}
+	if !convertCBR || convertVBR {
+		t.Fatalf("expected cbr mode from profile, got vbr=%v cbr=%v", convertVBR, convertCBR)
+	}
+}
@@ -29,14 +29,26 @@ func loadBatchResumeSuccess(reportPath string) (map[string]struct{}, error) {
 		return nil, fmt.Errorf("resume raporu JSON parse hatasi: %w", err)
 	}
 
-	succeeded := make(map[string]struct{}, len(report.Items))
+	// Çok hedefli çalışmada aynı girdinin birden fazla kaydı olur; çıktılarından biri
+	// başarısız olan girdi tekrar işlenmek üzere başarılı sayılmaz.
+	failed := map[string]struct{}{}
 	for _, item := range report.Items {
-		if strings.EqualFold(strings.TrimSpace(item.Status), "success") {
+		if strings.EqualFold(strings.TrimSpace(item.Status), "failed") {
 			for _, key := range pathCandidates(item.Input) {
-				succeeded[key] = struct{}{}
+				failed[key] = struct{}{}
 			}
 		}
 	}
+
+	succeeded := make(map[string]struct{}, len(report.Items))
+	for _, item := range report.Items {
+		if !strings.EqualFold(strings.TrimSpace(item.Status), "success") || hasResumeSuccess(failed, item.Input) {
+			continue
+		}
+		for _, key := range pathCandidates(item.Input) {
+			succeeded[key] = struct{}{}
+		}
+	}
 	return succeeded, nil
 }
 
@@ -36,6 +36,34 @@ func TestLoadBatchResumeSuccess(t *testing.T) {
 	}
 }
 
+func TestLoadBatchResumeSuccessMultiTarget(t *testing.T) {
+	dir := t.TempDir()
+	reportPath := filepath.Join(dir, "batch.json")
+	report := map[string]any{
+		"items": []map[string]any{
+			{"input": filepath.Join(dir, "a.png"), "output": filepath.Join(dir, "a.jpg"), "status": "success"},
+			{"input": filepath.Join(dir, "a.png"), "output": filepath.Join(dir, "a.webp"), "status": "failed"},
+			{"input": filepath.Join(dir, "b.png"), "output": filepath.Join(dir, "b.jpg"), "status": "success"},
+			{"input": filepath.Join(dir, "b.png"), "output": filepath.Join(dir, "b.webp"), "status": "skipped"},
+		},
+	}
+	data, _ := json.Marshal(report)
+	if err := os.WriteFile(reportPath, data, 0644); err != nil {
+		t.Fatalf("write report failed: %v", err)
+	}
+
+	set, err := loadBatchResumeSuccess(reportPath)
+	if err != nil {
+		t.Fatalf("loadBatchResumeSuccess failed: %v", err)
+	}
+	if hasResumeSuccess(set, filepath.Join(dir, "a.png")) {
+		t.Fatalf("did not expect partially failed input in success set")
+	}
+	if !hasResumeSuccess(set, filepath.Join(dir, "b.png")) {
+		t.Fatalf("expected b.png in success set")
+	}
+}
+
 func TestBuildPipelineResumePlan(t *testing.T) {
 	dir := t.TempDir()
 	step1Out := filepath.Join(dir, "step1.md")
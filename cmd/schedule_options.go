@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// scheduleFlagValues batch/watch komutlarının kaynak farkındalıklı zamanlama flag değerlerini taşır.
+type scheduleFlagValues struct {
+	Limits       []string
+	Weights      []string
+	Order        string
+	MemoryBudget string
+	Priority     string
+}
+
+// buildSchedule flag değerlerini doğrular ve varsayılan zamanlamanın üzerine uygular.
+func buildSchedule(values scheduleFlagValues) (batch.Schedule, error) {
+	schedule := batch.DefaultSchedule()
+
+	limits, err := batch.ParseCategoryValues(values.Limits)
+	if err != nil {
+		return schedule, fmt.Errorf("--max-concurrent hatalı: %w", err)
+	}
+	for category, n := range limits {
+		schedule.Limits[category] = n
+	}
+
+	weights, err := batch.ParseCategoryValues(values.Weights)
+	if err != nil {
+		return schedule, fmt.Errorf("--weight hatalı: %w", err)
+	}
+	if len(weights) > 0 {
+		schedule.Weights = weights
+	}
+
+	schedule.Order = batch.NormalizeOrder(values.Order)
+	if schedule.Order == "" {
+		return schedule, fmt.Errorf("gecersiz sıralama: %s (input, largest-first, smallest-first)", values.Order)
+	}
+
+	if strings.TrimSpace(values.MemoryBudget) != "" {
+		budget, err := converter.ParseSize(values.MemoryBudget)
+		if err != nil {
+			return schedule, fmt.Errorf("--memory-budget hatalı: %w", err)
+		}
+		schedule.MemoryBudget = budget
+	}
+
+	if err := converter.SetChildPriority(values.Priority); err != nil {
+		return schedule, err
+	}
+	return schedule, nil
+}
+
+// describeSchedule varsayılandan farklı zamanlama ayarlarını özetler.
+func describeSchedule(s batch.Schedule) string {
+	var parts []string
+	var limits []string
+	for _, category := range batch.Categories {
+		if n := s.Limits[category]; n > 0 {
+			limits = append(limits, fmt.Sprintf("%s=%d", category, n))
+		}
+	}
+	if len(limits) > 0 {
+		parts = append(parts, "sınırlar: "+strings.Join(limits, ","))
+	}
+	var weights []string
+	for _, category := range batch.Categories {
+		if n := s.Weights[category]; n > 1 {
+			weights = append(weights, fmt.Sprintf("%s=%d", category, n))
+		}
+	}
+	if len(weights) > 0 {
+		parts = append(parts, "ağırlıklar: "+strings.Join(weights, ","))
+	}
+	if s.Order != batch.OrderInput {
+		parts = append(parts, "sıra: "+s.Order)
+	}
+	if s.MemoryBudget > 0 {
+		parts = append(parts, "bellek bütçesi: "+formatFileSize(s.MemoryBudget))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// addScheduleFlags zamanlama flag'lerini komuta ekler.
+func addScheduleFlags(cmd *cobra.Command, values *scheduleFlagValues) {
+	cmd.Flags().StringArrayVar(&values.Limits, "max-concurrent", nil, "Kategori başına eşzamanlı iş sınırı (ör: video=2,image=8; kategoriler: video, audio, image, document, libreoffice; 0=sınırsız)")
+	cmd.Flags().StringArrayVar(&values.Weights, "weight", nil, "Kategori başına iş ağırlığı, işin kapladığı worker slotu (ör: video=4)")
+	cmd.Flags().StringVar(&values.Order, "order", batch.OrderInput, "İş sırası: input, largest-first, smallest-first")
+	cmd.Flags().StringVar(&values.MemoryBudget, "memory-budget", "", "Çalışan işlerin tahmini toplam bellek sınırı (ör: 4gb)")
+	cmd.Flags().StringVar(&values.Priority, "priority", converter.PriorityNormal, "Harici araçların CPU/disk önceliği: normal, low, idle (nice/ionice)")
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+)
+
+func TestBuildScheduleOverridesDefaults(t *testing.T) {
+	schedule, err := buildSchedule(scheduleFlagValues{
+		Limits:       []string{"video=3", "libreoffice=0"},
+		Weights:      []string{"video=2"},
+		Order:        "largest-first",
+		MemoryBudget: "2gb",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schedule.Limits[batch.CategoryVideo] != 3 || schedule.Limits[batch.CategoryLibreOffice] != 0 {
+		t.Fatalf("unexpected limits: %v", schedule.Limits)
+	}
+	if schedule.Weights[batch.CategoryVideo] != 2 {
+		t.Fatalf("unexpected weights: %v", schedule.Weights)
+	}
+	if schedule.Order != batch.OrderLargestFirst || schedule.MemoryBudget != 2*1024*1024*1024 {
+		t.Fatalf("unexpected schedule: %+v", schedule)
+	}
+}
+
+func TestBuildScheduleDefaults(t *testing.T) {
+	schedule, err := buildSchedule(scheduleFlagValues{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schedule.Limits[batch.CategoryLibreOffice] != 1 || schedule.Order != batch.OrderInput {
+		t.Fatalf("unexpected default schedule: %+v", schedule)
+	}
+}
+
+func TestBuildScheduleRejectsInvalid(t *testing.T) {
+	cases := []scheduleFlagValues{
+		{Limits: []string{"gpu=1"}},
+		{Weights: []string{"video"}},
+		{Order: "random"},
+		{MemoryBudget: "lots"},
+		{Priority: "realtime"},
+	}
+	for _, c := range cases {
+		if _, err := buildSchedule(c); err == nil {
+			t.Fatalf("expected error for %+v", c)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+// sourceFlagValues batch/watch komutlarının dönüşüm sonrası kaynak dosya eylemi flag değerlerini taşır.
+type sourceFlagValues struct {
+	OnSuccess string
+	OnFailure string
+	Manifest  string
+}
+
+// buildSourceHandler flag değerlerini doğrular. root taşıma sırasında korunacak klasör
+// yapısının köküdür. Hiçbir eylem veya manifest istenmemişse nil döner.
+func buildSourceHandler(values sourceFlagValues, root string) (*batch.SourceHandler, error) {
+	onSuccess, err := batch.ParseSourcePolicy(values.OnSuccess, true)
+	if err != nil {
+		return nil, fmt.Errorf("--on-success hatalı: %w", err)
+	}
+	onFailure, err := batch.ParseSourcePolicy(values.OnFailure, false)
+	if err != nil {
+		return nil, fmt.Errorf("--on-failure hatalı: %w", err)
+	}
+	handler := &batch.SourceHandler{OnSuccess: onSuccess, OnFailure: onFailure, Root: root}
+	if path := strings.TrimSpace(values.Manifest); path != "" {
+		handler.Manifest = batch.NewManifest(path)
+	}
+	if !handler.Active() {
+		return nil, nil
+	}
+	return handler, nil
+}
+
+// checkInPlaceSourceAction kaynakla aynı formata overwrite politikasıyla yazılan işlerde
+// --on-success move/delete kullanımını reddeder: çıktı girdinin üzerine yazılabilir ve
+// girdiyi taşımak ya da silmek yeni üretilen çıktıyı da götürür.
+func checkInPlaceSourceAction(handler *batch.SourceHandler, conflictPolicy, fromFormat string, targetFormats []string) error {
+	if handler == nil || conflictPolicy != converter.ConflictOverwrite {
+		return nil
+	}
+	if handler.OnSuccess.Action != batch.SourceMove && handler.OnSuccess.Action != batch.SourceDelete {
+		return nil
+	}
+	for _, to := range targetFormats {
+		if converter.NormalizeFormat(to) == converter.NormalizeFormat(fromFormat) {
+			return fmt.Errorf("--on-success %s, --on-conflict overwrite ile aynı formata (%s -> %s) dönüşümde kullanılamaz: çıktı kaynağın üzerine yazılabilir", handler.OnSuccess, fromFormat, to)
+		}
+	}
+	return nil
+}
+
+// sourceMoveDirs handler'ın girdileri taşıyacağı dizinleri döner.
+func sourceMoveDirs(handler *batch.SourceHandler) []string {
+	var dirs []string
+	for _, p := range []batch.SourcePolicy{handler.OnSuccess, handler.OnFailure} {
+		if p.Action == batch.SourceMove {
+			dirs = append(dirs, p.Dir)
+		}
+	}
+	return dirs
+}
+
+// isWithinDir path'in dir ile aynı ya da onun altında olup olmadığını döner.
+func isWithinDir(path, dir string) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// globRoot glob deseninin joker karakter içermeyen baş kısmını döner; taşımada
+// klasör yapısı bu dizine göre korunur (ör: "inbox/*/*.png" -> "inbox").
+func globRoot(pattern string) string {
+	dir := filepath.Dir(pattern)
+	for dir != "." && dir != string(filepath.Separator) && strings.ContainsAny(dir, "*?[") {
+		dir = filepath.Dir(dir)
+	}
+	return dir
+}
+
+// applySourceActions eylemleri uygular, manifest'i yazar ve sonucu ekrana basar.
+// Eylemi başarısız olan girdi sayısını döner.
+func applySourceActions(handler *batch.SourceHandler, results []batch.JobResult, jsonOutput bool) ([]batch.SourceAction, int) {
+	if handler == nil {
+		return nil, 0
+	}
+	actions := handler.Apply(results)
+	failed := 0
+	moved, deleted := 0, 0
+	for _, a := range actions {
+		switch {
+		case a.Error != "":
+			failed++
+			ui.PrintWarning(fmt.Sprintf("Kaynak eylemi başarısız (%s): %s: %s", a.Action, a.Input, a.Error))
+		case a.Action == batch.SourceMove:
+			moved++
+		case a.Action == batch.SourceDelete:
+			deleted++
+		}
+	}
+	if !jsonOutput && (moved > 0 || deleted > 0) {
+		ui.PrintInfo(fmt.Sprintf("Kaynak dosyalar: %d taşındı, %d silindi", moved, deleted))
+	}
+	if handler.Manifest != nil {
+		if err := handler.Manifest.Write(); err != nil {
+			failed++
+			ui.PrintWarning(fmt.Sprintf("Manifest yazılamadı: %s", err.Error()))
+		} else if !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("Manifest yazıldı: %s", handler.Manifest.Path()))
+		}
+	}
+	return actions, failed
+}
+
+// addSourceFlags dönüşüm sonrası kaynak dosya eylemi flag'lerini komuta ekler.
+func addSourceFlags(cmd *cobra.Command, values *sourceFlagValues) {
+	cmd.Flags().StringVar(&values.OnSuccess, "on-success", batch.SourceKeep, "Başarılı girdilere uygulanacak eylem: keep, delete, move:<dizin> (çıktılar doğrulandıktan sonra)")
+	cmd.Flags().StringVar(&values.OnFailure, "on-failure", batch.SourceKeep, "Başarısız girdilere uygulanacak eylem: keep, move:<dizin>")
+	cmd.Flags().StringVar(&values.Manifest, "manifest", "", "Girdi/çıktı eşlemesini ve SHA-256 özetlerini bu JSON dosyasına yaz")
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+)
+
+func TestBuildSourceHandler(t *testing.T) {
+	handler, err := buildSourceHandler(sourceFlagValues{OnSuccess: "keep", OnFailure: "keep"}, "inbox")
+	if err != nil || handler != nil {
+		t.Fatalf("expected nil handler for keep/keep, got %+v (%v)", handler, err)
+	}
+
+	handler, err = buildSourceHandler(sourceFlagValues{OnSuccess: "move:./done", OnFailure: "move:./failed", Manifest: "m.json"}, "inbox")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handler.OnSuccess.Dir != "./done" || handler.OnFailure.Action != batch.SourceMove || handler.Manifest == nil || handler.Root != "inbox" {
+		t.Fatalf("unexpected handler: %+v", handler)
+	}
+	if dirs := sourceMoveDirs(handler); len(dirs) != 2 {
+		t.Fatalf("expected 2 move dirs, got %v", dirs)
+	}
+
+	if _, err := buildSourceHandler(sourceFlagValues{OnFailure: "delete"}, ""); err == nil {
+		t.Fatalf("expected --on-failure delete to be rejected")
+	}
+}
+
+func TestGlobRoot(t *testing.T) {
+	cases := map[string]string{
+		"inbox/*.png":          "inbox",
+		"inbox/*/raw/*.png":    "inbox",
+		"./arsiv/**/*.png":     "arsiv",
+		"*.png":                ".",
+		"inbox/a/photo[12].jp": filepath.Join("inbox", "a"),
+	}
+	for pattern, want := range cases {
+		if got := globRoot(pattern); got != want {
+			t.Fatalf("globRoot(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+func TestIsWithinDir(t *testing.T) {
+	if !isWithinDir("inbox/done", "inbox") || !isWithinDir("inbox", "./inbox") {
+		t.Fatalf("expected nested dirs to be detected")
+	}
+	if isWithinDir("done", "inbox") || isWithinDir("inbox2", "inbox") {
+		t.Fatalf("unexpected nested dir match")
+	}
+}
+
+func TestCheckInPlaceSourceAction(t *testing.T) {
+	handler := &batch.SourceHandler{OnSuccess: batch.SourcePolicy{Action: batch.SourceDelete}}
+	if err := checkInPlaceSourceAction(handler, "overwrite", "png", []string{"webp", "png"}); err == nil {
+		t.Fatalf("expected in-place delete with overwrite to be rejected")
+	}
+	if err := checkInPlaceSourceAction(handler, "overwrite", "jpeg", []string{"jpg"}); err == nil {
+		t.Fatalf("expected format aliases to be treated as the same format")
+	}
+	if err := checkInPlaceSourceAction(handler, "versioned", "png", []string{"png"}); err != nil {
+		t.Fatalf("versioned outputs never replace the source: %v", err)
+	}
+	if err := checkInPlaceSourceAction(handler, "overwrite", "png", []string{"webp"}); err != nil {
+		t.Fatalf("unexpected error for different target format: %v", err)
+	}
+	if err := checkInPlaceSourceAction(nil, "overwrite", "png", []string{"png"}); err != nil {
+		t.Fatalf("unexpected error without source handler: %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// transformFlagNames sadece video kaynaklı dönüşümlerde geçerli kırpma/döndürme flag'leridir.
+var transformFlagNames = []string{"crop", "aspect", "gravity", "rotate", "flip", "auto-crop"}
+
+// transformFlagValues convert/batch komutlarının kırpma/döndürme flag değerlerini taşır.
+type transformFlagValues struct {
+	Crop     string
+	Aspect   string
+	Gravity  string
+	Rotate   int
+	Flip     string
+	AutoCrop bool
+}
+
+// resolveTransformSpec kaynak/hedef çiftine göre kırpma/döndürme ayarlarını doğrular ve spec üretir.
+// Kaynak video ve hedef video/gif/webp değilse flag verilmişse hata, verilmemişse nil döner.
+func resolveTransformSpec(cmd *cobra.Command, values transformFlagValues, from string, to string) (*converter.TransformSpec, error) {
+	if !converter.IsVideoFormat(from) || !(converter.IsVideoFormat(to) || converter.IsAnimatedTarget(from, to)) {
+		if anyFlagChanged(cmd, transformFlagNames...) {
+			return nil, fmt.Errorf("--crop, --aspect, --gravity, --rotate, --flip ve --auto-crop sadece video dönüşümlerinde kullanılabilir")
+		}
+		return nil, nil
+	}
+	if cmd.Flags().Changed("gravity") && values.Aspect == "" && values.Crop == "" {
+		return nil, fmt.Errorf("--gravity sadece --aspect veya ofsetsiz --crop ile kullanılabilir")
+	}
+	return converter.BuildTransformSpec(values.Crop, values.Aspect, values.Gravity, values.Rotate, values.Flip, values.AutoCrop)
+}
+
+// addTransformFlags kırpma/döndürme flag'lerini komuta ekler.
+func addTransformFlags(cmd *cobra.Command, values *transformFlagValues) {
+	cmd.Flags().StringVar(&values.Crop, "crop", "", "Kırpma dikdörtgeni: W:H:X:Y veya WxH+X+Y (ofsetsizse gravity'ye göre hizalanır)")
+	cmd.Flags().StringVar(&values.Aspect, "aspect", "", "En-boy oranına kırp (ör: 9:16, 1:1, 4:5)")
+	cmd.Flags().StringVar(&values.Gravity, "gravity", "", "Kırpmada korunacak bölge: center, top, bottom, left, right, top-left...")
+	cmd.Flags().IntVar(&values.Rotate, "rotate", 0, "Saat yönünde döndürme, derece (90/180/270 transpose; diğer açılar siyah dolgulu)")
+	cmd.Flags().StringVar(&values.Flip, "flip", "", "Çevirme: horizontal, vertical, both")
+	cmd.Flags().BoolVar(&values.AutoCrop, "auto-crop", false, "Siyah bantları cropdetect ile bulup otomatik kırp")
+}
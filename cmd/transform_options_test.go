@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newTransformTestCommand(values *transformFlagValues) *cobra.Command {
+	cmd := &cobra.Command{Use: "convert"}
+	addTransformFlags(cmd, values)
+	return cmd
+}
+
+func TestResolveTransformSpecForVideo(t *testing.T) {
+	var values transformFlagValues
+	cmd := newTransformTestCommand(&values)
+	if err := cmd.ParseFlags([]string{"--aspect", "9:16", "--gravity", "left", "--rotate", "90"}); err != nil {
+		t.Fatalf("parse flags failed: %v", err)
+	}
+	spec, err := resolveTransformSpec(cmd, values, "mp4", "mp4")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec == nil || spec.Aspect != "9:16" || spec.Gravity != "left" || spec.Rotate != 90 {
+		t.Fatalf("unexpected spec: %+v", spec)
+	}
+
+	spec, err = resolveTransformSpec(cmd, values, "mov", "gif")
+	if err != nil || spec == nil {
+		t.Fatalf("expected transform spec for animated target, got %+v %v", spec, err)
+	}
+}
+
+func TestResolveTransformSpecRejectsNonVideo(t *testing.T) {
+	var values transformFlagValues
+	cmd := newTransformTestCommand(&values)
+	if err := cmd.ParseFlags([]string{"--flip", "h"}); err != nil {
+		t.Fatalf("parse flags failed: %v", err)
+	}
+	if _, err := resolveTransformSpec(cmd, values, "png", "jpg"); err == nil {
+		t.Fatalf("expected error for image conversion")
+	}
+	if _, err := resolveTransformSpec(cmd, values, "mp4", "mp3"); err == nil {
+		t.Fatalf("expected error for audio target")
+	}
+
+	var empty transformFlagValues
+	spec, err := resolveTransformSpec(newTransformTestCommand(&empty), empty, "png", "jpg")
+	if err != nil || spec != nil {
+		t.Fatalf("expected nil spec without flags, got %+v %v", spec, err)
+	}
+}
+
+func TestResolveTransformSpecGravityNeedsCrop(t *testing.T) {
+	var values transformFlagValues
+	cmd := newTransformTestCommand(&values)
+	if err := cmd.ParseFlags([]string{"--gravity", "top"}); err != nil {
+		t.Fatalf("parse flags failed: %v", err)
+	}
+	if _, err := resolveTransformSpec(cmd, values, "mp4", "mp4"); err == nil {
+		t.Fatalf("expected error for --gravity without --aspect/--crop")
+	}
+}
+
+func TestInteractiveVideoTransformStep(t *testing.T) {
+	m := newInteractiveModel(nil, false)
+	m.sourceFormat = "mp4"
+	m.targetFormat = "mp4"
+	m.flowResizeOnly = true
+
+	next, _ := m.proceedAfterResizeSelection()
+	model := next.(interactiveModel)
+	if model.state != stateVideoTransformSelect {
+		t.Fatalf("expected transform select for video source, got %v", model.state)
+	}
+
+	for i, opt := range videoTransformOptions {
+		if opt.Key == "rotate-90" {
+			model.cursor = i
+		}
+	}
+	next, _ = model.selectVideoTransform()
+	model = next.(interactiveModel)
+	if model.videoTransform == nil || model.videoTransform.Rotate != 90 {
+		t.Fatalf("expected rotate-90 transform, got %+v", model.videoTransform)
+	}
+	if model.state != stateFileBrowser {
+		t.Fatalf("expected file browser after transform select, got %v", model.state)
+	}
+
+	image := newInteractiveModel(nil, false)
+	image.sourceFormat = "png"
+	next, _ = image.proceedAfterResizeSelection()
+	if next.(interactiveModel).state == stateVideoTransformSelect {
+		t.Fatalf("image sources must skip the transform step")
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// verifyFlagValues batch/watch komutlarının çıktı doğrulama flag değerlerini taşır.
+type verifyFlagValues struct {
+	Enabled   bool
+	Tolerance time.Duration
+}
+
+// buildVerifyOptions --verify verilmişse doğrulama ayarlarını döner; aksi halde nil.
+func buildVerifyOptions(values verifyFlagValues) (*converter.VerifyOptions, error) {
+	if values.Tolerance < 0 {
+		return nil, fmt.Errorf("--verify-tolerance negatif olamaz: %s", values.Tolerance)
+	}
+	if !values.Enabled {
+		return nil, nil
+	}
+	return &converter.VerifyOptions{DurationTolerance: values.Tolerance}, nil
+}
+
+// addVerifyFlags çıktı doğrulama flag'lerini komuta ekler.
+func addVerifyFlags(cmd *cobra.Command, values *verifyFlagValues) {
+	cmd.Flags().BoolVar(&values.Enabled, "verify", false, "Çıktıları doğrula: görselleri yeniden decode et, medyayı ffprobe ile oku, PDF/DOCX'i yeniden ayrıştır")
+	cmd.Flags().DurationVar(&values.Tolerance, "verify-tolerance", converter.DefaultVerifyTolerance, "--verify ile medya çıktısı süresinin kaynaktan sapabileceği en fazla fark")
+}
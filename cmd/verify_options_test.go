@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildVerifyOptions(t *testing.T) {
+	opts, err := buildVerifyOptions(verifyFlagValues{Tolerance: time.Second})
+	if err != nil || opts != nil {
+		t.Fatalf("expected verification to be off, got %+v (%v)", opts, err)
+	}
+	opts, err = buildVerifyOptions(verifyFlagValues{Enabled: true, Tolerance: 2 * time.Second})
+	if err != nil || opts == nil || opts.DurationTolerance != 2*time.Second {
+		t.Fatalf("unexpected verify options: %+v (%v)", opts, err)
+	}
+	if _, err := buildVerifyOptions(verifyFlagValues{Enabled: true, Tolerance: -time.Second}); err == nil {
+		t.Fatalf("expected negative tolerance to be rejected")
+	}
+}
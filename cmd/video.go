@@ -27,12 +27,20 @@ var (
 	videoTrimCodec      string
 	videoTrimOutputFile string
 	videoTrimName       string
+	videoTrimNameTmpl   string
 	videoTrimToFormat   string
 	videoTrimProfile    string
 	videoTrimQuality    int
 	videoTrimConflict   string
 	videoTrimPreserveMD bool
 	videoTrimStripMD    bool
+	videoTrimVCodec     string
+	videoTrimACodec     string
+	videoTrimCRF        int
+	videoTrimBitrate    string
+	videoTrimEncPreset  string
+	videoTrimPixFmt     string
+	videoTrimAudioBR    string
 )
 
 const (
@@ -68,7 +76,8 @@ var videoTrimCmd = &cobra.Command{
   fileconverter-cli video trim input.mp4 --mode remove --ranges "00:00:05-00:00:08,00:00:20-00:00:25"
   fileconverter-cli video trim input.mp4 --mode remove --ranges "5-8,20-25" --dry-run
   fileconverter-cli video trim input.mp4 --start 00:01:00 --end 00:01:30 --codec reencode
-  fileconverter-cli video trim input.mov --duration 15 --to mp4 --on-conflict versioned`,
+  fileconverter-cli video trim input.mov --duration 15 --to mp4 --on-conflict versioned
+  fileconverter-cli video trim input.mp4 --start 10 --duration 30 --video-codec h265 --crf 26`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		input := args[0]
@@ -138,8 +147,28 @@ var videoTrimCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		videoSpec, err := converter.BuildVideoSpec(videoTrimVCodec, videoTrimCRF, videoTrimBitrate, videoTrimEncPreset, videoTrimPixFmt, videoTrimACodec, videoTrimAudioBR)
+		if err != nil {
+			return err
+		}
+		if err := converter.ValidateVideoSpec(videoSpec, targetFormat); err != nil {
+			return err
+		}
+		if videoSpec != nil {
+			codec, codecNote, err = applyVideoSpecToTrimCodec(codec, codecNote, videoTrimCodec, videoSpec)
+			if err != nil {
+				return err
+			}
+			if err := prepareVideoEncoders(videoSpec, targetFormat, false); err != nil {
+				return err
+			}
+		}
 
-		outputPath := buildTrimOutputPath(input, targetFormat, videoTrimName, videoTrimOutputFile, mode)
+		outputPath, err := resolveNamedOutputPath(videoTrimNameTmpl, videoTrimName, videoTrimOutputFile, input, targetFormat, 0,
+			buildTrimOutputPath(input, targetFormat, videoTrimName, videoTrimOutputFile, mode))
+		if err != nil {
+			return err
+		}
 		conflict := converter.NormalizeConflictPolicy(videoTrimConflict)
 		if conflict == "" {
 			return fmt.Errorf("gecersiz on-conflict politikasi: %s", videoTrimConflict)
@@ -184,12 +213,12 @@ var videoTrimCmd = &cobra.Command{
 		}
 		started := time.Now()
 		if mode == trimModeClip {
-			err = runTrimFFmpeg(input, outputPath, startValue, endValue, durationValue, targetFormat, codec, videoTrimQuality, metadataMode, verbose)
+			err = runTrimFFmpeg(input, outputPath, startValue, endValue, durationValue, targetFormat, codec, videoTrimQuality, videoSpec, metadataMode, verbose)
 		} else {
 			if len(removeRanges) > 0 {
-				err = runTrimRemoveRangesFFmpeg(input, outputPath, removeRanges, targetFormat, codec, videoTrimQuality, metadataMode, verbose)
+				err = runTrimRemoveRangesFFmpeg(input, outputPath, removeRanges, targetFormat, codec, videoTrimQuality, videoSpec, metadataMode, verbose)
 			} else {
-				err = runTrimRemoveFFmpeg(input, outputPath, startValue, endValue, durationValue, targetFormat, codec, videoTrimQuality, metadataMode, verbose)
+				err = runTrimRemoveFFmpeg(input, outputPath, startValue, endValue, durationValue, targetFormat, codec, videoTrimQuality, videoSpec, metadataMode, verbose)
 			}
 		}
 		if err != nil {
@@ -217,12 +246,14 @@ func init() {
 	videoTrimCmd.Flags().StringVar(&videoTrimCodec, "codec", "auto", "Codec modu: auto, copy veya reencode")
 	videoTrimCmd.Flags().StringVar(&videoTrimOutputFile, "output-file", "", "Tam çıktı dosya yolu")
 	videoTrimCmd.Flags().StringVarP(&videoTrimName, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	addNameTemplateFlag(videoTrimCmd, &videoTrimNameTmpl)
 	videoTrimCmd.Flags().StringVar(&videoTrimToFormat, "to", "", "Hedef format (örn: mp4, mov)")
 	videoTrimCmd.Flags().StringVar(&videoTrimProfile, "profile", "", "Hazır profil (ör: social-story, podcast-clean, archive-lossless)")
 	videoTrimCmd.Flags().IntVarP(&videoTrimQuality, "quality", "q", 0, "Reencode modunda kalite seviyesi (1-100)")
 	videoTrimCmd.Flags().StringVar(&videoTrimConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
 	videoTrimCmd.Flags().BoolVar(&videoTrimPreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
 	videoTrimCmd.Flags().BoolVar(&videoTrimStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
+	addVideoCodecFlags(videoTrimCmd, &videoTrimVCodec, &videoTrimACodec, &videoTrimCRF, &videoTrimBitrate, &videoTrimEncPreset, &videoTrimPixFmt, &videoTrimAudioBR)
 
 	videoCmd.AddCommand(videoTrimCmd)
 	rootCmd.AddCommand(videoCmd)
@@ -446,7 +477,7 @@ func buildTrimOutputPath(input string, targetFormat string, customName string, e
 	return filepath.Join(filepath.Dir(input), base+"."+targetFormat)
 }
 
-func runTrimFFmpeg(input string, output string, start string, end string, duration string, targetFormat string, codec string, quality int, metadataMode string, verbose bool) error {
+func runTrimFFmpeg(input string, output string, start string, end string, duration string, targetFormat string, codec string, quality int, videoSpec *converter.VideoSpec, metadataMode string, verbose bool) error {
 	ffmpegPath, err := exec.LookPath("ffmpeg")
 	if err != nil {
 		return fmt.Errorf("ffmpeg bulunamadi")
@@ -503,7 +534,7 @@ func runTrimFFmpeg(input string, output string, start string, end string, durati
 		args = append(args, "-t", strings.TrimSpace(duration))
 	}
 
-	args = append(args, trimCodecArgs(targetFormat, codec, quality)...)
+	args = append(args, trimCodecArgs(targetFormat, codec, quality, videoSpec)...)
 
 	args = append(args, converter.MetadataFFmpegArgs(metadataMode)...)
 	args = append(args, "-y")
@@ -515,12 +546,12 @@ func runTrimFFmpeg(input string, output string, start string, end string, durati
 	return nil
 }
 
-func runTrimRemoveFFmpeg(input string, output string, start string, end string, duration string, targetFormat string, codec string, quality int, metadataMode string, verbose bool) error {
+func runTrimRemoveFFmpeg(input string, output string, start string, end string, duration string, targetFormat string, codec string, quality int, videoSpec *converter.VideoSpec, metadataMode string, verbose bool) error {
 	removeRanges, err := resolveRemoveRanges(start, end, duration, nil)
 	if err != nil {
 		return err
 	}
-	return runTrimRemoveRangesFFmpeg(input, output, removeRanges, targetFormat, codec, quality, metadataMode, verbose)
+	return runTrimRemoveRangesFFmpeg(input, output, removeRanges, targetFormat, codec, quality, videoSpec, metadataMode, verbose)
 }
 
 type keepSegment struct {
@@ -546,6 +577,8 @@ type videoTrimPlan struct {
 	ClipHasEnd        bool
 	RemoveRanges      []trimRange
 	KeepSegments      []keepSegment
+	// Retime doluysa plan speed/reverse/timelapse/fps komutlarına aittir; klip aralığı işlenecek bölümdür.
+	Retime *videoRetimeSpec
 }
 
 func buildVideoTrimPlan(
@@ -696,6 +729,9 @@ func printVideoTrimPlan(plan videoTrimPlan) {
 	if plan.Mode == trimModeRemove {
 		modeLabel = "Aralık Sil + Birleştir"
 	}
+	if plan.Retime != nil {
+		modeLabel = describeRetimeSpec(*plan.Retime)
+	}
 	ui.PrintInfo(fmt.Sprintf(
 		"Plan: mod=%s, codec=%s, kalite=%d, metadata=%s, on-conflict=%s",
 		modeLabel,
@@ -719,6 +755,14 @@ func printVideoTrimPlan(plan videoTrimPlan) {
 		if plan.ClipHasEnd {
 			endLabel = formatTrimSecondsHuman(plan.ClipEndSec)
 		}
+		if plan.Retime != nil {
+			ui.PrintInfo(fmt.Sprintf("İşlenecek aralık: %s -> %s", formatTrimSecondsHuman(plan.ClipStartSec), endLabel))
+			if plan.ClipHasEnd {
+				ui.PrintInfo(fmt.Sprintf("Tahmini çıktı süresi: %s", formatTrimSecondsHuman(retimeOutputDuration(*plan.Retime, plan.ClipEndSec-plan.ClipStartSec))))
+			}
+			ui.PrintInfo("İşlemi uygulamak için --dry-run/--preview flag'ini kaldırın.")
+			return
+		}
 		ui.PrintInfo(fmt.Sprintf("Klip aralığı: %s -> %s", formatTrimSecondsHuman(plan.ClipStartSec), endLabel))
 		if plan.ClipHasEnd {
 			ui.PrintInfo(fmt.Sprintf("Tahmini klip süresi: %s", formatTrimSecondsHuman(plan.ClipEndSec-plan.ClipStartSec)))
@@ -803,7 +847,7 @@ func formatTrimSecondsHuman(value float64) string {
 	return fmt.Sprintf("%02d:%02d:%02d.%03d", hours, minutes, seconds, ms)
 }
 
-func runTrimRemoveRangesFFmpeg(input string, output string, ranges []trimRange, targetFormat string, codec string, quality int, metadataMode string, verbose bool) error {
+func runTrimRemoveRangesFFmpeg(input string, output string, ranges []trimRange, targetFormat string, codec string, quality int, videoSpec *converter.VideoSpec, metadataMode string, verbose bool) error {
 	ffmpegPath, err := exec.LookPath("ffmpeg")
 	if err != nil {
 		return fmt.Errorf("ffmpeg bulunamadi")
@@ -873,7 +917,7 @@ func runTrimRemoveRangesFFmpeg(input string, output string, ranges []trimRange,
 			singleArgs = append(singleArgs, "-loglevel", "error")
 		}
 		singleArgs = append(singleArgs, "-i", remainingParts[0])
-		singleArgs = append(singleArgs, trimCodecArgs(targetFormat, codec, quality)...)
+		singleArgs = append(singleArgs, trimCodecArgs(targetFormat, codec, quality, videoSpec)...)
 		singleArgs = append(singleArgs, converter.MetadataFFmpegArgs(metadataMode)...)
 		singleArgs = append(singleArgs, "-y", output)
 		return runFFmpegCommand(ffmpegPath, singleArgs, "video remove çıktı üretilemedi")
@@ -894,7 +938,7 @@ func runTrimRemoveRangesFFmpeg(input string, output string, ranges []trimRange,
 		concatArgs = append(concatArgs, "-loglevel", "error")
 	}
 	concatArgs = append(concatArgs, "-f", "concat", "-safe", "0", "-i", listPath)
-	concatArgs = append(concatArgs, trimCodecArgs(targetFormat, codec, quality)...)
+	concatArgs = append(concatArgs, trimCodecArgs(targetFormat, codec, quality, videoSpec)...)
 	concatArgs = append(concatArgs, converter.MetadataFFmpegArgs(metadataMode)...)
 	concatArgs = append(concatArgs, "-y", output)
 	return runFFmpegCommand(ffmpegPath, concatArgs, "video remove birleştirme hatası")
@@ -965,13 +1009,27 @@ func buildKeepSegmentsFromRanges(ranges []trimRange, durationSec float64, hasDur
 	return segments, nil
 }
 
-func trimCodecArgs(targetFormat string, codec string, quality int) []string {
+func trimCodecArgs(targetFormat string, codec string, quality int, videoSpec *converter.VideoSpec) []string {
 	if codec == "copy" {
 		return []string{"-c", "copy"}
 	}
+	if videoSpec != nil {
+		return converter.VideoEncodeArgs(targetFormat, quality, videoSpec)
+	}
 	return trimReencodeArgs(targetFormat, quality)
 }
 
+// applyVideoSpecToTrimCodec codec ayarı verildiğinde trim codec modunu reencode'a çeker.
+func applyVideoSpecToTrimCodec(codec string, codecNote string, requested string, videoSpec *converter.VideoSpec) (string, string, error) {
+	if normalizeTrimCodec(requested) == "copy" {
+		return "", "", fmt.Errorf("--codec copy ile --video-codec/--crf/--bitrate gibi encode ayarları birlikte kullanılamaz")
+	}
+	if codec == "copy" {
+		return "reencode", "encode ayarları verildiği için reencode seçildi.", nil
+	}
+	return codec, codecNote, nil
+}
+
 func trimReencodeArgs(targetFormat string, quality int) []string {
 	to := converter.NormalizeFormat(targetFormat)
 	crf := trimCRF(quality)
@@ -0,0 +1,667 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+const (
+	videoAudioReplace = "replace"
+	videoAudioMix     = "mix"
+	videoAudioMute    = "mute"
+	videoAudioSelect  = "select"
+
+	// sidechaincompress ayarları: konuşma başladığında müzik hızlıca kısılır, yavaşça geri gelir.
+	duckingFilter = "sidechaincompress=threshold=0.03:ratio=8:attack=20:release=400"
+)
+
+var (
+	audioReplaceValues videoAudioFlagValues
+	audioMixValues     videoAudioFlagValues
+	audioMuteValues    videoAudioFlagValues
+	audioSelectValues  videoAudioFlagValues
+)
+
+// videoAudioFlagValues video audio alt komutlarının bayraklarını taşır.
+type videoAudioFlagValues struct {
+	To           string
+	Name         string
+	NameTemplate string
+	OutputFile   string
+	Conflict     string
+	PreserveMD   bool
+	StripMD      bool
+	DryRun       bool
+	ACodec       string
+	AudioBR      string
+	Normalize    bool
+	TargetLUFS   float64
+	AudioStream  string
+	KeepLang     string
+	DropLang     string
+	Add          bool
+	Language     string
+	Offset       float64
+	MusicVolume  float64
+	VideoVolume  float64
+	Duck         bool
+	Loop         bool
+	Ranges       string
+	All          bool
+}
+
+// videoAudioPlan tek bir video audio işleminin çözümlenmiş planı.
+type videoAudioPlan struct {
+	Operation string   `json:"operation"`
+	Input     string   `json:"input"`
+	Audio     string   `json:"audio,omitempty"`
+	Output    string   `json:"output"`
+	Tracks    []int    `json:"tracks,omitempty"`
+	Args      []string `json:"ffmpeg_args"`
+	Skipped   bool     `json:"skipped,omitempty"`
+}
+
+var videoAudioCmd = &cobra.Command{
+	Use:   "audio",
+	Short: "Video içindeki ses izlerini değiştirir, karıştırır, susturur veya seçer",
+	Long: `Video içindeki ses izleri üzerinde çalışır. Görüntü her zaman kopyalanır (re-encode yapılmaz).
+
+Alt komutlar:
+  replace  Ses izini başka bir ses dosyasıyla değiştirir veya ek iz olarak ekler
+  mix      Arka plan müziğini mevcut sesle karıştırır (seviye ve ducking)
+  mute     Belirli aralıkları veya tüm sesi susturur
+  select   Çok dilli dosyalarda ses izlerini seçer/çıkarır
+
+Tüm alt komutlar --normalize ile gömülü sesi ayrı çıkarma adımı olmadan EBU R128'e göre dengeler.`,
+}
+
+var videoAudioReplaceCmd = &cobra.Command{
+	Use:   "replace <video-dosyasi> <ses-dosyasi>",
+	Short: "Videonun ses izini verilen ses dosyasıyla değiştirir",
+	Long: `Videonun ses izini verilen ses dosyasıyla değiştirir. Ses videodan kısaysa sessizlikle
+doldurulur, uzunsa video sonunda kesilir. --add ile mevcut izler korunur ve yeni ses ek iz olur.
+
+Örnekler:
+  fileconverter-cli video audio replace klip.mp4 seslendirme.wav
+  fileconverter-cli video audio replace film.mkv dublaj.aac --add --language tur
+  fileconverter-cli video audio replace klip.mp4 muzik.mp3 --offset 2.5 --normalize`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoAudio(cmd, videoAudioReplace, args[0], args[1], &audioReplaceValues)
+	},
+}
+
+var videoAudioMixCmd = &cobra.Command{
+	Use:   "mix <video-dosyasi> <muzik-dosyasi>",
+	Short: "Arka plan müziğini videonun sesiyle karıştırır",
+	Long: `Müziği videonun mevcut sesiyle karıştırır. --duck ile konuşma olduğunda müzik
+otomatik kısılır. Çıktı süresi videonun süresidir; --loop ile kısa müzik tekrarlanır.
+
+Örnekler:
+  fileconverter-cli video audio mix vlog.mp4 muzik.mp3 --music-volume 0.2 --duck
+  fileconverter-cli video audio mix tanitim.mp4 jingle.wav --loop --normalize`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoAudio(cmd, videoAudioMix, args[0], args[1], &audioMixValues)
+	},
+}
+
+var videoAudioMuteCmd = &cobra.Command{
+	Use:   "mute <video-dosyasi>",
+	Short: "Videodaki sesi belirli aralıklarda veya tamamen susturur",
+	Long: `--ranges ile verilen aralıklarda sesi susturur (süre değişmez). --all ile ses izi tamamen kaldırılır.
+
+Örnekler:
+  fileconverter-cli video audio mute kayit.mp4 --ranges "00:01:05-00:01:09,00:03:00-00:03:02"
+  fileconverter-cli video audio mute kayit.mp4 --all`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoAudio(cmd, videoAudioMute, args[0], "", &audioMuteValues)
+	},
+}
+
+var videoAudioSelectCmd = &cobra.Command{
+	Use:   "select <video-dosyasi>",
+	Short: "Çok dilli videolarda ses izlerini seçer",
+	Long: `Ses izlerini sıra numarası (1'den başlar) veya dil etiketine göre seçer; seçilmeyen izler
+çıkarılır. İlk seçilen iz varsayılan iz olarak işaretlenir. Ses kopyalanır, --normalize
+veya --audio-codec verilirse yeniden encode edilir.
+
+Örnekler:
+  fileconverter-cli video audio select film.mkv --audio-stream 2
+  fileconverter-cli video audio select film.mkv --keep-lang tur,eng
+  fileconverter-cli video audio select film.mkv --drop-lang ger,fre`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoAudio(cmd, videoAudioSelect, args[0], "", &audioSelectValues)
+	},
+}
+
+func init() {
+	addVideoAudioFlags(videoAudioReplaceCmd, &audioReplaceValues)
+	videoAudioReplaceCmd.Flags().BoolVar(&audioReplaceValues.Add, "add", false, "Mevcut ses izlerini koru, yeni sesi ek iz olarak ekle")
+	videoAudioReplaceCmd.Flags().StringVar(&audioReplaceValues.Language, "language", "", "Yeni ses izinin dil etiketi (ör: tur, eng)")
+	videoAudioReplaceCmd.Flags().Float64Var(&audioReplaceValues.Offset, "offset", 0, "Sesi kaydır (saniye; negatif değer sesin başını keser)")
+	videoAudioReplaceCmd.Flags().BoolVar(&audioReplaceValues.Loop, "loop", false, "Ses videodan kısaysa sessizlik yerine tekrarla")
+
+	addVideoAudioFlags(videoAudioMixCmd, &audioMixValues)
+	videoAudioMixCmd.Flags().Float64Var(&audioMixValues.MusicVolume, "music-volume", 0.3, "Müzik ses seviyesi çarpanı (0-2)")
+	videoAudioMixCmd.Flags().Float64Var(&audioMixValues.VideoVolume, "video-volume", 1, "Videonun mevcut ses seviyesi çarpanı (0-2)")
+	videoAudioMixCmd.Flags().BoolVar(&audioMixValues.Duck, "duck", false, "Konuşma olduğunda müziği otomatik kıs (ducking)")
+	videoAudioMixCmd.Flags().BoolVar(&audioMixValues.Loop, "loop", false, "Müzik videodan kısaysa tekrarla")
+	videoAudioMixCmd.Flags().Float64Var(&audioMixValues.Offset, "offset", 0, "Müziğin başlayacağı an (saniye)")
+
+	addVideoAudioFlags(videoAudioMuteCmd, &audioMuteValues)
+	videoAudioMuteCmd.Flags().StringVar(&audioMuteValues.Ranges, "ranges", "", "Susturulacak aralıklar (ör: 5-8,00:01:00-00:01:04)")
+	videoAudioMuteCmd.Flags().BoolVar(&audioMuteValues.All, "all", false, "Ses izini tamamen kaldır")
+
+	addVideoAudioFlags(videoAudioSelectCmd, &audioSelectValues)
+
+	videoAudioCmd.AddCommand(videoAudioReplaceCmd, videoAudioMixCmd, videoAudioMuteCmd, videoAudioSelectCmd)
+	videoCmd.AddCommand(videoAudioCmd)
+}
+
+// addVideoAudioFlags video audio alt komutlarının ortak bayraklarını ekler.
+func addVideoAudioFlags(cmd *cobra.Command, values *videoAudioFlagValues) {
+	f := cmd.Flags()
+	f.StringVar(&values.To, "to", "", "Hedef video formatı (varsayılan: kaynak format)")
+	f.StringVarP(&values.Name, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	f.StringVar(&values.NameTemplate, "name-template", "", nameTemplateFlagUsage)
+	f.StringVar(&values.OutputFile, "output-file", "", "Tam çıktı dosya yolu")
+	f.StringVar(&values.Conflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+	f.BoolVar(&values.PreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
+	f.BoolVar(&values.StripMD, "strip-metadata", false, "Metadata bilgisini temizle")
+	f.BoolVar(&values.DryRun, "dry-run", false, "İşlem yapmadan planı ve ffmpeg argümanlarını göster")
+	f.StringVar(&values.ACodec, "audio-codec", "", "Ses izi codec'i: aac, opus, mp3, vorbis, ac3, flac")
+	f.StringVar(&values.AudioBR, "audio-bitrate", "", "Ses izi bitrate (ör: 192k)")
+	f.BoolVar(&values.Normalize, "normalize", false, "Sonuç sesi EBU R128'e göre normalize et")
+	f.Float64Var(&values.TargetLUFS, "target-lufs", -14, "--normalize hedef loudness (LUFS)")
+	f.StringVar(&values.AudioStream, "audio-stream", "", "Kullanılacak kaynak ses izleri, 1'den başlar (ör: 2 veya 1,3)")
+	f.StringVar(&values.KeepLang, "keep-lang", "", "Sadece bu dillerdeki ses izlerini tut (ör: tur,eng)")
+	f.StringVar(&values.DropLang, "drop-lang", "", "Bu dillerdeki ses izlerini çıkar (ör: ger)")
+}
+
+func runVideoAudio(cmd *cobra.Command, op string, input string, audio string, values *videoAudioFlagValues) error {
+	jsonOutput := isJSONOutput()
+	for _, path := range []string{input, audio} {
+		if path == "" {
+			continue
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return fmt.Errorf("dosya bulunamadi: %s", path)
+		}
+	}
+	if !converter.IsFFmpegAvailable() {
+		return fmt.Errorf("video audio %s için ffmpeg gerekli", op)
+	}
+
+	applyOnConflictDefault(cmd, "on-conflict", &values.Conflict)
+	applyMetadataDefault(cmd, "preserve-metadata", &values.PreserveMD, "strip-metadata", &values.StripMD)
+	metadataMode, err := metadataModeFromFlags(values.PreserveMD, values.StripMD)
+	if err != nil {
+		return err
+	}
+	if err := validateVideoAudioValues(op, *values); err != nil {
+		return err
+	}
+
+	targetFormat := converter.DetectFormat(input)
+	if strings.TrimSpace(values.To) != "" {
+		targetFormat = converter.NormalizeFormat(values.To)
+	}
+	if !converter.IsVideoFormat(targetFormat) {
+		return fmt.Errorf("video audio için video hedef formatı gerekli: %s", targetFormat)
+	}
+
+	audioSpec, err := converter.BuildVideoSpec("", -1, "", "", "", values.ACodec, values.AudioBR)
+	if err != nil {
+		return err
+	}
+	if audioSpec != nil {
+		if audioSpec.AudioCodec == "copy" || audioSpec.AudioCodec == "none" {
+			return fmt.Errorf("--audio-codec %s bu komutta kullanılamaz; sesi kaldırmak için 'video audio mute --all' kullanın", audioSpec.AudioCodec)
+		}
+		// Sadece ses izi encode edilir; görüntü her zaman kopyalanır.
+		audioSpec.Codec = converter.VideoCodecCopy
+		if err := converter.ValidateVideoSpec(audioSpec, targetFormat); err != nil {
+			return err
+		}
+	}
+
+	tracks, err := resolveVideoAudioTracks(op, input, *values)
+	if err != nil {
+		return err
+	}
+
+	outputPath, err := resolveNamedOutputPath(values.NameTemplate, values.Name, values.OutputFile, input, targetFormat, 0,
+		buildVideoAudioOutputPath(input, targetFormat, op, values.Name, values.OutputFile))
+	if err != nil {
+		return err
+	}
+	conflict := converter.NormalizeConflictPolicy(values.Conflict)
+	if conflict == "" {
+		return fmt.Errorf("gecersiz on-conflict politikasi: %s", values.Conflict)
+	}
+	outputPath, skip, err := converter.ResolveOutputPathConflict(outputPath, conflict)
+	if err != nil {
+		return err
+	}
+
+	if !values.DryRun && !skip {
+		if err := prepareVideoEncoders(audioSpec, targetFormat, jsonOutput); err != nil {
+			return err
+		}
+	}
+	audioArgs := converter.VideoAudioTrackArgs(targetFormat, audioSpec)
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	opArgs, err := buildVideoAudioArgs(op, input, audio, *values, tracks, audioArgs)
+	if err != nil {
+		return err
+	}
+	args = append(args, opArgs...)
+	args = append(args, converter.MetadataFFmpegArgs(metadataMode)...)
+	args = append(args, "-y", outputPath)
+	plan := videoAudioPlan{Operation: op, Input: input, Audio: audio, Output: outputPath, Args: args, Skipped: skip}
+	for _, t := range tracks {
+		plan.Tracks = append(plan.Tracks, t+1)
+	}
+
+	if values.DryRun {
+		if jsonOutput {
+			return printJSON(map[string]interface{}{"dry_run": true, "plan": plan})
+		}
+		ui.PrintInfo("Ön izleme modu (--dry-run) — işlem yapılmayacak.")
+		printVideoAudioPlan(plan)
+		return nil
+	}
+	if skip {
+		if jsonOutput {
+			return printJSON(map[string]interface{}{"success": true, "skipped": true, "input": input, "output": outputPath})
+		}
+		ui.PrintWarning(fmt.Sprintf("Çıktı dosyası mevcut, atlandı: %s", outputPath))
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return err
+	}
+
+	if !jsonOutput {
+		ui.PrintConversion(input, outputPath)
+	}
+	started := time.Now()
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return fmt.Errorf("ffmpeg bulunamadi")
+	}
+	if err := runFFmpegCommand(ffmpegPath, args, fmt.Sprintf("video audio %s ffmpeg hatasi", op)); err != nil {
+		if jsonOutput {
+			return err
+		}
+		ui.PrintError(err.Error())
+		return err
+	}
+
+	if jsonOutput {
+		return printJSON(map[string]interface{}{
+			"success":     true,
+			"plan":        plan,
+			"duration_ms": time.Since(started).Milliseconds(),
+		})
+	}
+	ui.PrintSuccess(fmt.Sprintf("Video audio %s tamamlandı!", op))
+	ui.PrintDuration(time.Since(started))
+	return nil
+}
+
+func validateVideoAudioValues(op string, values videoAudioFlagValues) error {
+	if values.Normalize && (values.TargetLUFS < -70 || values.TargetLUFS > -5) {
+		return fmt.Errorf("--target-lufs -70 ile -5 arasında olmalı: %g", values.TargetLUFS)
+	}
+	if strings.TrimSpace(values.KeepLang) != "" && strings.TrimSpace(values.DropLang) != "" {
+		return fmt.Errorf("--keep-lang ve --drop-lang birlikte kullanılamaz")
+	}
+	switch op {
+	case videoAudioReplace:
+		if strings.TrimSpace(values.Language) != "" && len(strings.TrimSpace(values.Language)) != 3 {
+			return fmt.Errorf("--language üç harfli ISO 639-2 kodu olmalı (ör: tur, eng)")
+		}
+		if !values.Add && hasAudioStreamSelector(values) {
+			return fmt.Errorf("--audio-stream/--keep-lang/--drop-lang sadece --add ile korunacak izleri seçmek için kullanılabilir")
+		}
+	case videoAudioMix:
+		if values.MusicVolume < 0 || values.MusicVolume > 2 || values.VideoVolume < 0 || values.VideoVolume > 2 {
+			return fmt.Errorf("--music-volume ve --video-volume 0-2 aralığında olmalı")
+		}
+		if values.Offset < 0 {
+			return fmt.Errorf("mix için --offset negatif olamaz")
+		}
+	case videoAudioMute:
+		hasRanges := strings.TrimSpace(values.Ranges) != ""
+		if hasRanges == values.All {
+			return fmt.Errorf("--ranges veya --all seçeneklerinden biri gerekli")
+		}
+		if values.All && (values.Normalize || hasAudioStreamSelector(values)) {
+			return fmt.Errorf("--all ile --normalize veya ses izi seçimi kullanılamaz")
+		}
+	case videoAudioSelect:
+		if !hasAudioStreamSelector(values) {
+			return fmt.Errorf("--audio-stream, --keep-lang veya --drop-lang seçeneklerinden biri gerekli")
+		}
+	}
+	return nil
+}
+
+func hasAudioStreamSelector(values videoAudioFlagValues) bool {
+	return strings.TrimSpace(values.AudioStream) != "" || strings.TrimSpace(values.KeepLang) != "" || strings.TrimSpace(values.DropLang) != ""
+}
+
+// resolveVideoAudioTracks kaynak videodaki kullanılacak ses izlerini (0 tabanlı a:N) belirler.
+// Seçici verilmemişse ve ffprobe yoksa nil döner; bu durumda tüm ses izleri kullanılır.
+func resolveVideoAudioTracks(op string, input string, values videoAudioFlagValues) ([]int, error) {
+	streams, err := converter.ProbeStreams(input)
+	if err != nil {
+		if hasAudioStreamSelector(values) {
+			return nil, fmt.Errorf("ses izi seçimi için akış bilgisi okunamadı: %w", err)
+		}
+		return nil, nil
+	}
+	tracks, err := selectAudioTracks(converter.StreamsOfType(streams, "audio"), values.AudioStream, values.KeepLang, values.DropLang)
+	if err != nil {
+		return nil, err
+	}
+	if len(tracks) == 0 && op == videoAudioMute && !values.All {
+		return nil, fmt.Errorf("videoda susturulacak ses izi yok")
+	}
+	return tracks, nil
+}
+
+// selectAudioTracks ses izlerini sıra numarası ve dil etiketlerine göre filtreler.
+// audioStream 1 tabanlıdır; dönen değerler ffmpeg'in 0 tabanlı a:N indeksleridir.
+func selectAudioTracks(audio []converter.StreamInfo, audioStream string, keepLang string, dropLang string) ([]int, error) {
+	selected := make([]converter.StreamInfo, 0, len(audio))
+	if strings.TrimSpace(audioStream) != "" {
+		for _, token := range strings.Split(audioStream, ",") {
+			token = strings.TrimSpace(token)
+			if token == "" {
+				continue
+			}
+			n, err := strconv.Atoi(token)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("geçersiz --audio-stream değeri: %s (1'den başlayan sıra numarası)", token)
+			}
+			if n > len(audio) {
+				return nil, fmt.Errorf("videoda %d. ses izi yok (toplam %d ses izi)", n, len(audio))
+			}
+			selected = append(selected, audio[n-1])
+		}
+	} else {
+		selected = append(selected, audio...)
+	}
+
+	keep := parseLanguageList(keepLang)
+	drop := parseLanguageList(dropLang)
+	tracks := []int{}
+	seen := map[int]bool{}
+	for _, s := range selected {
+		lang := s.Language
+		if lang == "" {
+			lang = "und"
+		}
+		if len(keep) > 0 && !keep[lang] {
+			continue
+		}
+		if drop[lang] || seen[s.TypeIndex] {
+			continue
+		}
+		seen[s.TypeIndex] = true
+		tracks = append(tracks, s.TypeIndex)
+	}
+	if len(tracks) == 0 && (len(keep) > 0 || len(drop) > 0 || strings.TrimSpace(audioStream) != "") {
+		return nil, fmt.Errorf("seçim sonucunda hiç ses izi kalmadı (mevcut diller: %s)", describeAudioLanguages(audio))
+	}
+	return tracks, nil
+}
+
+func parseLanguageList(raw string) map[string]bool {
+	langs := map[string]bool{}
+	for _, token := range strings.Split(raw, ",") {
+		token = strings.ToLower(strings.TrimSpace(token))
+		if token != "" {
+			langs[token] = true
+		}
+	}
+	return langs
+}
+
+func describeAudioLanguages(audio []converter.StreamInfo) string {
+	if len(audio) == 0 {
+		return "ses izi yok"
+	}
+	langs := make([]string, 0, len(audio))
+	for _, s := range audio {
+		lang := s.Language
+		if lang == "" {
+			lang = "und"
+		}
+		langs = append(langs, fmt.Sprintf("%d=%s", s.TypeIndex+1, lang))
+	}
+	return strings.Join(langs, ", ")
+}
+
+// buildVideoAudioArgs işleme göre ffmpeg argümanlarını kurar (loglevel, metadata ve çıktı hariç).
+// Görüntü ve altyazılar her zaman kopyalanır.
+func buildVideoAudioArgs(op string, input string, audio string, values videoAudioFlagValues, tracks []int, audioArgs []string) ([]string, error) {
+	normalize := ""
+	if values.Normalize {
+		normalize = loudnormFilter(values.TargetLUFS, -1.5, 11)
+	}
+	args := []string{}
+	switch op {
+	case videoAudioReplace:
+		args = append(args, "-i", input)
+		if values.Loop {
+			args = append(args, "-stream_loop", "-1")
+		}
+		args = append(args, "-i", audio)
+		chain := append(audioOffsetFilters(values.Offset), "apad")
+		if normalize != "" {
+			chain = append(chain, normalize)
+		}
+		args = append(args, "-filter_complex", "[1:a:0]"+strings.Join(chain, ",")+"[newa]")
+		args = append(args, "-map", "0:v")
+		kept := 0
+		if values.Add {
+			kept = len(tracks)
+			args = append(args, mapAudioTracks(tracks)...)
+			if tracks == nil {
+				// Akış bilgisi yoksa kaç iz kopyalanacağı bilinmez; tüm izler tek tek kopyalanır.
+				args = append(args, "-map", "0:a?")
+			}
+		}
+		args = append(args, "-map", "[newa]", "-map", "0:s?", "-c:v", "copy", "-c:s", "copy")
+		args = append(args, audioArgs...)
+		if values.Add {
+			for i := 0; i < kept; i++ {
+				args = append(args, fmt.Sprintf("-c:a:%d", i), "copy")
+			}
+		}
+		if lang := strings.ToLower(strings.TrimSpace(values.Language)); lang != "" && !(values.Add && tracks == nil) {
+			args = append(args, fmt.Sprintf("-metadata:s:a:%d", kept), "language="+lang)
+		}
+		args = append(args, "-shortest")
+	case videoAudioMix:
+		args = append(args, "-i", input)
+		if values.Loop {
+			args = append(args, "-stream_loop", "-1")
+		}
+		args = append(args, "-i", audio)
+		args = append(args, "-filter_complex", buildAudioMixFilter(values, tracks, normalize))
+		args = append(args, "-map", "0:v", "-map", "[aout]", "-map", "0:s?", "-c:v", "copy", "-c:s", "copy")
+		args = append(args, audioArgs...)
+		args = append(args, "-shortest")
+	case videoAudioMute:
+		args = append(args, "-i", input, "-map", "0:v")
+		if values.All {
+			args = append(args, "-map", "0:s?", "-c", "copy", "-an")
+			break
+		}
+		ranges, err := parseTrimRangesSpec(values.Ranges)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, mapAudioTracksOrAll(tracks)...)
+		args = append(args, "-map", "0:s?", "-c:v", "copy", "-c:s", "copy")
+		filter := muteRangesFilter(ranges)
+		if normalize != "" {
+			filter += "," + normalize
+		}
+		args = append(args, "-af", filter)
+		args = append(args, audioArgs...)
+	case videoAudioSelect:
+		args = append(args, "-i", input, "-map", "0:v")
+		args = append(args, mapAudioTracksOrAll(tracks)...)
+		args = append(args, "-map", "0:s?", "-c", "copy")
+		if normalize != "" || strings.TrimSpace(values.ACodec) != "" || strings.TrimSpace(values.AudioBR) != "" {
+			args = append(args, audioArgs...)
+			if normalize != "" {
+				args = append(args, "-af", normalize)
+			}
+		}
+		for i := range tracks {
+			disposition := "0"
+			if i == 0 {
+				disposition = "default"
+			}
+			args = append(args, fmt.Sprintf("-disposition:a:%d", i), disposition)
+		}
+	default:
+		return nil, fmt.Errorf("desteklenmeyen video audio işlemi: %s", op)
+	}
+	return args, nil
+}
+
+// buildAudioMixFilter müziği seviyelendirip (isteğe bağlı ducking ile) videonun sesiyle karıştırır.
+// Videoda ses izi yoksa sadece müzik kullanılır.
+func buildAudioMixFilter(values videoAudioFlagValues, tracks []int, normalize string) string {
+	music := append([]string{"volume=" + formatRetimeNumber(values.MusicVolume)}, audioOffsetFilters(values.Offset)...)
+	tail := ""
+	if normalize != "" {
+		tail = "," + normalize
+	}
+	if tracks != nil && len(tracks) == 0 {
+		return "[1:a:0]" + strings.Join(music, ",") + ",apad" + tail + "[aout]"
+	}
+	voice := 0
+	if len(tracks) > 0 {
+		voice = tracks[0]
+	}
+	parts := []string{}
+	voiceChain := fmt.Sprintf("[0:a:%d]volume=%s", voice, formatRetimeNumber(values.VideoVolume))
+	if values.Duck {
+		parts = append(parts,
+			voiceChain+",asplit=2[voice][key]",
+			"[1:a:0]"+strings.Join(music, ",")+"[bg]",
+			"[bg][key]"+duckingFilter+"[music]",
+		)
+	} else {
+		parts = append(parts,
+			voiceChain+"[voice]",
+			"[1:a:0]"+strings.Join(music, ",")+"[music]",
+		)
+	}
+	parts = append(parts, "[voice][music]amix=inputs=2:duration=first:dropout_transition=0:normalize=0"+tail+"[aout]")
+	return strings.Join(parts, ";")
+}
+
+// audioOffsetFilters sesi pozitif değerde geciktirir, negatif değerde başından keser.
+func audioOffsetFilters(offset float64) []string {
+	switch {
+	case offset > 0:
+		return []string{fmt.Sprintf("adelay=%d:all=1", int(offset*1000+0.5))}
+	case offset < 0:
+		return []string{"atrim=start=" + formatRetimeNumber(-offset), "asetpts=PTS-STARTPTS"}
+	}
+	return nil
+}
+
+// muteRangesFilter verilen aralıklarda sesi sıfırlayan volume filtresini üretir.
+func muteRangesFilter(ranges []trimRange) string {
+	conds := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		conds = append(conds, fmt.Sprintf("between(t,%s,%s)", formatRetimeNumber(r.Start), formatRetimeNumber(r.End)))
+	}
+	return fmt.Sprintf("volume=enable='%s':volume=0", strings.Join(conds, "+"))
+}
+
+func mapAudioTracks(tracks []int) []string {
+	args := []string{}
+	for _, t := range tracks {
+		args = append(args, "-map", fmt.Sprintf("0:a:%d", t))
+	}
+	return args
+}
+
+func mapAudioTracksOrAll(tracks []int) []string {
+	if tracks == nil {
+		return []string{"-map", "0:a?"}
+	}
+	return mapAudioTracks(tracks)
+}
+
+func buildVideoAudioOutputPath(input string, targetFormat string, op string, customName string, explicit string) string {
+	if strings.TrimSpace(explicit) != "" {
+		return explicit
+	}
+	base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+	switch op {
+	case videoAudioReplace:
+		base += "_newaudio"
+	case videoAudioMix:
+		base += "_mix"
+	case videoAudioMute:
+		base += "_muted"
+	default:
+		base += "_tracks"
+	}
+	if strings.TrimSpace(customName) != "" {
+		base = customName
+	}
+	dir := filepath.Dir(input)
+	if strings.TrimSpace(outputDir) != "" {
+		dir = outputDir
+	}
+	return filepath.Join(dir, base+"."+targetFormat)
+}
+
+func printVideoAudioPlan(plan videoAudioPlan) {
+	ui.PrintInfo(fmt.Sprintf("İşlem: video audio %s", plan.Operation))
+	ui.PrintInfo(fmt.Sprintf("Kaynak: %s", plan.Input))
+	if plan.Audio != "" {
+		ui.PrintInfo(fmt.Sprintf("Ses: %s", plan.Audio))
+	}
+	if len(plan.Tracks) > 0 {
+		labels := make([]string, 0, len(plan.Tracks))
+		for _, t := range plan.Tracks {
+			labels = append(labels, strconv.Itoa(t))
+		}
+		ui.PrintInfo(fmt.Sprintf("Ses izleri: %s", strings.Join(labels, ", ")))
+	}
+	ui.PrintInfo(fmt.Sprintf("Çıktı: %s", plan.Output))
+	if plan.Skipped {
+		ui.PrintWarning("Çıktı dosyası mevcut, işlem atlanacak.")
+	}
+	ui.PrintInfo("ffmpeg " + strings.Join(plan.Args, " "))
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func testAudioStreams() []converter.StreamInfo {
+	return []converter.StreamInfo{
+		{Index: 1, Type: "audio", TypeIndex: 0, Language: "tur"},
+		{Index: 2, Type: "audio", TypeIndex: 1, Language: "eng"},
+		{Index: 3, Type: "audio", TypeIndex: 2},
+	}
+}
+
+func TestSelectAudioTracks(t *testing.T) {
+	audio := testAudioStreams()
+	cases := []struct {
+		stream, keep, drop string
+		want               []int
+	}{
+		{"", "", "", []int{0, 1, 2}},
+		{"2", "", "", []int{1}},
+		{"3,1", "", "", []int{2, 0}},
+		{"", "eng,tur", "", []int{0, 1}},
+		{"", "", "tur", []int{1, 2}},
+		{"", "und", "", []int{2}},
+		{"1,2", "", "eng", []int{0}},
+	}
+	for _, tc := range cases {
+		got, err := selectAudioTracks(audio, tc.stream, tc.keep, tc.drop)
+		if err != nil {
+			t.Fatalf("selectAudioTracks(%q,%q,%q) error: %v", tc.stream, tc.keep, tc.drop, err)
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("selectAudioTracks(%q,%q,%q) = %v, want %v", tc.stream, tc.keep, tc.drop, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("selectAudioTracks(%q,%q,%q) = %v, want %v", tc.stream, tc.keep, tc.drop, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestSelectAudioTracksErrors(t *testing.T) {
+	audio := testAudioStreams()
+	if _, err := selectAudioTracks(audio, "4", "", ""); err == nil {
+		t.Fatalf("expected error for missing track")
+	}
+	if _, err := selectAudioTracks(audio, "0", "", ""); err == nil {
+		t.Fatalf("expected error for zero index")
+	}
+	if _, err := selectAudioTracks(audio, "", "ger", ""); err == nil {
+		t.Fatalf("expected error when no track remains")
+	}
+	got, err := selectAudioTracks(nil, "", "", "")
+	if err != nil || len(got) != 0 {
+		t.Fatalf("video without audio should return empty selection, got %v err=%v", got, err)
+	}
+}
+
+func TestValidateVideoAudioValues(t *testing.T) {
+	if err := validateVideoAudioValues(videoAudioMute, videoAudioFlagValues{}); err == nil {
+		t.Fatalf("expected error for mute without --ranges/--all")
+	}
+	if err := validateVideoAudioValues(videoAudioMute, videoAudioFlagValues{All: true, Ranges: "1-2"}); err == nil {
+		t.Fatalf("expected error for --ranges with --all")
+	}
+	if err := validateVideoAudioValues(videoAudioSelect, videoAudioFlagValues{}); err == nil {
+		t.Fatalf("expected error for select without selector")
+	}
+	if err := validateVideoAudioValues(videoAudioReplace, videoAudioFlagValues{AudioStream: "1"}); err == nil {
+		t.Fatalf("expected error for replace selector without --add")
+	}
+	if err := validateVideoAudioValues(videoAudioMix, videoAudioFlagValues{MusicVolume: 3, VideoVolume: 1}); err == nil {
+		t.Fatalf("expected error for music volume out of range")
+	}
+	if err := validateVideoAudioValues(videoAudioSelect, videoAudioFlagValues{KeepLang: "tur", DropLang: "eng"}); err == nil {
+		t.Fatalf("expected error for --keep-lang with --drop-lang")
+	}
+}
+
+func TestBuildVideoAudioArgsReplace(t *testing.T) {
+	aac := []string{"-c:a", "aac", "-b:a", "128k"}
+	args, err := buildVideoAudioArgs(videoAudioReplace, "klip.mp4", "ses.wav", videoAudioFlagValues{Offset: 1.5, Language: "tur"}, nil, aac)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	joined := strings.Join(args, " ")
+	for _, want := range []string{"[1:a:0]adelay=1500:all=1,apad[newa]", "-map 0:v -map [newa]", "-c:v copy", "-metadata:s:a:0 language=tur", "-shortest"} {
+		if !strings.Contains(joined, want) {
+			t.Fatalf("replace args missing %q: %s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "-map 0:a") {
+		t.Fatalf("replace should drop original audio: %s", joined)
+	}
+
+	args, _ = buildVideoAudioArgs(videoAudioReplace, "film.mkv", "dublaj.aac", videoAudioFlagValues{Add: true, Language: "tur", Normalize: true, TargetLUFS: -16}, []int{0, 1}, aac)
+	joined = strings.Join(args, " ")
+	for _, want := range []string{"-map 0:a:0 -map 0:a:1 -map [newa]", "-c:a:0 copy", "-c:a:1 copy", "-metadata:s:a:2 language=tur", "apad,loudnorm=I=-16.0"} {
+		if !strings.Contains(joined, want) {
+			t.Fatalf("replace --add args missing %q: %s", want, joined)
+		}
+	}
+}
+
+func TestBuildAudioMixFilter(t *testing.T) {
+	got := buildAudioMixFilter(videoAudioFlagValues{MusicVolume: 0.3, VideoVolume: 1}, []int{0}, "")
+	want := "[0:a:0]volume=1[voice];[1:a:0]volume=0.3[music];[voice][music]amix=inputs=2:duration=first:dropout_transition=0:normalize=0[aout]"
+	if got != want {
+		t.Fatalf("unexpected mix filter:\n got %s\nwant %s", got, want)
+	}
+
+	got = buildAudioMixFilter(videoAudioFlagValues{MusicVolume: 0.2, VideoVolume: 1, Duck: true}, []int{1}, "")
+	for _, want := range []string{"[0:a:1]volume=1,asplit=2[voice][key]", "[bg][key]sidechaincompress", "[voice][music]amix"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("ducking filter missing %q: %s", want, got)
+		}
+	}
+
+	got = buildAudioMixFilter(videoAudioFlagValues{MusicVolume: 0.5, Offset: 2}, []int{}, loudnormFilter(-14, -1.5, 11))
+	if got != "[1:a:0]volume=0.5,adelay=2000:all=1,apad,loudnorm=I=-14.0:TP=-1.5:LRA=11.0[aout]" {
+		t.Fatalf("unexpected music-only filter: %s", got)
+	}
+}
+
+func TestBuildVideoAudioArgsMute(t *testing.T) {
+	args, err := buildVideoAudioArgs(videoAudioMute, "kayit.mp4", "", videoAudioFlagValues{Ranges: "5-8,20-25"}, []int{0}, []string{"-c:a", "aac"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "-af volume=enable='between(t,5,8)+between(t,20,25)':volume=0") {
+		t.Fatalf("unexpected mute args: %s", joined)
+	}
+
+	args, _ = buildVideoAudioArgs(videoAudioMute, "kayit.mp4", "", videoAudioFlagValues{All: true}, nil, nil)
+	joined = strings.Join(args, " ")
+	if !strings.Contains(joined, "-c copy -an") || strings.Contains(joined, "-af") {
+		t.Fatalf("unexpected mute --all args: %s", joined)
+	}
+
+	if _, err := buildVideoAudioArgs(videoAudioMute, "kayit.mp4", "", videoAudioFlagValues{Ranges: "8-5"}, nil, nil); err == nil {
+		t.Fatalf("expected error for invalid range")
+	}
+}
+
+func TestBuildVideoAudioArgsSelect(t *testing.T) {
+	args, _ := buildVideoAudioArgs(videoAudioSelect, "film.mkv", "", videoAudioFlagValues{AudioStream: "2,1"}, []int{1, 0}, []string{"-c:a", "aac"})
+	joined := strings.Join(args, " ")
+	for _, want := range []string{"-map 0:a:1 -map 0:a:0", "-c copy", "-disposition:a:0 default", "-disposition:a:1 0"} {
+		if !strings.Contains(joined, want) {
+			t.Fatalf("select args missing %q: %s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "-c:a aac") {
+		t.Fatalf("select should copy audio without normalize: %s", joined)
+	}
+
+	args, _ = buildVideoAudioArgs(videoAudioSelect, "film.mkv", "", videoAudioFlagValues{KeepLang: "tur", Normalize: true, TargetLUFS: -14}, []int{0}, []string{"-c:a", "aac"})
+	joined = strings.Join(args, " ")
+	if !strings.Contains(joined, "-c:a aac") || !strings.Contains(joined, "-af loudnorm") {
+		t.Fatalf("select --normalize should re-encode audio: %s", joined)
+	}
+}
+
+func TestBuildVideoAudioOutputPath(t *testing.T) {
+	outputDir = ""
+	if got := buildVideoAudioOutputPath("/tmp/klip.mp4", "mp4", videoAudioMix, "", ""); got != filepath.Join("/tmp", "klip_mix.mp4") {
+		t.Fatalf("unexpected output path: %s", got)
+	}
+	if got := buildVideoAudioOutputPath("/tmp/klip.mp4", "mkv", videoAudioSelect, "tr", ""); got != filepath.Join("/tmp", "tr.mkv") {
+		t.Fatalf("unexpected output path with name: %s", got)
+	}
+}
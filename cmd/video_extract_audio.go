@@ -19,6 +19,7 @@ var (
 	extractAudioQuality    int
 	extractAudioCopy       bool
 	extractAudioName       string
+	extractAudioNameTmpl   string
 	extractAudioConflict   string
 	extractAudioPreserveMD bool
 	extractAudioStripMD    bool
@@ -69,7 +70,11 @@ var extractAudioCmd = &cobra.Command{
 			ui.PrintWarning("--copy modu seçili fakat hedef format kaynak ses codec'i ile uyumsuz olabilir, sonuç hatalı olabilir.")
 		}
 
-		outputPath := buildExtractAudioOutputPath(input, targetFormat, extractAudioName)
+		outputPath, err := resolveNamedOutputPath(extractAudioNameTmpl, extractAudioName, "", input, targetFormat, 0,
+			buildExtractAudioOutputPath(input, targetFormat, extractAudioName))
+		if err != nil {
+			return err
+		}
 		conflict := converter.NormalizeConflictPolicy(extractAudioConflict)
 		if conflict == "" {
 			return fmt.Errorf("gecersiz on-conflict politikasi: %s", extractAudioConflict)
@@ -106,6 +111,7 @@ func init() {
 	extractAudioCmd.Flags().IntVarP(&extractAudioQuality, "quality", "q", 0, "Ses kalitesi (1-100)")
 	extractAudioCmd.Flags().BoolVar(&extractAudioCopy, "copy", false, "Codec copy modu (re-encode yapmadan)")
 	extractAudioCmd.Flags().StringVarP(&extractAudioName, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	addNameTemplateFlag(extractAudioCmd, &extractAudioNameTmpl)
 	extractAudioCmd.Flags().StringVar(&extractAudioConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
 	extractAudioCmd.Flags().BoolVar(&extractAudioPreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
 	extractAudioCmd.Flags().BoolVar(&extractAudioStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
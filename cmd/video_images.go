@@ -0,0 +1,682 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/batch"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+const (
+	imageOrderNatural = "natural"
+	imageOrderName    = "name"
+	imageOrderMtime   = "mtime"
+
+	defaultSequenceFPS = 25.0
+)
+
+// sequenceInputFormats kare dizisinden video üretirken kabul edilen görsel formatları.
+var sequenceInputFormats = []string{"png", "jpg", "bmp", "webp", "tif"}
+
+// framePatternVerb çıktı dosya adı kalıbındaki kare numarası yer tutucusu (%d, %05d).
+var framePatternVerb = regexp.MustCompile(`%0?\d*d`)
+
+var (
+	fromImagesFPS        float64
+	fromImagesOrder      string
+	fromImagesAudio      string
+	fromImagesShortest   bool
+	fromImagesDryRun     bool
+	fromImagesTo         string
+	fromImagesName       string
+	fromImagesNameTmpl   string
+	fromImagesOutputFile string
+	fromImagesQuality    int
+	fromImagesConflict   string
+	fromImagesPreset     string
+	fromImagesWidth      float64
+	fromImagesHeight     float64
+	fromImagesUnit       string
+	fromImagesDPI        float64
+	fromImagesResizeMode string
+	fromImagesVCodec     string
+	fromImagesACodec     string
+	fromImagesCRF        int
+	fromImagesBitrate    string
+	fromImagesEncPreset  string
+	fromImagesPixFmt     string
+	fromImagesAudioBR    string
+
+	toImagesTo       string
+	toImagesQuality  int
+	toImagesPattern  string
+	toImagesStart    string
+	toImagesEnd      string
+	toImagesDuration string
+	toImagesEvery    int
+	toImagesConflict string
+)
+
+var videoFromImagesCmd = &cobra.Command{
+	Use:   "from-images <klasor|glob>",
+	Short: "Numaralı görsel dizisinden video üretir",
+	Long: `Bir klasördeki veya glob ile seçilen görselleri sırayla birleştirip video üretir.
+
+Sıralama:
+  - natural (varsayılan): dosya adındaki sayılar sayısal karşılaştırılır (kare2 < kare10)
+  - name: düz alfabetik sıralama
+  - mtime: değiştirilme zamanına göre
+
+Boyut verilmezse tüm kareler ilk görselin boyutuna pad modunda yerleştirilir.
+
+Örnekler:
+  fileconverter-cli video from-images ./kareler --fps 30
+  fileconverter-cli video from-images "render/*.png" --fps 24 --preset fullhd --to mp4
+  fileconverter-cli video from-images ./slaytlar --fps 0.5 --audio muzik.mp3 --order mtime
+  fileconverter-cli video from-images ./kareler --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		source := args[0]
+		jsonOutput := isJSONOutput()
+
+		applyQualityDefault(cmd, "quality", &fromImagesQuality)
+		applyOnConflictDefault(cmd, "on-conflict", &fromImagesConflict)
+
+		if fromImagesFPS <= 0 || fromImagesFPS > 240 {
+			return fmt.Errorf("--fps 0-240 aralığında olmalı: %g", fromImagesFPS)
+		}
+		files, err := collectSequenceImages(source)
+		if err != nil {
+			return err
+		}
+		if err := sortSequenceImages(files, fromImagesOrder); err != nil {
+			return err
+		}
+		if strings.TrimSpace(fromImagesAudio) != "" {
+			if _, err := os.Stat(fromImagesAudio); err != nil {
+				return fmt.Errorf("ses dosyası bulunamadı: %s", fromImagesAudio)
+			}
+		}
+
+		targetFormat := converter.NormalizeFormat(fromImagesTo)
+		if !converter.IsVideoFormat(targetFormat) {
+			return fmt.Errorf("hedef video formatı gerekli: %s", fromImagesTo)
+		}
+		resizeSpec, err := converter.BuildResizeSpec(fromImagesPreset, fromImagesWidth, fromImagesHeight, fromImagesUnit, fromImagesResizeMode, fromImagesDPI)
+		if err != nil {
+			return err
+		}
+		frameFilter, err := buildSequenceFrameFilter(resizeSpec, files[0], fromImagesFPS)
+		if err != nil {
+			return err
+		}
+
+		videoSpec, err := converter.BuildVideoSpec(fromImagesVCodec, fromImagesCRF, fromImagesBitrate, fromImagesEncPreset, fromImagesPixFmt, fromImagesACodec, fromImagesAudioBR)
+		if err != nil {
+			return err
+		}
+		if err := converter.ValidateVideoSpec(videoSpec, targetFormat); err != nil {
+			return err
+		}
+		if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy {
+			return fmt.Errorf("görsel dizisi için encode gerekir, --video-codec copy kullanılamaz")
+		}
+
+		outputPath, err := resolveNamedOutputPath(fromImagesNameTmpl, fromImagesName, fromImagesOutputFile, source, targetFormat, 0,
+			buildSequenceOutputPath(source, files, targetFormat, fromImagesName, fromImagesOutputFile))
+		if err != nil {
+			return err
+		}
+		conflict := converter.NormalizeConflictPolicy(fromImagesConflict)
+		if conflict == "" {
+			return fmt.Errorf("gecersiz on-conflict politikasi: %s", fromImagesConflict)
+		}
+		outputPath, skip, err := converter.ResolveOutputPathConflict(outputPath, conflict)
+		if err != nil {
+			return err
+		}
+		durationSec := float64(len(files)) / fromImagesFPS
+
+		if fromImagesDryRun {
+			if jsonOutput {
+				return printJSON(map[string]interface{}{
+					"dry_run":          true,
+					"output":           outputPath,
+					"frames":           len(files),
+					"fps":              fromImagesFPS,
+					"order":            fromImagesOrder,
+					"first":            files[0],
+					"last":             files[len(files)-1],
+					"duration_seconds": durationSec,
+					"filter":           frameFilter,
+					"would_skip":       skip,
+				})
+			}
+			ui.PrintInfo("Ön izleme modu (--dry-run) — işlem yapılmayacak.")
+			ui.PrintInfo(fmt.Sprintf("Kare sayısı: %d (sıralama=%s)", len(files), fromImagesOrder))
+			ui.PrintInfo(fmt.Sprintf("İlk kare: %s", files[0]))
+			ui.PrintInfo(fmt.Sprintf("Son kare: %s", files[len(files)-1]))
+			ui.PrintInfo(fmt.Sprintf("Tahmini süre: %s (%s fps)", formatTrimSecondsHuman(durationSec), formatRetimeNumber(fromImagesFPS)))
+			ui.PrintInfo(fmt.Sprintf("Çıktı: %s", outputPath))
+			if skip {
+				ui.PrintWarning("Bu işlem on-conflict=skip nedeniyle atlanacak.")
+			}
+			return nil
+		}
+		if skip {
+			if jsonOutput {
+				return printJSON(map[string]interface{}{"success": true, "skipped": true, "output": outputPath})
+			}
+			ui.PrintWarning(fmt.Sprintf("Çıktı dosyası mevcut, atlandı: %s", outputPath))
+			return nil
+		}
+		if !converter.IsFFmpegAvailable() {
+			return fmt.Errorf("video from-images için ffmpeg gerekli")
+		}
+		if err := prepareVideoEncoders(videoSpec, targetFormat, jsonOutput); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+			return err
+		}
+
+		if !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("%d kare, %s fps, tahmini süre %s", len(files), formatRetimeNumber(fromImagesFPS), formatTrimSecondsHuman(durationSec)))
+			ui.PrintConversion(source, outputPath)
+		}
+		started := time.Now()
+		codecArgs := trimCodecArgs(targetFormat, "reencode", fromImagesQuality, videoSpec)
+		if err := runFromImagesFFmpeg(files, outputPath, fromImagesFPS, frameFilter, fromImagesAudio, fromImagesShortest, codecArgs, verbose); err != nil {
+			if jsonOutput {
+				return err
+			}
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		if jsonOutput {
+			return printJSON(map[string]interface{}{
+				"success":     true,
+				"output":      outputPath,
+				"frames":      len(files),
+				"fps":         fromImagesFPS,
+				"duration_ms": time.Since(started).Milliseconds(),
+			})
+		}
+		ui.PrintSuccess("Görsel dizisinden video üretildi!")
+		ui.PrintDuration(time.Since(started))
+		return nil
+	},
+}
+
+var videoToImagesCmd = &cobra.Command{
+	Use:   "to-images <video-dosyasi>",
+	Short: "Videoyu numaralı kare dosyalarına ayırır",
+	Long: `Videodaki kareleri numaralı görsel dosyalarına çıkarır. Kareler önce kayıpsız
+PNG olarak alınır, png dışındaki hedeflerde uygulamanın görsel encoder'larıyla
+(--quality dahil) jpg, webp veya bmp'ye dönüştürülür.
+
+Dosya adı kalıbı bir kare numarası yer tutucusu içermelidir: %d veya %05d gibi.
+Çıktı klasörü varsayılan olarak <ad>_frames'tir; -o ile değiştirilebilir.
+
+Örnekler:
+  fileconverter-cli video to-images klip.mp4
+  fileconverter-cli video to-images klip.mp4 --to jpg --quality 85 --every 10
+  fileconverter-cli video to-images klip.mp4 --start 5 --duration 3 --pattern "kare_%04d"
+  fileconverter-cli video to-images klip.mp4 --to webp -o ./anotasyon`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input := args[0]
+		jsonOutput := isJSONOutput()
+		if _, err := os.Stat(input); os.IsNotExist(err) {
+			return fmt.Errorf("dosya bulunamadi: %s", input)
+		}
+		if !converter.IsFFmpegAvailable() {
+			return fmt.Errorf("video to-images için ffmpeg gerekli")
+		}
+
+		applyQualityDefault(cmd, "quality", &toImagesQuality)
+		applyOnConflictDefault(cmd, "on-conflict", &toImagesConflict)
+
+		targetFormat := converter.NormalizeFormat(toImagesTo)
+		if !isValidSnapshotFormat(targetFormat) {
+			return fmt.Errorf("desteklenmeyen görsel formatı: %s (desteklenen: %s)", toImagesTo, strings.Join(snapshotOutputFormats, ", "))
+		}
+		if toImagesEvery < 1 {
+			return fmt.Errorf("--every en az 1 olmalı")
+		}
+		conflict := converter.NormalizeConflictPolicy(toImagesConflict)
+		if conflict == "" {
+			return fmt.Errorf("gecersiz on-conflict politikasi: %s", toImagesConflict)
+		}
+		if strings.TrimSpace(toImagesEnd) != "" && strings.TrimSpace(toImagesDuration) != "" {
+			return fmt.Errorf("--end ve --duration birlikte kullanılamaz")
+		}
+		_, _, _, startSec, endSec, err := resolveTrimRange(toImagesStart, toImagesEnd, toImagesDuration, trimModeClip)
+		if err != nil {
+			return err
+		}
+		startSec, endSec, err = adjustTrimWindowByDuration(input, startSec, endSec, trimModeClip)
+		if err != nil {
+			return err
+		}
+
+		base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+		pattern := strings.TrimSpace(toImagesPattern)
+		if pattern == "" {
+			pattern = base + "_%05d"
+		}
+		if err := validateFramePattern(pattern); err != nil {
+			return err
+		}
+		frameDir := strings.TrimSpace(outputDir)
+		if frameDir == "" {
+			frameDir = filepath.Join(filepath.Dir(input), base+"_frames")
+		}
+		if err := os.MkdirAll(frameDir, 0755); err != nil {
+			return err
+		}
+
+		if !jsonOutput {
+			ui.PrintConversion(input, filepath.Join(frameDir, pattern+"."+targetFormat))
+		}
+		started := time.Now()
+		frames, skipped, err := runToImages(input, frameDir, pattern, targetFormat, toImagesQuality, startSec, endSec, toImagesEvery, conflict, verbose)
+		if err != nil {
+			if jsonOutput {
+				return err
+			}
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		if jsonOutput {
+			return printJSON(map[string]interface{}{
+				"success":     true,
+				"input":       input,
+				"output_dir":  frameDir,
+				"format":      targetFormat,
+				"frames":      frames,
+				"skipped":     skipped,
+				"duration_ms": time.Since(started).Milliseconds(),
+			})
+		}
+		if len(frames) == 0 {
+			ui.PrintWarning("Hiç kare üretilmedi.")
+		} else {
+			ui.PrintSuccess(fmt.Sprintf("%d kare çıkarıldı: %s", len(frames), frameDir))
+		}
+		if skipped > 0 {
+			ui.PrintWarning(fmt.Sprintf("%d kare mevcut olduğu için atlandı.", skipped))
+		}
+		ui.PrintDuration(time.Since(started))
+		return nil
+	},
+}
+
+func init() {
+	f := videoFromImagesCmd.Flags()
+	f.Float64Var(&fromImagesFPS, "fps", defaultSequenceFPS, "Saniyedeki kare sayısı (ör: 24, 30; slayt için 0.5)")
+	f.StringVar(&fromImagesOrder, "order", imageOrderNatural, "Kare sıralaması: natural, name veya mtime")
+	f.StringVar(&fromImagesAudio, "audio", "", "Videoya eklenecek ses dosyası")
+	f.BoolVar(&fromImagesShortest, "shortest", true, "Ses daha uzunsa videonun sonunda kes")
+	f.BoolVar(&fromImagesDryRun, "dry-run", false, "Kare listesini ve tahmini süreyi göster, video üretme")
+	f.StringVarP(&fromImagesTo, "to", "t", "mp4", "Hedef video formatı")
+	f.StringVarP(&fromImagesName, "name", "n", "", "Çıktı dosya adı (uzantısız, varsayılan: klasör adı)")
+	f.StringVar(&fromImagesNameTmpl, "name-template", "", nameTemplateFlagUsage)
+	f.StringVar(&fromImagesOutputFile, "output-file", "", "Tam çıktı dosya yolu")
+	f.IntVarP(&fromImagesQuality, "quality", "q", 0, "Kalite seviyesi (1-100)")
+	f.StringVar(&fromImagesConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+	f.StringVar(&fromImagesPreset, "preset", "", "Hazır boyut preset'i (ör: story, square, fullhd, 1080x1920)")
+	f.Float64Var(&fromImagesWidth, "width", 0, "Manuel hedef genişlik")
+	f.Float64Var(&fromImagesHeight, "height", 0, "Manuel hedef yükseklik")
+	f.StringVar(&fromImagesUnit, "unit", "px", "Manuel ölçü birimi: px veya cm")
+	f.Float64Var(&fromImagesDPI, "dpi", 96, "Birim cm ise kullanılacak DPI değeri")
+	f.StringVar(&fromImagesResizeMode, "resize-mode", "pad", "Boyutlandırma modu: pad, fill, stretch")
+	addVideoCodecFlags(videoFromImagesCmd, &fromImagesVCodec, &fromImagesACodec, &fromImagesCRF, &fromImagesBitrate, &fromImagesEncPreset, &fromImagesPixFmt, &fromImagesAudioBR)
+
+	g := videoToImagesCmd.Flags()
+	g.StringVarP(&toImagesTo, "to", "t", "png", "Kare formatı (png, jpg, webp, bmp)")
+	g.IntVarP(&toImagesQuality, "quality", "q", 0, "Görsel kalitesi (1-100, jpg/webp)")
+	g.StringVar(&toImagesPattern, "pattern", "", "Dosya adı kalıbı, uzantısız (varsayılan: <ad>_%05d)")
+	g.StringVar(&toImagesStart, "start", "0", "Başlangıç zamanı (örn: 00:00:05)")
+	g.StringVar(&toImagesEnd, "end", "", "Bitiş zamanı (örn: 00:00:10)")
+	g.StringVar(&toImagesDuration, "duration", "", "Süre (örn: 3, 00:00:03)")
+	g.IntVar(&toImagesEvery, "every", 1, "Her N karede bir kare çıkar")
+	g.StringVar(&toImagesConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+
+	videoCmd.AddCommand(videoFromImagesCmd, videoToImagesCmd)
+}
+
+// collectSequenceImages klasör veya glob kaynağından desteklenen görselleri toplar.
+func collectSequenceImages(source string) ([]string, error) {
+	var candidates []string
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		entries, err := os.ReadDir(source)
+		if err != nil {
+			return nil, fmt.Errorf("klasör okunamadı: %w", err)
+		}
+		for _, e := range entries {
+			if !e.IsDir() {
+				candidates = append(candidates, filepath.Join(source, e.Name()))
+			}
+		}
+	} else {
+		matches, err := batch.CollectFilesFromGlob(source, nil)
+		if err != nil {
+			return nil, err
+		}
+		candidates = matches
+	}
+
+	files := make([]string, 0, len(candidates))
+	for _, f := range candidates {
+		format := converter.DetectFormat(f)
+		for _, allowed := range sequenceInputFormats {
+			if format == allowed {
+				files = append(files, f)
+				break
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("görsel bulunamadı: %s (desteklenen: %s)", source, strings.Join(sequenceInputFormats, ", "))
+	}
+	return files, nil
+}
+
+// sortSequenceImages kareleri seçilen sıralamaya göre yerinde sıralar.
+func sortSequenceImages(files []string, order string) error {
+	switch strings.ToLower(strings.TrimSpace(order)) {
+	case "", imageOrderNatural:
+		sort.SliceStable(files, func(i, j int) bool {
+			return naturalLess(filepath.Base(files[i]), filepath.Base(files[j]))
+		})
+	case imageOrderName:
+		sort.SliceStable(files, func(i, j int) bool {
+			return filepath.Base(files[i]) < filepath.Base(files[j])
+		})
+	case imageOrderMtime:
+		mtimes := make(map[string]time.Time, len(files))
+		for _, f := range files {
+			if info, err := os.Stat(f); err == nil {
+				mtimes[f] = info.ModTime()
+			}
+		}
+		sort.SliceStable(files, func(i, j int) bool {
+			a, b := mtimes[files[i]], mtimes[files[j]]
+			if a.Equal(b) {
+				return naturalLess(filepath.Base(files[i]), filepath.Base(files[j]))
+			}
+			return a.Before(b)
+		})
+	default:
+		return fmt.Errorf("gecersiz --order: %s (natural|name|mtime)", order)
+	}
+	return nil
+}
+
+// naturalLess dosya adlarındaki sayı gruplarını sayısal olarak karşılaştırır (kare2 < kare10).
+func naturalLess(a string, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	for a != "" && b != "" {
+		ad, bd := isDigit(a[0]), isDigit(b[0])
+		if ad && bd {
+			an, arest := splitDigits(a)
+			bn, brest := splitDigits(b)
+			at, bt := strings.TrimLeft(an, "0"), strings.TrimLeft(bn, "0")
+			if len(at) != len(bt) {
+				return len(at) < len(bt)
+			}
+			if at != bt {
+				return at < bt
+			}
+			if len(an) != len(bn) {
+				return len(an) < len(bn)
+			}
+			a, b = arest, brest
+			continue
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) < len(b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func splitDigits(s string) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// buildSequenceFrameFilter tüm kareleri ortak boyuta getiren ve sabit fps üreten filtreyi kurar.
+// Boyut verilmezse ilk görselin boyutu pad modunda kullanılır.
+func buildSequenceFrameFilter(resize *converter.ResizeSpec, firstImage string, fps float64) (string, error) {
+	spec := resize
+	if spec == nil {
+		info, err := converter.GetFileInfo(firstImage)
+		if err != nil || info.Width <= 0 || info.Height <= 0 {
+			return "", fmt.Errorf("ilk görselin boyutu okunamadı, --preset veya --width/--height verin")
+		}
+		spec = &converter.ResizeSpec{Width: info.Width, Height: info.Height, Mode: converter.ResizeModePad}
+	}
+	if spec.Mode == converter.ResizeModeFit {
+		// fit her kareyi farklı boyutta bırakabilir; encoder sabit boyut ister.
+		return "", fmt.Errorf("görsel dizisinde fit modu kullanılamaz; pad, fill veya stretch seçin")
+	}
+	scale, err := converter.BuildVideoResizeFilter(*spec)
+	if err != nil {
+		return "", err
+	}
+	return scale + ",fps=" + formatRetimeNumber(fps), nil
+}
+
+// buildImageSequenceConcatList concat demuxer listesini her kareye 1/fps süre vererek üretir.
+// Demuxer son girdinin süresini yok saydığı için son kare bir kez daha yazılır.
+func buildImageSequenceConcatList(files []string, fps float64) (string, error) {
+	frameDuration := formatSecondsForFFmpeg(1 / fps)
+	var sb strings.Builder
+	last := ""
+	for _, f := range files {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return "", err
+		}
+		last = fmt.Sprintf("file '%s'\n", escapeConcatPath(abs))
+		sb.WriteString(last)
+		sb.WriteString("duration " + frameDuration + "\n")
+	}
+	sb.WriteString(last)
+	return sb.String(), nil
+}
+
+func buildSequenceOutputPath(source string, files []string, targetFormat string, customName string, explicit string) string {
+	if strings.TrimSpace(explicit) != "" {
+		return explicit
+	}
+	dir := filepath.Clean(source)
+	if info, err := os.Stat(source); err != nil || !info.IsDir() {
+		dir = filepath.Dir(files[0])
+	}
+	base := filepath.Base(dir)
+	if base == "." || base == string(filepath.Separator) {
+		base = "sequence"
+	}
+	if strings.TrimSpace(customName) != "" {
+		base = customName
+	}
+	parent := filepath.Dir(dir)
+	if strings.TrimSpace(outputDir) != "" {
+		parent = outputDir
+	}
+	return filepath.Join(parent, base+"."+targetFormat)
+}
+
+func runFromImagesFFmpeg(files []string, output string, fps float64, frameFilter string, audio string, shortest bool, codecArgs []string, verbose bool) error {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return fmt.Errorf("ffmpeg bulunamadi")
+	}
+	tempDir, err := os.MkdirTemp("", "fileconverter-from-images-*")
+	if err != nil {
+		return fmt.Errorf("geçici klasör oluşturulamadı: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	list, err := buildImageSequenceConcatList(files, fps)
+	if err != nil {
+		return err
+	}
+	listPath := filepath.Join(tempDir, "frames.txt")
+	if err := os.WriteFile(listPath, []byte(list), 0644); err != nil {
+		return fmt.Errorf("kare listesi yazılamadı: %w", err)
+	}
+
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	args = append(args, "-f", "concat", "-safe", "0", "-i", listPath)
+	if strings.TrimSpace(audio) != "" {
+		args = append(args, "-i", audio, "-map", "0:v:0", "-map", "1:a:0")
+	}
+	args = append(args, "-vf", frameFilter)
+	args = append(args, codecArgs...)
+	if strings.TrimSpace(audio) == "" {
+		args = append(args, "-an")
+	} else if shortest {
+		args = append(args, "-shortest")
+	}
+	args = append(args, "-y", output)
+	return runFFmpegCommand(ffmpegPath, args, "video from-images ffmpeg hatasi")
+}
+
+// validateFramePattern kalıpta tek bir kare numarası yer tutucusu olduğunu doğrular.
+func validateFramePattern(pattern string) error {
+	if strings.ContainsAny(pattern, `/\`) {
+		return fmt.Errorf("--pattern klasör içeremez, çıktı klasörü için -o kullanın: %s", pattern)
+	}
+	if len(framePatternVerb.FindAllString(pattern, -1)) != 1 || strings.Count(pattern, "%") != 1 {
+		return fmt.Errorf("--pattern tek bir kare numarası yer tutucusu içermeli (ör: kare_%%05d): %s", pattern)
+	}
+	return nil
+}
+
+// buildToImagesFFmpegArgs aralık ve her N. kare seçimiyle PNG kare çıkarma komutunu kurar.
+func buildToImagesFFmpegArgs(input string, outputPattern string, startSec float64, endSec float64, every int, verbose bool) []string {
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	if startSec > 0 {
+		args = append(args, "-ss", formatSecondsForFFmpeg(startSec))
+	}
+	if endSec > startSec {
+		args = append(args, "-t", formatSecondsForFFmpeg(endSec-startSec))
+	}
+	args = append(args, "-i", input, "-map", "0:v:0")
+	if every > 1 {
+		args = append(args, "-vf", "framestep="+strconv.Itoa(every))
+	}
+	args = append(args, "-vsync", "vfr", "-start_number", "1", "-y", outputPattern)
+	return args
+}
+
+// runToImages kareleri geçici klasöre PNG olarak çıkarır, ardından hedef formata
+// görsel encoder'larıyla dönüştürüp çakışma politikasına göre çıktı klasörüne yazar.
+func runToImages(input string, frameDir string, pattern string, targetFormat string, quality int, startSec float64, endSec float64, every int, conflict string, verbose bool) ([]string, int, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return nil, 0, fmt.Errorf("ffmpeg bulunamadi")
+	}
+	tempDir, err := os.MkdirTemp("", "fileconverter-to-images-*")
+	if err != nil {
+		return nil, 0, fmt.Errorf("geçici klasör oluşturulamadı: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	args := buildToImagesFFmpegArgs(input, filepath.Join(tempDir, pattern+".png"), startSec, endSec, every, verbose)
+	if err := runFFmpegCommand(ffmpegPath, args, "video to-images ffmpeg hatasi"); err != nil {
+		return nil, 0, err
+	}
+
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		return nil, 0, err
+	}
+	var conv converter.Converter
+	if targetFormat != "png" {
+		conv, err = converter.FindConverter("png", targetFormat)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	frames := make([]string, 0, len(entries))
+	skipped := 0
+	for _, e := range entries {
+		tmp := filepath.Join(tempDir, e.Name())
+		name := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		out, skip, err := converter.ResolveOutputPathConflict(filepath.Join(frameDir, name+"."+targetFormat), conflict)
+		if err != nil {
+			return frames, skipped, err
+		}
+		if skip {
+			skipped++
+			continue
+		}
+		if conv != nil {
+			if err := conv.Convert(tmp, out, converter.Options{Quality: quality}); err != nil {
+				return frames, skipped, fmt.Errorf("%s dönüştürülemedi: %w", e.Name(), err)
+			}
+		} else if err := moveFile(tmp, out); err != nil {
+			return frames, skipped, err
+		}
+		frames = append(frames, out)
+	}
+	return frames, skipped, nil
+}
+
+// moveFile dosyayı taşır; farklı disk bölümlerinde kopyalayıp kaynağı siler.
+func moveFile(src string, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
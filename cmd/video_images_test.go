@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func writeTestPNG(t *testing.T, path string, w int, h int) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, image.NewRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatalf("encode failed: %v", err)
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	files := []string{"kare10.png", "kare2.png", "kare1.png", "KARE3.png", "kare002.png"}
+	if err := sortSequenceImages(files, imageOrderNatural); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "kare1.png,kare2.png,kare002.png,KARE3.png,kare10.png"
+	if got := strings.Join(files, ","); got != want {
+		t.Fatalf("unexpected natural order: %s", got)
+	}
+
+	files = []string{"kare10.png", "kare2.png"}
+	_ = sortSequenceImages(files, imageOrderName)
+	if files[0] != "kare10.png" {
+		t.Fatalf("name order must be lexical: %v", files)
+	}
+	if err := sortSequenceImages(files, "random"); err == nil {
+		t.Fatalf("expected error for invalid order")
+	}
+}
+
+func TestCollectSequenceImagesAndFilter(t *testing.T) {
+	dir := t.TempDir()
+	writeTestPNG(t, filepath.Join(dir, "f_1.png"), 641, 360)
+	writeTestPNG(t, filepath.Join(dir, "f_2.png"), 320, 180)
+	if err := os.WriteFile(filepath.Join(dir, "notlar.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := collectSequenceImages(dir)
+	if err != nil || len(files) != 2 {
+		t.Fatalf("expected 2 images, got %v %v", files, err)
+	}
+	files, err = collectSequenceImages(filepath.Join(dir, "*.png"))
+	if err != nil || len(files) != 2 {
+		t.Fatalf("expected 2 images from glob, got %v %v", files, err)
+	}
+	if _, err := collectSequenceImages(filepath.Join(dir, "*.gif")); err == nil {
+		t.Fatalf("expected error when no images match")
+	}
+
+	filter, err := buildSequenceFrameFilter(nil, files[0], 30)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(filter, "pad=642:360") || !strings.HasSuffix(filter, ",fps=30") {
+		t.Fatalf("expected first image canvas with even width, got %s", filter)
+	}
+	if _, err := buildSequenceFrameFilter(&converter.ResizeSpec{Width: 100, Height: 100, Mode: converter.ResizeModeFit}, files[0], 30); err == nil {
+		t.Fatalf("expected error for fit mode")
+	}
+
+	out := buildSequenceOutputPath(dir, files, "mp4", "", "")
+	if out != filepath.Join(filepath.Dir(dir), filepath.Base(dir)+".mp4") {
+		t.Fatalf("unexpected output path: %s", out)
+	}
+}
+
+func TestBuildImageSequenceConcatList(t *testing.T) {
+	list, err := buildImageSequenceConcatList([]string{"/k/a.png", "/k/b'c.png"}, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "file '/k/a.png'\nduration 0.25\nfile '/k/b'\\''c.png'\nduration 0.25\nfile '/k/b'\\''c.png'\n"
+	if list != want {
+		t.Fatalf("unexpected concat list:\n%s", list)
+	}
+}
+
+func TestValidateFramePattern(t *testing.T) {
+	for _, ok := range []string{"kare_%05d", "%d", "klip-%3d-x"} {
+		if err := validateFramePattern(ok); err != nil {
+			t.Fatalf("expected %q to be valid: %v", ok, err)
+		}
+	}
+	for _, bad := range []string{"kare", "%d_%d", "kare_%s", "alt/%05d", "%05d_%%"} {
+		if err := validateFramePattern(bad); err == nil {
+			t.Fatalf("expected %q to be invalid", bad)
+		}
+	}
+}
+
+func TestBuildToImagesFFmpegArgs(t *testing.T) {
+	args := strings.Join(buildToImagesFFmpegArgs("in.mp4", "tmp/k_%05d.png", 5, 8, 10, false), " ")
+	for _, want := range []string{"-ss 5 -t 3 -i in.mp4", "-vf framestep=10", "-vsync vfr", "tmp/k_%05d.png"} {
+		if !strings.Contains(args, want) {
+			t.Fatalf("expected %q in args: %s", want, args)
+		}
+	}
+	args = strings.Join(buildToImagesFFmpegArgs("in.mp4", "k_%d.png", 0, 0, 1, false), " ")
+	if strings.Contains(args, "-ss") || strings.Contains(args, "framestep") {
+		t.Fatalf("unexpected args for full video: %s", args)
+	}
+}
+
+func TestToImagesTargetsUseImageEncoders(t *testing.T) {
+	for _, format := range snapshotOutputFormats {
+		if format == "png" {
+			continue
+		}
+		if _, err := converter.FindConverter("png", format); err != nil {
+			t.Fatalf("png -> %s must have an image encoder: %v", format, err)
+		}
+	}
+}
@@ -15,13 +15,28 @@ import (
 )
 
 var (
-	mergeToFormat   string
-	mergeQuality    int
-	mergeName       string
-	mergeConflict   string
-	mergeReencode   bool
-	mergePreserveMD bool
-	mergeStripMD    bool
+	mergeToFormat       string
+	mergeQuality        int
+	mergeName           string
+	mergeNameTmpl       string
+	mergeConflict       string
+	mergeReencode       bool
+	mergePreserveMD     bool
+	mergeStripMD        bool
+	mergeVCodec         string
+	mergeACodec         string
+	mergeCRFValue       int
+	mergeBitrate        string
+	mergeEncPreset      string
+	mergePixFmt         string
+	mergeAudioBR        string
+	mergeNormalize      bool
+	mergeCanvasFlag     string
+	mergeResizeMode     string
+	mergeFPS            float64
+	mergeSampleRate     int
+	mergeTransitionName string
+	mergeTransDur       string
 )
 
 var mergeCmd = &cobra.Command{
@@ -30,13 +45,18 @@ var mergeCmd = &cobra.Command{
 	Long: `Birden fazla video dosyasını sıralı olarak tek bir dosyada birleştirir.
 
 Aynı codec'teki videolar hızlı concat demuxer ile birleştirilir.
-Farklı codec'lerde otomatik re-encode yapılır.
+Farklı codec'lerde otomatik re-encode yapılır. Çözünürlük, fps veya ses
+düzeni farklıysa (ya da bir klipte ses yoksa) girdiler önce ortak bir tuvale
+normalize edilir; eksik ses izleri sessizlikle tamamlanır.
 
 Örnekler:
   fileconverter-cli video merge part1.mp4 part2.mp4
   fileconverter-cli video merge part1.mp4 part2.mp4 part3.mp4 --name full_video
   fileconverter-cli video merge clip1.mov clip2.avi --to mp4
-  fileconverter-cli video merge part1.mp4 part2.mp4 --reencode --quality 80`,
+  fileconverter-cli video merge part1.mp4 part2.mp4 --reencode --quality 80
+  fileconverter-cli video merge a.mp4 b.mov --to mkv --video-codec av1 --crf 32 --audio-codec opus
+  fileconverter-cli video merge telefon.mov kamera.mp4 --canvas fullhd --fps 30
+  fileconverter-cli video merge a.mp4 b.mp4 c.mp4 --transition fade --transition-duration 1,0.5`,
 	Args: cobra.MinimumNArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		for _, input := range args {
@@ -65,10 +85,52 @@ Farklı codec'lerde otomatik re-encode yapılır.
 			targetFormat = "mp4"
 		}
 
-		// Codec tutarlılığını kontrol et
-		canConcatDemux := !mergeReencode && checkCodecConsistency(args)
+		videoSpec, err := converter.BuildVideoSpec(mergeVCodec, mergeCRFValue, mergeBitrate, mergeEncPreset, mergePixFmt, mergeACodec, mergeAudioBR)
+		if err != nil {
+			return err
+		}
+		if err := converter.ValidateVideoSpec(videoSpec, targetFormat); err != nil {
+			return err
+		}
+		if err := prepareVideoEncoders(videoSpec, targetFormat, false); err != nil {
+			return err
+		}
+
+		canvasResize, err := converter.BuildResizeSpec(mergeCanvasFlag, 0, 0, "px", mergeResizeMode, 0)
+		if err != nil {
+			return err
+		}
+		if mergeFPS < 0 || mergeSampleRate < 0 {
+			return fmt.Errorf("--fps ve --sample-rate negatif olamaz")
+		}
+		transition, err := parseMergeTransition(mergeTransitionName, mergeTransDur, len(args)-1)
+		if err != nil {
+			return err
+		}
+
+		// Normalizasyon: açıkça istenmişse, geçiş verilmişse veya girdiler uyumsuzsa.
+		infos := probeMergeInputs(args)
+		normalize := mergeNormalize || transition != nil || canvasResize != nil || mergeFPS > 0 || mergeSampleRate > 0 ||
+			mergeInputsNeedNormalize(infos)
+		var canvas mergeCanvas
+		if normalize {
+			if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy {
+				return fmt.Errorf("normalizasyon/geçiş için re-encode gerekir, --video-codec copy kullanılamaz")
+			}
+			canvas, err = resolveMergeCanvas(canvasResize, mergeFPS, mergeSampleRate, infos)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Codec tutarlılığını kontrol et; encode ayarı verildiyse her zaman re-encode yapılır.
+		canConcatDemux := !normalize && !mergeReencode && videoSpec == nil && checkCodecConsistency(args)
 
-		outputPath := buildMergeOutputPath(args[0], targetFormat, mergeName)
+		outputPath, err := resolveNamedOutputPath(mergeNameTmpl, mergeName, "", args[0], targetFormat, 0,
+			buildMergeOutputPath(args[0], targetFormat, mergeName))
+		if err != nil {
+			return err
+		}
 		conflict := converter.NormalizeConflictPolicy(mergeConflict)
 		if conflict == "" {
 			return fmt.Errorf("gecersiz on-conflict politikasi: %s", mergeConflict)
@@ -92,18 +154,31 @@ Farklı codec'lerde otomatik re-encode yapılır.
 		}
 		ui.PrintInfo(fmt.Sprintf("Çıktı: %s", outputPath))
 
-		if canConcatDemux {
+		switch {
+		case normalize:
+			ui.PrintInfo(fmt.Sprintf("Mod: Normalize + re-encode (tuval: %dx%d %s, %s fps, %d Hz stereo)",
+				canvas.Resize.Width, canvas.Resize.Height, canvas.Resize.Mode, formatMergeSeconds(canvas.FPS), canvas.SampleRate))
+			if transition != nil {
+				ui.PrintInfo(fmt.Sprintf("Geçiş: %s (%s sn)", transition.Name, joinMergeDurations(transition.Durations)))
+			}
+		case canConcatDemux:
 			ui.PrintInfo("Mod: Concat demuxer (hızlı, codec copy)")
-		} else {
+		default:
 			ui.PrintInfo("Mod: Re-encode (farklı codec'ler veya --reencode)")
 		}
+		if videoSpec != nil {
+			ui.PrintInfo(fmt.Sprintf("Video ayarları: %s", describeVideoSpec(videoSpec)))
+		}
 
 		started := time.Now()
 
-		if canConcatDemux {
+		switch {
+		case normalize:
+			err = runMergeNormalized(infos, outputPath, targetFormat, mergeQuality, videoSpec, canvas, transition, metadataMode, verbose)
+		case canConcatDemux:
 			err = runMergeConcatDemuxer(args, outputPath, metadataMode, verbose)
-		} else {
-			err = runMergeReencode(args, outputPath, targetFormat, mergeQuality, metadataMode, verbose)
+		default:
+			err = runMergeReencode(args, outputPath, targetFormat, mergeQuality, videoSpec, metadataMode, verbose)
 		}
 		if err != nil {
 			ui.PrintError(err.Error())
@@ -120,10 +195,19 @@ func init() {
 	mergeCmd.Flags().StringVarP(&mergeToFormat, "to", "t", "", "Çıktı video formatı (varsayılan: ilk dosyanın formatı)")
 	mergeCmd.Flags().IntVarP(&mergeQuality, "quality", "q", 0, "Re-encode kalitesi (1-100)")
 	mergeCmd.Flags().StringVarP(&mergeName, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	addNameTemplateFlag(mergeCmd, &mergeNameTmpl)
 	mergeCmd.Flags().StringVar(&mergeConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
 	mergeCmd.Flags().BoolVar(&mergeReencode, "reencode", false, "Re-encode modunu zorla")
 	mergeCmd.Flags().BoolVar(&mergePreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
 	mergeCmd.Flags().BoolVar(&mergeStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
+	addVideoCodecFlags(mergeCmd, &mergeVCodec, &mergeACodec, &mergeCRFValue, &mergeBitrate, &mergeEncPreset, &mergePixFmt, &mergeAudioBR)
+	mergeCmd.Flags().BoolVar(&mergeNormalize, "normalize", false, "Girdileri ortak çözünürlük/fps/ses düzenine normalize etmeyi zorla")
+	mergeCmd.Flags().StringVar(&mergeCanvasFlag, "canvas", "", "Ortak tuval boyutu: preset veya GENxYÜK (varsayılan: ilk video)")
+	mergeCmd.Flags().StringVar(&mergeResizeMode, "resize-mode", "pad", "Tuvale yerleştirme modu: pad, fill, stretch")
+	mergeCmd.Flags().Float64Var(&mergeFPS, "fps", 0, "Ortak kare hızı (varsayılan: ilk video)")
+	mergeCmd.Flags().IntVar(&mergeSampleRate, "sample-rate", 0, "Ortak ses örnekleme hızı (varsayılan: 48000)")
+	mergeCmd.Flags().StringVar(&mergeTransitionName, "transition", "", "Klipler arası geçiş: fade, dissolve, wipeleft, slideright vb.")
+	mergeCmd.Flags().StringVar(&mergeTransDur, "transition-duration", "1", "Geçiş süresi (sn); tek değer ya da her birleşim için virgüllü liste")
 
 	videoCmd.AddCommand(mergeCmd)
 }
@@ -228,7 +312,7 @@ func runMergeConcatDemuxer(inputs []string, output string, metadataMode string,
 	return runFFmpegCommand(ffmpegPath, args, "video birleştirme (concat) ffmpeg hatasi")
 }
 
-func runMergeReencode(inputs []string, output string, targetFormat string, quality int, metadataMode string, verbose bool) error {
+func runMergeReencode(inputs []string, output string, targetFormat string, quality int, videoSpec *converter.VideoSpec, metadataMode string, verbose bool) error {
 	ffmpegPath, err := exec.LookPath("ffmpeg")
 	if err != nil {
 		return fmt.Errorf("ffmpeg bulunamadi")
@@ -249,7 +333,7 @@ func runMergeReencode(inputs []string, output string, targetFormat string, quali
 			partArgs = append(partArgs, "-loglevel", "error")
 		}
 		partArgs = append(partArgs, "-i", input)
-		partArgs = append(partArgs, mergeReencodeCodecArgs(targetFormat, quality)...)
+		partArgs = append(partArgs, mergeReencodeCodecArgs(targetFormat, quality, videoSpec)...)
 		partArgs = append(partArgs, "-y", partPath)
 
 		if err := runFFmpegCommand(ffmpegPath, partArgs, "video birleştirme ara dönüşüm hatasi"); err != nil {
@@ -308,7 +392,10 @@ func mergeQScale(quality int) int {
 	}
 }
 
-func mergeReencodeCodecArgs(targetFormat string, quality int) []string {
+func mergeReencodeCodecArgs(targetFormat string, quality int, videoSpec *converter.VideoSpec) []string {
+	if videoSpec != nil {
+		return converter.VideoEncodeArgs(targetFormat, quality, videoSpec)
+	}
 	crf := mergeCRF(quality)
 	switch targetFormat {
 	case "webm":
@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+const (
+	defaultMergeFPS        = 30.0
+	defaultMergeSampleRate = 48000
+)
+
+// mergeTransitions xfade filtresinde desteklenen geçiş adları.
+var mergeTransitions = []string{
+	"fade", "fadeblack", "fadewhite", "dissolve", "distance",
+	"wipeleft", "wiperight", "wipeup", "wipedown",
+	"slideleft", "slideright", "slideup", "slidedown",
+	"smoothleft", "smoothright", "circleopen", "circleclose", "radial", "pixelize",
+}
+
+// mergeInputInfo birleştirilecek bir girdinin normalizasyon için gereken özellikleri.
+type mergeInputInfo struct {
+	Path       string
+	Width      int
+	Height     int
+	FPS        float64
+	SampleRate int
+	Channels   int
+	HasAudio   bool
+	VideoCodec string
+	AudioCodec string
+	Duration   float64
+}
+
+// mergeCanvas normalizasyon sonrası tüm girdilerin uyacağı ortak çıktı özellikleri.
+type mergeCanvas struct {
+	Resize     converter.ResizeSpec
+	FPS        float64
+	SampleRate int
+}
+
+// mergeTransition parçalar arası xfade/acrossfade geçişi.
+type mergeTransition struct {
+	Name      string
+	Durations []float64
+}
+
+// probeMergeInputs girdilerin çözünürlük, fps, ses ve süre bilgilerini okur.
+func probeMergeInputs(files []string) []mergeInputInfo {
+	infos := make([]mergeInputInfo, 0, len(files))
+	for _, f := range files {
+		item := mergeInputInfo{Path: f}
+		if fi, err := converter.GetFileInfo(f); err == nil {
+			item.Width = fi.Width
+			item.Height = fi.Height
+			item.FPS = fi.FPS
+			item.SampleRate = fi.SampleRate
+			item.Channels = fi.Channels
+			item.HasAudio = fi.AudioCodec != ""
+			item.VideoCodec = fi.VideoCodec
+			item.AudioCodec = fi.AudioCodec
+		}
+		if d, ok := probeMediaDurationSeconds(f); ok {
+			item.Duration = d
+		}
+		infos = append(infos, item)
+	}
+	return infos
+}
+
+// mergeInputsNeedNormalize girdiler arasında çözünürlük, fps, codec veya ses düzeni farkı olup olmadığını döner.
+func mergeInputsNeedNormalize(infos []mergeInputInfo) bool {
+	if len(infos) < 2 {
+		return false
+	}
+	first := infos[0]
+	for _, item := range infos[1:] {
+		if item.Width != first.Width || item.Height != first.Height {
+			return true
+		}
+		if math.Abs(item.FPS-first.FPS) > 0.01 {
+			return true
+		}
+		if item.VideoCodec != first.VideoCodec || item.HasAudio != first.HasAudio {
+			return true
+		}
+		if item.HasAudio && (item.AudioCodec != first.AudioCodec || item.SampleRate != first.SampleRate || item.Channels != first.Channels) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveMergeCanvas verilen ayarları, eksik olanları ilk girdiden tamamlayarak ortak tuvale çevirir.
+func resolveMergeCanvas(resize *converter.ResizeSpec, fps float64, sampleRate int, infos []mergeInputInfo) (mergeCanvas, error) {
+	canvas := mergeCanvas{FPS: fps, SampleRate: sampleRate}
+	if resize != nil {
+		if resize.Mode == converter.ResizeModeFit {
+			// fit her girdiyi farklı boyutta bırakır; concat/xfade aynı boyut ister.
+			return canvas, fmt.Errorf("birleştirmede fit modu kullanılamaz; pad, fill veya stretch seçin")
+		}
+		canvas.Resize = *resize
+	} else {
+		if len(infos) == 0 || infos[0].Width <= 0 || infos[0].Height <= 0 {
+			return canvas, fmt.Errorf("ilk videonun çözünürlüğü okunamadı, --canvas ile hedef boyut verin")
+		}
+		canvas.Resize = converter.ResizeSpec{Width: infos[0].Width, Height: infos[0].Height, Mode: converter.ResizeModePad}
+	}
+	if canvas.FPS <= 0 {
+		canvas.FPS = defaultMergeFPS
+		if len(infos) > 0 && infos[0].FPS > 0 {
+			canvas.FPS = infos[0].FPS
+		}
+	}
+	if canvas.SampleRate <= 0 {
+		canvas.SampleRate = defaultMergeSampleRate
+	}
+	return canvas, nil
+}
+
+// parseMergeTransition geçiş adını ve sınır başına süre listesini doğrular.
+// Tek süre verilirse tüm sınırlara uygulanır.
+func parseMergeTransition(name string, durations string, boundaries int) (*mergeTransition, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || name == "none" {
+		return nil, nil
+	}
+	if !slices.Contains(mergeTransitions, name) {
+		return nil, fmt.Errorf("desteklenmeyen geçiş: %s (desteklenen: %s)", name, strings.Join(mergeTransitions, ", "))
+	}
+
+	parts := strings.Split(durations, ",")
+	values := make([]float64, 0, len(parts))
+	for _, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("geçersiz geçiş süresi: %s", strings.TrimSpace(part))
+		}
+		values = append(values, v)
+	}
+	switch {
+	case len(values) == 1:
+		values = slices.Repeat(values, boundaries)
+	case len(values) != boundaries:
+		return nil, fmt.Errorf("geçiş süresi sayısı (%d) birleşim noktası sayısıyla (%d) eşleşmeli", len(values), boundaries)
+	}
+	return &mergeTransition{Name: name, Durations: values}, nil
+}
+
+// buildMergeFilterGraph girdileri ortak tuvale normalize eden ve concat ya da
+// xfade/acrossfade ile birleştiren filter_complex metnini üretir.
+// Çıkış etiketleri [vout] ve (withAudio ise) [aout] olur.
+func buildMergeFilterGraph(infos []mergeInputInfo, canvas mergeCanvas, transition *mergeTransition, withAudio bool) (string, error) {
+	resizeFilter, err := converter.BuildVideoResizeFilter(canvas.Resize)
+	if err != nil {
+		return "", err
+	}
+	fps := strconv.FormatFloat(canvas.FPS, 'f', -1, 64)
+
+	chains := make([]string, 0, len(infos)*3)
+	for i, item := range infos {
+		chains = append(chains, fmt.Sprintf("[%d:v:0]%s,setsar=1,fps=%s,format=yuv420p,setpts=PTS-STARTPTS,settb=AVTB[v%d]", i, resizeFilter, fps, i))
+		if !withAudio {
+			continue
+		}
+		conform := fmt.Sprintf("aformat=sample_fmts=fltp:sample_rates=%d:channel_layouts=stereo", canvas.SampleRate)
+		switch {
+		case item.HasAudio && item.Duration > 0:
+			// Ses videodan kısa ise sessizlikle tamamlanır, uzunsa kırpılır; senkron korunur.
+			chains = append(chains, fmt.Sprintf("[%d:a:0]aresample=%d,%s,asetpts=PTS-STARTPTS,apad,atrim=duration=%s[a%d]", i, canvas.SampleRate, conform, formatMergeSeconds(item.Duration), i))
+		case item.HasAudio:
+			chains = append(chains, fmt.Sprintf("[%d:a:0]aresample=%d,%s,asetpts=PTS-STARTPTS[a%d]", i, canvas.SampleRate, conform, i))
+		case item.Duration > 0:
+			chains = append(chains, fmt.Sprintf("anullsrc=channel_layout=stereo:sample_rate=%d,atrim=duration=%s,%s[a%d]", canvas.SampleRate, formatMergeSeconds(item.Duration), conform, i))
+		default:
+			return "", fmt.Errorf("ses izi olmayan girdinin süresi okunamadı: %s", item.Path)
+		}
+	}
+
+	if transition == nil {
+		var labels strings.Builder
+		for i := range infos {
+			labels.WriteString(fmt.Sprintf("[v%d]", i))
+			if withAudio {
+				labels.WriteString(fmt.Sprintf("[a%d]", i))
+			}
+		}
+		audioCount := 0
+		outLabels := "[vout]"
+		if withAudio {
+			audioCount = 1
+			outLabels += "[aout]"
+		}
+		chains = append(chains, fmt.Sprintf("%sconcat=n=%d:v=1:a=%d%s", labels.String(), len(infos), audioCount, outLabels))
+		return strings.Join(chains, ";"), nil
+	}
+
+	if len(transition.Durations) != len(infos)-1 {
+		return "", fmt.Errorf("geçiş süresi sayısı birleşim noktası sayısıyla eşleşmeli")
+	}
+	prevV, prevA := "v0", "a0"
+	length := infos[0].Duration
+	for k := 1; k < len(infos); k++ {
+		d := transition.Durations[k-1]
+		if infos[k-1].Duration <= 0 || infos[k].Duration <= 0 {
+			return "", fmt.Errorf("geçiş için video süreleri okunamadı (ffprobe gerekli)")
+		}
+		if d >= infos[k-1].Duration || d >= infos[k].Duration {
+			return "", fmt.Errorf("%d. geçiş süresi (%ss) komşu kliplerden kısa olmalı", k, formatMergeSeconds(d))
+		}
+		offset := length - d
+		outV, outA := fmt.Sprintf("vx%d", k), fmt.Sprintf("ax%d", k)
+		if k == len(infos)-1 {
+			outV, outA = "vout", "aout"
+		}
+		chains = append(chains, fmt.Sprintf("[%s][v%d]xfade=transition=%s:duration=%s:offset=%s[%s]", prevV, k, transition.Name, formatMergeSeconds(d), formatMergeSeconds(offset), outV))
+		if withAudio {
+			chains = append(chains, fmt.Sprintf("[%s][a%d]acrossfade=d=%s[%s]", prevA, k, formatMergeSeconds(d), outA))
+		}
+		prevV, prevA = outV, outA
+		length += infos[k].Duration - d
+	}
+	return strings.Join(chains, ";"), nil
+}
+
+func joinMergeDurations(values []float64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = formatMergeSeconds(v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatMergeSeconds(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1000)/1000, 'f', -1, 64)
+}
+
+// runMergeNormalized tüm girdileri tek FFmpeg çağrısında normalize edip birleştirir.
+func runMergeNormalized(infos []mergeInputInfo, output string, targetFormat string, quality int, videoSpec *converter.VideoSpec, canvas mergeCanvas, transition *mergeTransition, metadataMode string, verbose bool) error {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return fmt.Errorf("ffmpeg bulunamadi")
+	}
+
+	withAudio := videoSpec == nil || videoSpec.AudioCodec != "none"
+	graph, err := buildMergeFilterGraph(infos, canvas, transition, withAudio)
+	if err != nil {
+		return err
+	}
+
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	for _, item := range infos {
+		args = append(args, "-i", item.Path)
+	}
+	args = append(args, "-filter_complex", graph, "-map", "[vout]")
+	if withAudio {
+		args = append(args, "-map", "[aout]")
+	}
+	args = append(args, mergeReencodeCodecArgs(targetFormat, quality, videoSpec)...)
+	args = append(args, converter.MetadataFFmpegArgs(metadataMode)...)
+	args = append(args, "-y", output)
+
+	return runFFmpegCommand(ffmpegPath, args, "video birleştirme (normalize) ffmpeg hatasi")
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestParseMergeTransition(t *testing.T) {
+	tr, err := parseMergeTransition("", "1", 2)
+	if err != nil || tr != nil {
+		t.Fatalf("expected nil transition, got %v %v", tr, err)
+	}
+
+	tr, err = parseMergeTransition("Fade", "0.5", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tr.Name != "fade" || len(tr.Durations) != 3 || tr.Durations[2] != 0.5 {
+		t.Fatalf("unexpected transition: %+v", tr)
+	}
+
+	tr, err = parseMergeTransition("wipeleft", "1, 2", 2)
+	if err != nil || tr.Durations[1] != 2 {
+		t.Fatalf("unexpected per-boundary transition: %+v %v", tr, err)
+	}
+
+	if _, err := parseMergeTransition("spin", "1", 1); err == nil {
+		t.Fatalf("expected error for unknown transition")
+	}
+	if _, err := parseMergeTransition("fade", "1,2,3", 2); err == nil {
+		t.Fatalf("expected error for duration count mismatch")
+	}
+	if _, err := parseMergeTransition("fade", "-1", 1); err == nil {
+		t.Fatalf("expected error for negative duration")
+	}
+}
+
+func TestMergeInputsNeedNormalize(t *testing.T) {
+	base := mergeInputInfo{Width: 1920, Height: 1080, FPS: 30, VideoCodec: "h264", HasAudio: true, AudioCodec: "aac", SampleRate: 48000, Channels: 2}
+	if mergeInputsNeedNormalize([]mergeInputInfo{base, base}) {
+		t.Fatalf("identical inputs should not need normalization")
+	}
+
+	portrait := base
+	portrait.Width, portrait.Height = 1080, 1920
+	if !mergeInputsNeedNormalize([]mergeInputInfo{base, portrait}) {
+		t.Fatalf("resolution mismatch should need normalization")
+	}
+
+	silent := base
+	silent.HasAudio, silent.AudioCodec = false, ""
+	if !mergeInputsNeedNormalize([]mergeInputInfo{base, silent}) {
+		t.Fatalf("missing audio should need normalization")
+	}
+
+	mono := base
+	mono.Channels = 1
+	if !mergeInputsNeedNormalize([]mergeInputInfo{base, mono}) {
+		t.Fatalf("channel mismatch should need normalization")
+	}
+}
+
+func TestResolveMergeCanvas(t *testing.T) {
+	infos := []mergeInputInfo{{Width: 1280, Height: 720, FPS: 25}}
+	canvas, err := resolveMergeCanvas(nil, 0, 0, infos)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if canvas.Resize.Width != 1280 || canvas.Resize.Mode != converter.ResizeModePad || canvas.FPS != 25 || canvas.SampleRate != defaultMergeSampleRate {
+		t.Fatalf("unexpected canvas: %+v", canvas)
+	}
+
+	if _, err := resolveMergeCanvas(nil, 0, 0, []mergeInputInfo{{}}); err == nil {
+		t.Fatalf("expected error when first input has no dimensions")
+	}
+
+	fit := &converter.ResizeSpec{Width: 1920, Height: 1080, Mode: converter.ResizeModeFit}
+	if _, err := resolveMergeCanvas(fit, 0, 0, infos); err == nil {
+		t.Fatalf("expected error for fit mode")
+	}
+}
+
+func TestBuildMergeFilterGraphConcatWithSilentAudio(t *testing.T) {
+	canvas := mergeCanvas{Resize: converter.ResizeSpec{Width: 1920, Height: 1080, Mode: converter.ResizeModePad}, FPS: 30, SampleRate: 48000}
+	infos := []mergeInputInfo{
+		{Path: "a.mp4", HasAudio: true, Duration: 10},
+		{Path: "b.mp4", HasAudio: false, Duration: 5},
+	}
+	graph, err := buildMergeFilterGraph(infos, canvas, nil, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"[0:v:0]scale=1920:1080",
+		"fps=30",
+		"anullsrc=channel_layout=stereo:sample_rate=48000,atrim=duration=5",
+		"[v0][a0][v1][a1]concat=n=2:v=1:a=1[vout][aout]",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Fatalf("graph missing %q: %s", want, graph)
+		}
+	}
+	if strings.Contains(graph, "[1:a:0]") {
+		t.Fatalf("graph should not reference missing audio stream: %s", graph)
+	}
+}
+
+func TestBuildMergeFilterGraphTransitions(t *testing.T) {
+	canvas := mergeCanvas{Resize: converter.ResizeSpec{Width: 1280, Height: 720, Mode: converter.ResizeModeStretch}, FPS: 30, SampleRate: 48000}
+	infos := []mergeInputInfo{
+		{Path: "a.mp4", HasAudio: true, Duration: 10},
+		{Path: "b.mp4", HasAudio: true, Duration: 8},
+		{Path: "c.mp4", HasAudio: true, Duration: 6},
+	}
+	tr := &mergeTransition{Name: "fade", Durations: []float64{1, 0.5}}
+	graph, err := buildMergeFilterGraph(infos, canvas, tr, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"[v0][v1]xfade=transition=fade:duration=1:offset=9[vx1]",
+		"[a0][a1]acrossfade=d=1[ax1]",
+		"[vx1][v2]xfade=transition=fade:duration=0.5:offset=16.5[vout]",
+		"[ax1][a2]acrossfade=d=0.5[aout]",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Fatalf("graph missing %q: %s", want, graph)
+		}
+	}
+
+	tooLong := &mergeTransition{Name: "fade", Durations: []float64{9, 1}}
+	if _, err := buildMergeFilterGraph(infos, canvas, tooLong, true); err == nil {
+		t.Fatalf("expected error when transition exceeds clip length")
+	}
+}
@@ -26,7 +26,7 @@ func TestBuildMergeOutputPath(t *testing.T) {
 
 func TestMergeReencodeCodecArgs(t *testing.T) {
 	// MP4 → libx264
-	args := mergeReencodeCodecArgs("mp4", 80)
+	args := mergeReencodeCodecArgs("mp4", 80, nil)
 	foundH264 := false
 	for i := 0; i < len(args)-1; i++ {
 		if args[i] == "-c:v" && args[i+1] == "libx264" {
@@ -38,7 +38,7 @@ func TestMergeReencodeCodecArgs(t *testing.T) {
 	}
 
 	// WebM → libvpx-vp9
-	args = mergeReencodeCodecArgs("webm", 80)
+	args = mergeReencodeCodecArgs("webm", 80, nil)
 	foundVP9 := false
 	for i := 0; i < len(args)-1; i++ {
 		if args[i] == "-c:v" && args[i+1] == "libvpx-vp9" {
@@ -50,7 +50,7 @@ func TestMergeReencodeCodecArgs(t *testing.T) {
 	}
 
 	// AVI → mpeg4
-	args = mergeReencodeCodecArgs("avi", 50)
+	args = mergeReencodeCodecArgs("avi", 50, nil)
 	foundMpeg4 := false
 	for i := 0; i < len(args)-1; i++ {
 		if args[i] == "-c:v" && args[i+1] == "mpeg4" {
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+// audioOnlyFlagNames yalnızca ses çıktılarında anlamlı olan bayraklar.
+var audioOnlyFlagNames = []string{"sample-rate", "bit-depth", "channels", "vbr", "cbr"}
+
+// videoOnlyFlagNames yalnızca video çıktılarında anlamlı olan bayraklar.
+var videoOnlyFlagNames = []string{"video-codec", "crf", "encode-preset", "pix-fmt"}
+
+// encodeFlagValues convert/batch/watch komutlarının ses ve video encode bayraklarını taşır.
+// --bitrate hedefe göre anlam değiştirir: ses çıktısında ses, video çıktısında video bitrate'idir.
+type encodeFlagValues struct {
+	SampleRate   int
+	BitDepth     string
+	Channels     int
+	Bitrate      string
+	VBR          bool
+	CBR          bool
+	AudioCodec   string
+	AudioBitrate string
+	VideoCodec   string
+	CRF          int
+	EncodePreset string
+	PixFmt       string
+	// VideoBitrate sadece profilden gelir; --bitrate verilmemişse video hedeflerinde kullanılır.
+	VideoBitrate string
+}
+
+// resolveEncodeSpecs bayrakları hedef formata göre ses veya video ayarına çevirir ve doğrular.
+// Profilden gelen ve hedefe uymayan ayarlar yok sayılır; açıkça verilen bayraklar hata üretir.
+func resolveEncodeSpecs(cmd *cobra.Command, values encodeFlagValues, targetFormat string) (*converter.AudioSpec, *converter.VideoSpec, error) {
+	switch {
+	case converter.IsVideoFormat(targetFormat):
+		if anyFlagChanged(cmd, audioOnlyFlagNames...) {
+			return nil, nil, fmt.Errorf("--sample-rate, --bit-depth, --channels, --vbr ve --cbr sadece ses çıktılarında kullanılabilir")
+		}
+		bitrate := values.Bitrate
+		if bitrate == "" {
+			bitrate = values.VideoBitrate
+		}
+		audioCodec := values.AudioCodec
+		if !cmd.Flags().Changed("audio-codec") && converter.NormalizeVideoAudioCodec(audioCodec) == "" {
+			// Profildeki ses-çıktısı encoder'ı video ses izine uymuyorsa yok say.
+			audioCodec = ""
+		}
+		spec, err := converter.BuildVideoSpec(values.VideoCodec, values.CRF, bitrate, values.EncodePreset, values.PixFmt, audioCodec, values.AudioBitrate)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := converter.ValidateVideoSpec(spec, targetFormat); err != nil {
+			return nil, nil, err
+		}
+		return nil, spec, nil
+
+	case converter.IsAudioFormat(targetFormat):
+		if anyFlagChanged(cmd, videoOnlyFlagNames...) {
+			return nil, nil, fmt.Errorf("--video-codec, --crf, --encode-preset ve --pix-fmt sadece video çıktılarında kullanılabilir")
+		}
+		if cmd.Flags().Changed("bitrate") && cmd.Flags().Changed("audio-bitrate") {
+			return nil, nil, fmt.Errorf("ses çıktılarında --bitrate ve --audio-bitrate aynı anlama gelir, sadece birini kullanın")
+		}
+		bitrate := values.Bitrate
+		if bitrate == "" {
+			bitrate = values.AudioBitrate
+		}
+		spec, err := converter.BuildAudioSpec(values.SampleRate, values.BitDepth, values.Channels, bitrate, values.VBR, values.CBR, values.AudioCodec)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := converter.ValidateAudioSpec(spec, targetFormat); err != nil {
+			return nil, nil, err
+		}
+		return spec, nil, nil
+
+	default:
+		names := append(append([]string{"bitrate", "audio-codec", "audio-bitrate"}, audioOnlyFlagNames...), videoOnlyFlagNames...)
+		if anyFlagChanged(cmd, names...) {
+			return nil, nil, fmt.Errorf("ses/video encode ayarları (--bitrate, --video-codec vb.) sadece ses ve video çıktılarında kullanılabilir")
+		}
+		return nil, nil, nil
+	}
+}
+
+// prepareVideoEncoders video ayarındaki codec'leri yerel FFmpeg encoder'larına eşler
+// ve yedek encoder'a geçildiyse kullanıcıyı uyarır.
+func prepareVideoEncoders(spec *converter.VideoSpec, targetFormat string, quiet bool) error {
+	if spec.IsZero() {
+		return nil
+	}
+	notes, err := converter.ResolveVideoSpecEncoders(spec, targetFormat)
+	if err != nil {
+		return err
+	}
+	if !quiet {
+		for _, note := range notes {
+			ui.PrintWarning(note)
+		}
+	}
+	return nil
+}
+
+func describeVideoSpec(spec *converter.VideoSpec) string {
+	if spec.IsZero() {
+		return ""
+	}
+	desc := ""
+	add := func(label string, value string) {
+		if desc != "" {
+			desc += ", "
+		}
+		desc += label + "=" + value
+	}
+	if spec.Codec != "" {
+		codec := spec.Codec
+		if spec.Encoder != "" {
+			codec += " (" + spec.Encoder + ")"
+		}
+		add("codec", codec)
+	}
+	if spec.CRF != nil {
+		add("crf", fmt.Sprintf("%d", *spec.CRF))
+	}
+	if spec.Bitrate != "" {
+		add("bitrate", spec.Bitrate)
+	}
+	if spec.Preset != "" {
+		add("preset", spec.Preset)
+	}
+	if spec.PixFmt != "" {
+		add("pix-fmt", spec.PixFmt)
+	}
+	if spec.AudioCodec != "" {
+		add("ses", spec.AudioCodec)
+	}
+	if spec.AudioBitrate != "" {
+		add("ses-bitrate", spec.AudioBitrate)
+	}
+	return desc
+}
+
+// addVideoCodecFlags video codec bayraklarını komuta ekler (trim ve merge için).
+func addVideoCodecFlags(cmd *cobra.Command, videoCodec *string, audioCodec *string, crf *int, bitrate *string, preset *string, pixFmt *string, audioBitrate *string) {
+	cmd.Flags().StringVar(videoCodec, "video-codec", "", "Video codec: h264, h265, av1, vp9, copy")
+	cmd.Flags().StringVar(audioCodec, "audio-codec", "", "Ses izi codec'i: aac, opus, mp3, vorbis, ac3, flac, copy, none")
+	cmd.Flags().IntVar(crf, "crf", -1, "Sabit kalite değeri (h264/h265: 0-51, av1/vp9: 0-63)")
+	cmd.Flags().StringVar(bitrate, "bitrate", "", "Video bitrate (ör: 2500k, 4M)")
+	cmd.Flags().StringVar(preset, "encode-preset", "", "Encoder hız preset'i (ör: fast, medium, slow)")
+	cmd.Flags().StringVar(pixFmt, "pix-fmt", "", "Piksel formatı (ör: yuv420p, yuv420p10le)")
+	cmd.Flags().StringVar(audioBitrate, "audio-bitrate", "", "Ses izi bitrate (ör: 128k)")
+}
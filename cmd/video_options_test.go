@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newEncodeFlagTestCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	for _, name := range []string{"bitrate", "bit-depth", "audio-codec", "audio-bitrate", "video-codec", "encode-preset", "pix-fmt"} {
+		cmd.Flags().String(name, "", "")
+	}
+	for _, name := range []string{"sample-rate", "channels", "crf"} {
+		cmd.Flags().Int(name, 0, "")
+	}
+	cmd.Flags().Bool("vbr", false, "")
+	cmd.Flags().Bool("cbr", false, "")
+	return cmd
+}
+
+func TestResolveEncodeSpecsBitrateFollowsTarget(t *testing.T) {
+	cmd := newEncodeFlagTestCommand()
+	if err := cmd.Flags().Set("bitrate", "192k"); err != nil {
+		t.Fatalf("set flag failed: %v", err)
+	}
+
+	audioSpec, videoSpec, err := resolveEncodeSpecs(cmd, encodeFlagValues{Bitrate: "192k", CRF: -1}, "mp3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if videoSpec != nil || audioSpec == nil || audioSpec.Bitrate != "192k" {
+		t.Fatalf("expected audio bitrate for mp3 target, got audio=%#v video=%#v", audioSpec, videoSpec)
+	}
+
+	audioSpec, videoSpec, err = resolveEncodeSpecs(cmd, encodeFlagValues{Bitrate: "4M", AudioBitrate: "96k", CRF: -1}, "mp4")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if audioSpec != nil || videoSpec == nil || videoSpec.Bitrate != "4000k" || videoSpec.AudioBitrate != "96k" {
+		t.Fatalf("expected video bitrate for mp4 target, got audio=%#v video=%#v", audioSpec, videoSpec)
+	}
+}
+
+func TestResolveEncodeSpecsRejectsMismatchedFlags(t *testing.T) {
+	cmd := newEncodeFlagTestCommand()
+	if err := cmd.Flags().Set("crf", "20"); err != nil {
+		t.Fatalf("set flag failed: %v", err)
+	}
+	if _, _, err := resolveEncodeSpecs(cmd, encodeFlagValues{CRF: 20}, "mp3"); err == nil {
+		t.Fatalf("expected --crf to be rejected for audio target")
+	}
+
+	cmd = newEncodeFlagTestCommand()
+	if err := cmd.Flags().Set("sample-rate", "48000"); err != nil {
+		t.Fatalf("set flag failed: %v", err)
+	}
+	if _, _, err := resolveEncodeSpecs(cmd, encodeFlagValues{SampleRate: 48000, CRF: -1}, "mp4"); err == nil {
+		t.Fatalf("expected --sample-rate to be rejected for video target")
+	}
+}
+
+func TestResolveEncodeSpecsIgnoresProfileValuesForOtherTargets(t *testing.T) {
+	cmd := newEncodeFlagTestCommand()
+	audioSpec, videoSpec, err := resolveEncodeSpecs(cmd, encodeFlagValues{SampleRate: 44100, AudioCodec: "alac", CRF: -1}, "mp4")
+	if err != nil {
+		t.Fatalf("profile-sourced audio settings should be ignored for video targets: %v", err)
+	}
+	if audioSpec != nil || videoSpec != nil {
+		t.Fatalf("expected no specs, got audio=%#v video=%#v", audioSpec, videoSpec)
+	}
+
+	audioSpec, videoSpec, err = resolveEncodeSpecs(cmd, encodeFlagValues{SampleRate: 44100, CRF: -1}, "png")
+	if err != nil || audioSpec != nil || videoSpec != nil {
+		t.Fatalf("expected image target to ignore encode settings, got audio=%#v video=%#v err=%v", audioSpec, videoSpec, err)
+	}
+}
@@ -0,0 +1,528 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+const (
+	defaultPackageLadder     = "1080,720,480"
+	defaultPackageSegment    = 6.0
+	defaultPackageAudioBR    = "128k"
+	defaultThumbnailInterval = 10.0
+	thumbnailWidth           = 160
+)
+
+var (
+	packageHLS           bool
+	packageDASH          bool
+	packageLadder        string
+	packageSegment       float64
+	packageAudioBR       string
+	packagePreset        string
+	packageThumbnails    bool
+	packageThumbInterval float64
+	packageDryRun        bool
+)
+
+// packageRung bitrate merdiveninin tek basamağı.
+type packageRung struct {
+	Name    string `json:"name"`
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+	Bitrate string `json:"bitrate"`
+	MaxRate string `json:"maxrate"`
+	BufSize string `json:"bufsize"`
+}
+
+var videoPackageCmd = &cobra.Command{
+	Use:   "package <video-dosyasi>",
+	Short: "Videoyu HLS ve/veya DASH olarak uyarlanabilir akış paketine dönüştürür",
+	Long: `Tek kaynaktan bitrate merdiveni (ör: 1080/720/480) üretir; segment dosyalarını,
+varyant playlist'lerini ve master playlist (HLS) / MPD (DASH) dosyasını yazar.
+Tamamen çevrimdışı, yerel FFmpeg ile çalışır; çıktı statik hosting'e kopyalanabilir.
+
+Merdiven basamakları yükseklik (1080, 720p), resize preset adı (fullhd, hd) veya
+1280x720 biçiminde boyut olabilir; ":bitrate" ile video bitrate'i ezilebilir (ör: 720:3M).
+Kaynaktan yüksek basamaklar atlanır.
+
+Örnekler:
+  fileconverter-cli video package film.mp4 --hls
+  fileconverter-cli video package film.mp4 --hls --dash --ladder 1080,720,480 --segment-duration 4
+  fileconverter-cli video package film.mp4 --dash --ladder fullhd:6M,hd,480 -o ./portal/film
+  fileconverter-cli video package film.mp4 --hls --thumbnails --thumbnail-interval 5
+  fileconverter-cli video package film.mp4 --hls --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input := args[0]
+		jsonOutput := isJSONOutput()
+		if _, err := os.Stat(input); os.IsNotExist(err) {
+			return fmt.Errorf("dosya bulunamadi: %s", input)
+		}
+		if !packageHLS && !packageDASH {
+			return fmt.Errorf("--hls veya --dash seçeneklerinden en az biri gerekli")
+		}
+		if packageSegment < 1 || packageSegment > 60 {
+			return fmt.Errorf("--segment-duration 1-60 saniye aralığında olmalı: %g", packageSegment)
+		}
+		if packageThumbnails && packageThumbInterval <= 0 {
+			return fmt.Errorf("--thumbnail-interval pozitif olmalı")
+		}
+		audioBitrate, err := converter.NormalizeAudioBitrate(packageAudioBR)
+		if err != nil {
+			return fmt.Errorf("geçersiz --audio-bitrate: %w", err)
+		}
+		packageAudioBR = audioBitrate
+
+		info, _ := converter.GetFileInfo(input)
+		hasAudio := info.AudioCodec != "" || info.VideoCodec == ""
+		rungs, dropped, err := buildPackageLadder(packageLadder, info.Width, info.Height)
+		if err != nil {
+			return err
+		}
+
+		root := strings.TrimSpace(outputDir)
+		if root == "" {
+			base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+			root = filepath.Join(filepath.Dir(input), base+"_package")
+		}
+		formats := packageFormats(packageHLS, packageDASH)
+
+		if packageDryRun {
+			if jsonOutput {
+				return printJSON(map[string]interface{}{
+					"dry_run":          true,
+					"input":            input,
+					"output_dir":       root,
+					"formats":          formats,
+					"ladder":           rungs,
+					"skipped_rungs":    dropped,
+					"segment_duration": packageSegment,
+					"audio":            hasAudio,
+					"thumbnails":       packageThumbnails,
+				})
+			}
+			ui.PrintInfo("Ön izleme modu (--dry-run) — işlem yapılmayacak.")
+			printPackagePlan(input, root, formats, rungs, dropped, hasAudio)
+			return nil
+		}
+		if !converter.IsFFmpegAvailable() {
+			return fmt.Errorf("video package için ffmpeg gerekli")
+		}
+		if err := os.MkdirAll(root, 0755); err != nil {
+			return err
+		}
+
+		if !jsonOutput {
+			printPackagePlan(input, root, formats, rungs, dropped, hasAudio)
+		}
+		started := time.Now()
+		outputs := map[string]string{}
+		for _, format := range formats {
+			dir := filepath.Join(root, format)
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			var args []string
+			if format == "hls" {
+				args = buildHLSPackageArgs(input, dir, rungs, hasAudio, packageSegment, packageAudioBR, packagePreset, verbose)
+				outputs["hls"] = filepath.Join(dir, "master.m3u8")
+			} else {
+				args = buildDASHPackageArgs(input, dir, rungs, hasAudio, packageSegment, packageAudioBR, packagePreset, verbose)
+				outputs["dash"] = filepath.Join(dir, "manifest.mpd")
+			}
+			if !jsonOutput {
+				ui.PrintInfo(fmt.Sprintf("%s paketleniyor...", strings.ToUpper(format)))
+			}
+			if err := runPackageFFmpeg(args, format); err != nil {
+				if jsonOutput {
+					return err
+				}
+				ui.PrintError(err.Error())
+				return err
+			}
+		}
+
+		if packageThumbnails {
+			vttPath, err := writePackageThumbnails(input, root, packageThumbInterval, verbose)
+			if err != nil {
+				if jsonOutput {
+					return err
+				}
+				ui.PrintError(err.Error())
+				return err
+			}
+			outputs["thumbnails"] = vttPath
+		}
+
+		if jsonOutput {
+			return printJSON(map[string]interface{}{
+				"success":     true,
+				"input":       input,
+				"output_dir":  root,
+				"ladder":      rungs,
+				"outputs":     outputs,
+				"duration_ms": time.Since(started).Milliseconds(),
+			})
+		}
+		for _, format := range formats {
+			ui.PrintSuccess(fmt.Sprintf("%s: %s", strings.ToUpper(format), outputs[format]))
+		}
+		if vtt, ok := outputs["thumbnails"]; ok {
+			ui.PrintInfo(fmt.Sprintf("Küçük resim VTT: %s", vtt))
+		}
+		ui.PrintDuration(time.Since(started))
+		return nil
+	},
+}
+
+func init() {
+	f := videoPackageCmd.Flags()
+	f.BoolVar(&packageHLS, "hls", false, "HLS paketi üret (master.m3u8 + varyant playlist'leri + .ts segmentleri)")
+	f.BoolVar(&packageDASH, "dash", false, "DASH paketi üret (manifest.mpd + .m4s segmentleri)")
+	f.StringVar(&packageLadder, "ladder", defaultPackageLadder, "Bitrate merdiveni (ör: 1080,720,480 veya fullhd:6M,hd,480)")
+	f.Float64Var(&packageSegment, "segment-duration", defaultPackageSegment, "Segment süresi (saniye)")
+	f.StringVar(&packageAudioBR, "audio-bitrate", defaultPackageAudioBR, "Ses bitrate'i (ör: 96k, 128k)")
+	f.StringVar(&packagePreset, "encode-preset", "medium", "x264 hız preset'i (ör: veryfast, medium, slow)")
+	f.BoolVar(&packageThumbnails, "thumbnails", false, "Oynatıcı önizlemesi için küçük resimler ve thumbnails.vtt üret")
+	f.Float64Var(&packageThumbInterval, "thumbnail-interval", defaultThumbnailInterval, "Küçük resimler arası süre (saniye)")
+	f.BoolVar(&packageDryRun, "dry-run", false, "Merdiveni ve çıktı yapısını göster, paketleme yapma")
+
+	videoCmd.AddCommand(videoPackageCmd)
+}
+
+func packageFormats(hls bool, dash bool) []string {
+	formats := []string{}
+	if hls {
+		formats = append(formats, "hls")
+	}
+	if dash {
+		formats = append(formats, "dash")
+	}
+	return formats
+}
+
+// buildPackageLadder merdiven tanımını basamaklara çevirir; kaynaktan yüksek basamakları atlar.
+// Kaynak boyutu bilinmiyorsa 16:9 varsayılır ve hiçbir basamak atlanmaz.
+func buildPackageLadder(spec string, srcWidth int, srcHeight int) ([]packageRung, []string, error) {
+	var rungs []packageRung
+	var dropped []string
+	seen := map[string]bool{}
+	for _, token := range strings.Split(spec, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		rung, err := parsePackageRung(token, srcWidth, srcHeight)
+		if err != nil {
+			return nil, nil, err
+		}
+		if seen[rung.Name] {
+			continue
+		}
+		seen[rung.Name] = true
+		if srcHeight > 0 && rung.Height > srcHeight && minInt(rung.Width, rung.Height) > minInt(srcWidth, srcHeight) {
+			dropped = append(dropped, rung.Name)
+			continue
+		}
+		rungs = append(rungs, rung)
+	}
+	if len(rungs) == 0 && len(dropped) == 0 {
+		return nil, nil, fmt.Errorf("--ladder en az bir basamak içermeli")
+	}
+	if len(rungs) == 0 {
+		// Tüm basamaklar kaynaktan büyükse kaynak çözünürlüğünde tek basamak üret.
+		rung := newPackageRung(fmt.Sprintf("%dp", evenDimension(srcHeight)), evenDimension(srcWidth), evenDimension(srcHeight), 0)
+		rungs = append(rungs, rung)
+	}
+	return rungs, dropped, nil
+}
+
+func parsePackageRung(token string, srcWidth int, srcHeight int) (packageRung, error) {
+	sizePart, bitratePart, _ := strings.Cut(token, ":")
+	sizePart = strings.ToLower(strings.TrimSpace(sizePart))
+
+	bitrate := 0
+	if strings.TrimSpace(bitratePart) != "" {
+		normalized, err := converter.NormalizeVideoBitrate(bitratePart)
+		if err != nil {
+			return packageRung{}, fmt.Errorf("geçersiz basamak bitrate'i %q: %w", token, err)
+		}
+		bitrate, _ = strconv.Atoi(strings.TrimSuffix(normalized, "k"))
+	}
+
+	if h, err := strconv.Atoi(strings.TrimSuffix(sizePart, "p")); err == nil {
+		if h < 144 || h > 4320 {
+			return packageRung{}, fmt.Errorf("geçersiz basamak yüksekliği: %s (144-4320)", token)
+		}
+		width := int(math.Round(float64(h) * 16 / 9))
+		if srcWidth > 0 && srcHeight > 0 {
+			width = int(math.Round(float64(h) * float64(srcWidth) / float64(srcHeight)))
+		}
+		height := evenDimension(h)
+		return newPackageRung(fmt.Sprintf("%dp", height), evenDimension(width), height, bitrate), nil
+	}
+	preset, ok := converter.ResolveResizePreset(sizePart)
+	if !ok {
+		return packageRung{}, fmt.Errorf("geçersiz merdiven basamağı: %s (ör: 1080, 720p, hd, 1280x720)", token)
+	}
+	return newPackageRung(fmt.Sprintf("%dp", evenDimension(preset.Height)), evenDimension(preset.Width), evenDimension(preset.Height), bitrate), nil
+}
+
+// newPackageRung basamağı kbps cinsinden bitrate ile kurar; 0 ise yüksekliğe göre varsayılan kullanılır.
+func newPackageRung(name string, width int, height int, kbps int) packageRung {
+	if kbps <= 0 {
+		kbps = defaultRungKbps(height)
+	}
+	return packageRung{
+		Name:    name,
+		Width:   width,
+		Height:  height,
+		Bitrate: fmt.Sprintf("%dk", kbps),
+		MaxRate: fmt.Sprintf("%dk", kbps*107/100),
+		BufSize: fmt.Sprintf("%dk", kbps*3/2),
+	}
+}
+
+// defaultRungKbps yükseklik için yaygın H.264 merdiven bitrate'ini döner.
+func defaultRungKbps(height int) int {
+	switch {
+	case height >= 2160:
+		return 16000
+	case height >= 1440:
+		return 9000
+	case height >= 1080:
+		return 5000
+	case height >= 720:
+		return 2800
+	case height >= 480:
+		return 1400
+	case height >= 360:
+		return 800
+	default:
+		return 400
+	}
+}
+
+func evenDimension(value int) int {
+	if value < 2 {
+		return 2
+	}
+	return value - value%2
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// packageFilterComplex kaynağı basamak sayısı kadar bölüp her birini hedef boyuta ölçekler.
+func packageFilterComplex(rungs []packageRung) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[0:v:0]split=%d", len(rungs)))
+	for i := range rungs {
+		sb.WriteString(fmt.Sprintf("[s%d]", i))
+	}
+	for i, r := range rungs {
+		scale, _ := converter.BuildVideoResizeFilter(converter.ResizeSpec{Width: r.Width, Height: r.Height, Mode: converter.ResizeModePad})
+		sb.WriteString(fmt.Sprintf(";[s%d]%s,setsar=1[v%d]", i, scale, i))
+	}
+	return sb.String()
+}
+
+// packageVideoArgs basamak başına H.264 encode ve segment sınırında anahtar kare ayarlarını üretir.
+func packageVideoArgs(rungs []packageRung, segment float64, preset string) []string {
+	args := []string{}
+	for i, r := range rungs {
+		idx := strconv.Itoa(i)
+		args = append(args,
+			"-c:v:"+idx, "libx264",
+			"-b:v:"+idx, r.Bitrate,
+			"-maxrate:v:"+idx, r.MaxRate,
+			"-bufsize:v:"+idx, r.BufSize,
+		)
+	}
+	if strings.TrimSpace(preset) != "" {
+		args = append(args, "-preset", preset)
+	}
+	// Tüm varyantların segmentleri aynı anda başlamalı: anahtar kareler segment sınırına zorlanır.
+	args = append(args,
+		"-pix_fmt", "yuv420p",
+		"-profile:v", "main",
+		"-sc_threshold", "0",
+		"-force_key_frames", "expr:gte(t,n_forced*"+formatRetimeNumber(segment)+")",
+	)
+	return args
+}
+
+// buildHLSPackageArgs tek ffmpeg çağrısıyla tüm varyantları, varyant playlist'lerini ve master.m3u8'i üretir.
+func buildHLSPackageArgs(input string, dir string, rungs []packageRung, hasAudio bool, segment float64, audioBitrate string, preset string, verbose bool) []string {
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	args = append(args, "-i", input, "-filter_complex", packageFilterComplex(rungs))
+	streamMap := make([]string, 0, len(rungs))
+	for i, r := range rungs {
+		args = append(args, "-map", fmt.Sprintf("[v%d]", i))
+		entry := fmt.Sprintf("v:%d", i)
+		if hasAudio {
+			args = append(args, "-map", "0:a:0")
+			entry += fmt.Sprintf(",a:%d", i)
+		}
+		streamMap = append(streamMap, entry+",name:"+r.Name)
+	}
+	args = append(args, packageVideoArgs(rungs, segment, preset)...)
+	if hasAudio {
+		args = append(args, "-c:a", "aac", "-b:a", audioBitrate, "-ac", "2")
+	}
+	args = append(args,
+		"-f", "hls",
+		"-hls_time", formatRetimeNumber(segment),
+		"-hls_playlist_type", "vod",
+		"-hls_flags", "independent_segments",
+		"-hls_segment_filename", filepath.Join(dir, "%v", "segment_%05d.ts"),
+		"-master_pl_name", "master.m3u8",
+		"-var_stream_map", strings.Join(streamMap, " "),
+		"-y", filepath.Join(dir, "%v", "index.m3u8"),
+	)
+	return args
+}
+
+// buildDASHPackageArgs tüm video temsillerini ve tek ses temsilini manifest.mpd ile üretir.
+func buildDASHPackageArgs(input string, dir string, rungs []packageRung, hasAudio bool, segment float64, audioBitrate string, preset string, verbose bool) []string {
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	args = append(args, "-i", input, "-filter_complex", packageFilterComplex(rungs))
+	for i := range rungs {
+		args = append(args, "-map", fmt.Sprintf("[v%d]", i))
+	}
+	adaptation := "id=0,streams=v"
+	if hasAudio {
+		args = append(args, "-map", "0:a:0")
+		adaptation += " id=1,streams=a"
+	}
+	args = append(args, packageVideoArgs(rungs, segment, preset)...)
+	if hasAudio {
+		args = append(args, "-c:a", "aac", "-b:a", audioBitrate, "-ac", "2")
+	}
+	args = append(args,
+		"-f", "dash",
+		"-seg_duration", formatRetimeNumber(segment),
+		"-use_template", "1",
+		"-use_timeline", "1",
+		"-adaptation_sets", adaptation,
+		"-init_seg_name", "init-$RepresentationID$.m4s",
+		"-media_seg_name", "chunk-$RepresentationID$-$Number%05d$.m4s",
+		"-y", filepath.Join(dir, "manifest.mpd"),
+	)
+	return args
+}
+
+func runPackageFFmpeg(args []string, format string) error {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return fmt.Errorf("ffmpeg bulunamadi")
+	}
+	return runFFmpegCommand(ffmpegPath, args, fmt.Sprintf("video package %s ffmpeg hatasi", format))
+}
+
+// writePackageThumbnails belirli aralıklarla küçük resim çıkarır ve oynatıcılar için WebVTT dosyası yazar.
+func writePackageThumbnails(input string, root string, interval float64, verbose bool) (string, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return "", fmt.Errorf("ffmpeg bulunamadi")
+	}
+	thumbDir := filepath.Join(root, "thumbnails")
+	if err := os.MkdirAll(thumbDir, 0755); err != nil {
+		return "", err
+	}
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	args = append(args,
+		"-i", input,
+		"-vf", fmt.Sprintf("fps=1/%s,scale=%d:-2", formatRetimeNumber(interval), thumbnailWidth),
+		"-q:v", "5",
+		"-start_number", "1",
+		"-y", filepath.Join(thumbDir, "thumb_%05d.jpg"),
+	)
+	if err := runFFmpegCommand(ffmpegPath, args, "küçük resim üretilemedi"); err != nil {
+		return "", err
+	}
+
+	entries, err := os.ReadDir(thumbDir)
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".jpg") {
+			names = append(names, "thumbnails/"+e.Name())
+		}
+	}
+	duration, _ := probeMediaDurationSeconds(input)
+	vttPath := filepath.Join(root, "thumbnails.vtt")
+	if err := os.WriteFile(vttPath, []byte(buildThumbnailsVTT(names, interval, duration)), 0644); err != nil {
+		return "", fmt.Errorf("thumbnails.vtt yazılamadı: %w", err)
+	}
+	return vttPath, nil
+}
+
+// buildThumbnailsVTT her küçük resmi kendi zaman aralığına eşleyen WebVTT içeriğini üretir.
+// Son aralık video süresi biliniyorsa onunla sınırlanır.
+func buildThumbnailsVTT(images []string, interval float64, duration float64) string {
+	var sb strings.Builder
+	sb.WriteString("WEBVTT\n")
+	for i, img := range images {
+		start := float64(i) * interval
+		end := start + interval
+		if duration > 0 {
+			if start >= duration {
+				break
+			}
+			if end > duration {
+				end = duration
+			}
+		}
+		sb.WriteString(fmt.Sprintf("\n%s --> %s\n%s\n", formatVTTTimestamp(start), formatVTTTimestamp(end), img))
+	}
+	return sb.String()
+}
+
+func formatVTTTimestamp(seconds float64) string {
+	millis := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", millis/3600000, (millis%3600000)/60000, (millis%60000)/1000, millis%1000)
+}
+
+func printPackagePlan(input string, root string, formats []string, rungs []packageRung, dropped []string, hasAudio bool) {
+	ui.PrintInfo(fmt.Sprintf("Kaynak: %s", input))
+	ui.PrintInfo(fmt.Sprintf("Çıktı klasörü: %s (%s)", root, strings.ToUpper(strings.Join(formats, "+"))))
+	for _, r := range rungs {
+		ui.PrintInfo(fmt.Sprintf("  %s: %dx%d, video %s (maks %s)", r.Name, r.Width, r.Height, r.Bitrate, r.MaxRate))
+	}
+	if len(dropped) > 0 {
+		ui.PrintWarning(fmt.Sprintf("Kaynaktan yüksek basamaklar atlandı: %s", strings.Join(dropped, ", ")))
+	}
+	audio := "yok"
+	if hasAudio {
+		audio = "aac " + packageAudioBR
+	}
+	ui.PrintInfo(fmt.Sprintf("Segment: %ss, ses: %s", formatRetimeNumber(packageSegment), audio))
+}
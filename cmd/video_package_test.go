@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildPackageLadder(t *testing.T) {
+	rungs, dropped, err := buildPackageLadder("1080,720p:3M,hd,480", 1920, 1080)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rungs) != 3 || len(dropped) != 0 {
+		t.Fatalf("expected duplicate 720p to collapse, got %+v", rungs)
+	}
+	if rungs[0].Width != 1920 || rungs[0].Bitrate != "5000k" {
+		t.Fatalf("unexpected 1080p rung: %+v", rungs[0])
+	}
+	if rungs[1].Name != "720p" || rungs[1].Bitrate != "3000k" || rungs[1].MaxRate != "3210k" || rungs[1].BufSize != "4500k" {
+		t.Fatalf("unexpected 720p rung: %+v", rungs[1])
+	}
+	if rungs[2].Width != 852 || rungs[2].Height != 480 {
+		t.Fatalf("expected 480p width from source aspect, got %+v", rungs[2])
+	}
+}
+
+func TestBuildPackageLadderSkipsUpscale(t *testing.T) {
+	rungs, dropped, err := buildPackageLadder("1080,720,480", 1280, 720)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rungs) != 2 || strings.Join(dropped, ",") != "1080p" {
+		t.Fatalf("expected 1080p to be skipped, got %+v dropped=%v", rungs, dropped)
+	}
+
+	rungs, _, err = buildPackageLadder("1080", 640, 360)
+	if err != nil || len(rungs) != 1 || rungs[0].Height != 360 {
+		t.Fatalf("expected single source-sized rung, got %+v %v", rungs, err)
+	}
+
+	for _, bad := range []string{"", "abc", "100", "720:fast"} {
+		if _, _, err := buildPackageLadder(bad, 1920, 1080); err == nil {
+			t.Fatalf("expected error for ladder %q", bad)
+		}
+	}
+}
+
+func TestBuildHLSPackageArgs(t *testing.T) {
+	rungs, _, _ := buildPackageLadder("720,480", 1920, 1080)
+	args := strings.Join(buildHLSPackageArgs("in.mp4", "out", rungs, true, 4, "128k", "fast", false), " ")
+	for _, want := range []string{
+		"[0:v:0]split=2[s0][s1];[s0]scale=1280:720",
+		"-map [v0] -map 0:a:0 -map [v1] -map 0:a:0",
+		"-b:v:1 1400k",
+		"-force_key_frames expr:gte(t,n_forced*4)",
+		"-hls_time 4",
+		"-var_stream_map v:0,a:0,name:720p v:1,a:1,name:480p",
+		"-master_pl_name master.m3u8",
+		filepath.Join("out", "%v", "segment_%05d.ts"),
+	} {
+		if !strings.Contains(args, want) {
+			t.Fatalf("expected %q in args: %s", want, args)
+		}
+	}
+
+	args = strings.Join(buildHLSPackageArgs("in.mp4", "out", rungs, false, 6, "128k", "", false), " ")
+	if strings.Contains(args, "0:a:0") || !strings.Contains(args, "-var_stream_map v:0,name:720p v:1,name:480p") {
+		t.Fatalf("unexpected args without audio: %s", args)
+	}
+}
+
+func TestBuildDASHPackageArgs(t *testing.T) {
+	rungs, _, _ := buildPackageLadder("720,480", 1920, 1080)
+	args := strings.Join(buildDASHPackageArgs("in.mp4", "out", rungs, true, 6, "96k", "", false), " ")
+	for _, want := range []string{
+		"-map [v0] -map [v1] -map 0:a:0",
+		"-adaptation_sets id=0,streams=v id=1,streams=a",
+		"-seg_duration 6",
+		"-b:a 96k",
+		filepath.Join("out", "manifest.mpd"),
+	} {
+		if !strings.Contains(args, want) {
+			t.Fatalf("expected %q in args: %s", want, args)
+		}
+	}
+}
+
+func TestBuildThumbnailsVTT(t *testing.T) {
+	vtt := buildThumbnailsVTT([]string{"thumbnails/thumb_00001.jpg", "thumbnails/thumb_00002.jpg", "thumbnails/thumb_00003.jpg"}, 10, 15.5)
+	want := "WEBVTT\n\n00:00:00.000 --> 00:00:10.000\nthumbnails/thumb_00001.jpg\n\n00:00:10.000 --> 00:00:15.500\nthumbnails/thumb_00002.jpg\n"
+	if vtt != want {
+		t.Fatalf("unexpected vtt:\n%s", vtt)
+	}
+}
@@ -0,0 +1,578 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+const (
+	retimeSpeed     = "speed"
+	retimeReverse   = "reverse"
+	retimeTimelapse = "timelapse"
+	retimeFPS       = "fps"
+
+	fpsModeDrop        = "drop"
+	fpsModeInterpolate = "interpolate"
+
+	minSpeedFactor = 0.1
+	maxSpeedFactor = 100.0
+	// atempo filtresi tek örnekte 0.5-2.0 aralığını güvenle destekler; dışı zincirlenir.
+	atempoMin = 0.5
+	atempoMax = 2.0
+	// reverse tüm kareleri bellekte tutar; bu süreden uzun aralıklarda uyarı verilir.
+	reverseWarnSeconds = 60.0
+)
+
+var (
+	speedValues     retimeFlagValues
+	reverseValues   retimeFlagValues
+	timelapseValues retimeFlagValues
+	fpsValues       retimeFlagValues
+)
+
+// retimeFlagValues speed/reverse/timelapse/fps komutlarının ortak bayraklarını taşır.
+type retimeFlagValues struct {
+	Start        string
+	End          string
+	Duration     string
+	DryRun       bool
+	Preview      bool
+	Factor       float64
+	Every        int
+	FPS          float64
+	FPSMode      string
+	To           string
+	Name         string
+	NameTemplate string
+	OutputFile   string
+	Profile      string
+	Quality      int
+	Conflict     string
+	PreserveMD   bool
+	StripMD      bool
+	VCodec       string
+	ACodec       string
+	CRF          int
+	Bitrate      string
+	EncPreset    string
+	PixFmt       string
+	AudioBR      string
+}
+
+// videoRetimeSpec hız, ters oynatma, timelapse ve kare hızı dönüşümü ayarları.
+type videoRetimeSpec struct {
+	Kind    string
+	Factor  float64
+	Every   int
+	FPS     float64
+	FPSMode string
+}
+
+var videoSpeedCmd = &cobra.Command{
+	Use:   "speed <video-dosyasi>",
+	Short: "Videoyu hızlandırır veya ağır çekime alır (ses perdesi korunur)",
+	Long: `Videonun oynatma hızını --factor ile değiştirir. Ses izi atempo ile perdesi
+korunarak aynı oranda hızlandırılır/yavaşlatılır; atempo sınırlarını aşan
+çarpanlar (ör: 4x, 0.25x) otomatik olarak zincirlenir.
+
+--start/--end/--duration ile sadece bir aralık işlenebilir, --dry-run ile plan görülebilir.
+Ağır çekimde akıcılık için --fps 60 --fps-mode interpolate kullanılabilir.
+
+Örnekler:
+  fileconverter-cli video speed ders.mp4 --factor 2
+  fileconverter-cli video speed klip.mp4 --factor 0.5 --fps 60 --fps-mode interpolate
+  fileconverter-cli video speed kayit.mov --factor 4 --start 00:01:00 --duration 30 --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoRetime(cmd, args[0], retimeSpeed, &speedValues)
+	},
+}
+
+var videoReverseCmd = &cobra.Command{
+	Use:   "reverse <video-dosyasi>",
+	Short: "Videoyu (ve sesini) tersten oynatır",
+	Long: `Videoyu ve ses izini tersine çevirir. FFmpeg reverse filtresi tüm kareleri
+bellekte tuttuğu için uzun videolarda --start/--duration ile aralık seçilmesi önerilir.
+
+Örnekler:
+  fileconverter-cli video reverse klip.mp4
+  fileconverter-cli video reverse klip.mp4 --start 12 --duration 4 --to gif`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoRetime(cmd, args[0], retimeReverse, &reverseValues)
+	},
+}
+
+var videoTimelapseCmd = &cobra.Command{
+	Use:   "timelapse <video-dosyasi>",
+	Short: "Uzun kayıttan her N karede bir kare alarak timelapse üretir",
+	Long: `Her --every karede bir kareyi tutar ve kaynak kare hızında oynatır; böylece
+video N kat hızlanır. Timelapse çıktısında ses izi kaldırılır.
+
+Örnekler:
+  fileconverter-cli video timelapse kayit.mp4 --every 30
+  fileconverter-cli video timelapse kayit.mp4 --every 10 --fps 24 --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoRetime(cmd, args[0], retimeTimelapse, &timelapseValues)
+	},
+}
+
+var videoFPSCmd = &cobra.Command{
+	Use:   "fps <video-dosyasi>",
+	Short: "Videonun kare hızını değiştirir (kare düşürme/çoğaltma veya hareket interpolasyonu)",
+	Long: `Kare hızını --fps değerine dönüştürür. Süre değişmez.
+
+Modlar:
+  - drop: gereken kareleri düşürür veya çoğaltır (hızlı)
+  - interpolate: minterpolate ile hareket telafili ara kareler üretir (yavaş, daha akıcı)
+
+Örnekler:
+  fileconverter-cli video fps klip.mp4 --fps 30
+  fileconverter-cli video fps klip.mp4 --fps 60 --fps-mode interpolate`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVideoRetime(cmd, args[0], retimeFPS, &fpsValues)
+	},
+}
+
+func init() {
+	addRetimeFlags(videoSpeedCmd, &speedValues)
+	videoSpeedCmd.Flags().Float64Var(&speedValues.Factor, "factor", 0, "Hız çarpanı (ör: 2 iki kat hızlı, 0.5 yarı hız)")
+	addRetimeFlags(videoReverseCmd, &reverseValues)
+	addRetimeFlags(videoTimelapseCmd, &timelapseValues)
+	videoTimelapseCmd.Flags().IntVar(&timelapseValues.Every, "every", 0, "Her N karede bir kare tut (N >= 2)")
+	addRetimeFlags(videoFPSCmd, &fpsValues)
+
+	videoCmd.AddCommand(videoSpeedCmd, videoReverseCmd, videoTimelapseCmd, videoFPSCmd)
+}
+
+// addRetimeFlags zaman/kare hızı komutlarının ortak bayraklarını ekler.
+func addRetimeFlags(cmd *cobra.Command, values *retimeFlagValues) {
+	f := cmd.Flags()
+	f.StringVar(&values.Start, "start", "0", "İşlenecek aralığın başlangıcı (örn: 00:01:05)")
+	f.StringVar(&values.End, "end", "", "İşlenecek aralığın bitişi (örn: 00:02:00)")
+	f.StringVar(&values.Duration, "duration", "", "İşlenecek aralığın süresi (örn: 15, 00:00:15)")
+	f.BoolVar(&values.DryRun, "dry-run", false, "Ön izleme/plan modu: işlem yapmadan etkiyi gösterir")
+	f.BoolVar(&values.Preview, "preview", false, "Ön izleme modu (--dry-run ile aynı)")
+	f.Float64Var(&values.FPS, "fps", 0, "Çıktı kare hızı (ör: 24, 30, 60)")
+	f.StringVar(&values.FPSMode, "fps-mode", fpsModeDrop, "Kare hızı dönüşüm modu: drop veya interpolate")
+	f.StringVar(&values.To, "to", "", "Hedef format (varsayılan: kaynak format)")
+	f.StringVarP(&values.Name, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	f.StringVar(&values.NameTemplate, "name-template", "", nameTemplateFlagUsage)
+	f.StringVar(&values.OutputFile, "output-file", "", "Tam çıktı dosya yolu")
+	f.StringVar(&values.Profile, "profile", "", "Hazır profil (ör: social-story, archive-lossless)")
+	f.IntVarP(&values.Quality, "quality", "q", 0, "Kalite seviyesi (1-100)")
+	f.StringVar(&values.Conflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+	f.BoolVar(&values.PreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
+	f.BoolVar(&values.StripMD, "strip-metadata", false, "Metadata bilgisini temizle")
+	addVideoCodecFlags(cmd, &values.VCodec, &values.ACodec, &values.CRF, &values.Bitrate, &values.EncPreset, &values.PixFmt, &values.AudioBR)
+}
+
+func runVideoRetime(cmd *cobra.Command, input string, kind string, values *retimeFlagValues) error {
+	jsonOutput := isJSONOutput()
+	if _, err := os.Stat(input); os.IsNotExist(err) {
+		return fmt.Errorf("dosya bulunamadi: %s", input)
+	}
+	if !converter.IsFFmpegAvailable() {
+		return fmt.Errorf("video %s için ffmpeg gerekli", kind)
+	}
+
+	applyProfileDefault(cmd, "profile", &values.Profile)
+	applyQualityDefault(cmd, "quality", &values.Quality)
+	applyOnConflictDefault(cmd, "on-conflict", &values.Conflict)
+	applyMetadataDefault(cmd, "preserve-metadata", &values.PreserveMD, "strip-metadata", &values.StripMD)
+
+	if p, ok, err := resolveProfile(values.Profile); err != nil {
+		return err
+	} else if ok {
+		if p.Quality != nil && !cmd.Flags().Changed("quality") {
+			values.Quality = *p.Quality
+		}
+		if p.OnConflict != "" && !cmd.Flags().Changed("on-conflict") {
+			values.Conflict = p.OnConflict
+		}
+		applyProfileMetadata(cmd, p, "preserve-metadata", &values.PreserveMD, "strip-metadata", &values.StripMD)
+	}
+
+	metadataMode, err := metadataModeFromFlags(values.PreserveMD, values.StripMD)
+	if err != nil {
+		return err
+	}
+
+	spec, err := buildRetimeSpec(kind, *values)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(values.End) != "" && strings.TrimSpace(values.Duration) != "" {
+		return fmt.Errorf("--end ve --duration birlikte kullanılamaz")
+	}
+	startValue, endValue, durationValue, _, _, err := resolveTrimRange(values.Start, values.End, values.Duration, trimModeClip)
+	if err != nil {
+		return err
+	}
+
+	targetFormat := converter.DetectFormat(input)
+	if strings.TrimSpace(values.To) != "" {
+		targetFormat = converter.NormalizeFormat(values.To)
+	}
+	if !converter.IsVideoFormat(targetFormat) && targetFormat != "gif" {
+		return fmt.Errorf("video %s için video hedef formatı gerekli: %s", kind, targetFormat)
+	}
+
+	videoSpec, err := converter.BuildVideoSpec(values.VCodec, values.CRF, values.Bitrate, values.EncPreset, values.PixFmt, values.ACodec, values.AudioBR)
+	if err != nil {
+		return err
+	}
+	if err := converter.ValidateVideoSpec(videoSpec, targetFormat); err != nil {
+		return err
+	}
+	if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy {
+		return fmt.Errorf("video %s için re-encode gerekir, --video-codec copy kullanılamaz", kind)
+	}
+
+	previewMode := values.DryRun || values.Preview
+	outputPath, err := resolveNamedOutputPath(values.NameTemplate, values.Name, values.OutputFile, input, targetFormat, 0,
+		buildRetimeOutputPath(input, targetFormat, values.Name, values.OutputFile, spec))
+	if err != nil {
+		return err
+	}
+	conflict := converter.NormalizeConflictPolicy(values.Conflict)
+	if conflict == "" {
+		return fmt.Errorf("gecersiz on-conflict politikasi: %s", values.Conflict)
+	}
+	outputPath, skip, err := converter.ResolveOutputPathConflict(outputPath, conflict)
+	if err != nil {
+		return err
+	}
+
+	plan, err := buildVideoTrimPlan(input, outputPath, trimModeClip, startValue, endValue, durationValue, nil, "reencode", values.Quality, metadataMode, conflict, skip, "")
+	if err != nil {
+		return err
+	}
+	plan.Retime = &spec
+
+	if previewMode {
+		if jsonOutput {
+			return printJSON(retimePlanJSON(plan))
+		}
+		printVideoTrimPlan(plan)
+		return nil
+	}
+	if skip {
+		if jsonOutput {
+			return printJSON(map[string]interface{}{
+				"success": true,
+				"skipped": true,
+				"input":   input,
+				"output":  outputPath,
+			})
+		}
+		ui.PrintWarning(fmt.Sprintf("Çıktı dosyası mevcut, atlandı: %s", outputPath))
+		return nil
+	}
+	if err := prepareVideoEncoders(videoSpec, targetFormat, jsonOutput); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return err
+	}
+
+	if !jsonOutput {
+		ui.PrintInfo(fmt.Sprintf("İşlem: %s", describeRetimeSpec(spec)))
+		ui.PrintConversion(input, outputPath)
+		if kind == retimeReverse && plan.ClipHasEnd && plan.ClipEndSec-plan.ClipStartSec > reverseWarnSeconds {
+			ui.PrintWarning("Ters oynatma tüm kareleri bellekte tutar; uzun aralıklarda --start/--duration ile parça seçmeniz önerilir.")
+		}
+	}
+	started := time.Now()
+
+	hasAudio := true
+	if info, err := converter.GetFileInfo(input); err == nil && info.VideoCodec != "" {
+		hasAudio = info.AudioCodec != ""
+	}
+	codecArgs := trimCodecArgs(targetFormat, "reencode", values.Quality, videoSpec)
+	err = runRetimeFFmpeg(input, outputPath, plan, spec, hasAudio, codecArgs, metadataMode, verbose)
+	if err != nil {
+		if jsonOutput {
+			return err
+		}
+		ui.PrintError(err.Error())
+		return err
+	}
+
+	if jsonOutput {
+		result := retimePlanJSON(plan)
+		result["success"] = true
+		result["duration_ms"] = time.Since(started).Milliseconds()
+		return printJSON(result)
+	}
+	ui.PrintSuccess(fmt.Sprintf("Video %s tamamlandı!", retimeKindLabel(kind)))
+	ui.PrintDuration(time.Since(started))
+	return nil
+}
+
+// buildRetimeSpec komut türüne göre bayrakları doğrular ve işlem ayarını üretir.
+func buildRetimeSpec(kind string, values retimeFlagValues) (videoRetimeSpec, error) {
+	spec := videoRetimeSpec{Kind: kind, FPS: values.FPS}
+
+	mode, err := normalizeFPSMode(values.FPSMode)
+	if err != nil {
+		return spec, err
+	}
+	spec.FPSMode = mode
+	if values.FPS < 0 || values.FPS > 240 {
+		return spec, fmt.Errorf("--fps 0-240 aralığında olmalı: %g", values.FPS)
+	}
+
+	switch kind {
+	case retimeSpeed:
+		if values.Factor == 0 {
+			return spec, fmt.Errorf("--factor gerekli (ör: 2 veya 0.5)")
+		}
+		if values.Factor < minSpeedFactor || values.Factor > maxSpeedFactor {
+			return spec, fmt.Errorf("--factor %g-%g aralığında olmalı: %g", minSpeedFactor, maxSpeedFactor, values.Factor)
+		}
+		spec.Factor = values.Factor
+	case retimeTimelapse:
+		if values.Every < 2 {
+			return spec, fmt.Errorf("--every en az 2 olmalı")
+		}
+		spec.Every = values.Every
+	case retimeFPS:
+		if values.FPS <= 0 {
+			return spec, fmt.Errorf("--fps gerekli (ör: 30 veya 60)")
+		}
+	case retimeReverse:
+	default:
+		return spec, fmt.Errorf("bilinmeyen işlem: %s", kind)
+	}
+	return spec, nil
+}
+
+func normalizeFPSMode(mode string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", fpsModeDrop, "dup", "duplicate":
+		return fpsModeDrop, nil
+	case fpsModeInterpolate, "mci", "motion":
+		return fpsModeInterpolate, nil
+	default:
+		return "", fmt.Errorf("gecersiz --fps-mode: %s (drop|interpolate)", mode)
+	}
+}
+
+// retimeVideoFilters işlem ayarından video filtre zincirini üretir.
+func retimeVideoFilters(spec videoRetimeSpec) []string {
+	filters := []string{}
+	switch spec.Kind {
+	case retimeSpeed:
+		filters = append(filters, "setpts=PTS/"+formatRetimeNumber(spec.Factor))
+	case retimeReverse:
+		filters = append(filters, "reverse")
+	case retimeTimelapse:
+		n := strconv.Itoa(spec.Every)
+		filters = append(filters, "framestep="+n, "setpts=PTS/"+n)
+	}
+	if spec.FPS > 0 {
+		fps := formatRetimeNumber(spec.FPS)
+		if spec.FPSMode == fpsModeInterpolate {
+			filters = append(filters, "minterpolate=fps="+fps+":mi_mode=mci:mc_mode=aobmc:vsbmc=1")
+		} else {
+			filters = append(filters, "fps="+fps)
+		}
+	}
+	return filters
+}
+
+// retimeAudioFilters işlem ayarından ses filtre zincirini üretir; keep=false ise ses izi kaldırılır.
+func retimeAudioFilters(spec videoRetimeSpec) (filters []string, keep bool) {
+	switch spec.Kind {
+	case retimeSpeed:
+		return atempoChain(spec.Factor), true
+	case retimeReverse:
+		return []string{"areverse"}, true
+	case retimeTimelapse:
+		return nil, false
+	default:
+		return nil, true
+	}
+}
+
+// atempoChain hız çarpanını atempo'nun desteklediği 0.5-2.0 aralığındaki adımlara böler.
+func atempoChain(factor float64) []string {
+	if factor <= 0 || math.Abs(factor-1) < 1e-9 {
+		return nil
+	}
+	chain := []string{}
+	for factor > atempoMax+1e-9 {
+		chain = append(chain, "atempo="+formatRetimeNumber(atempoMax))
+		factor /= atempoMax
+	}
+	for factor < atempoMin-1e-9 {
+		chain = append(chain, "atempo="+formatRetimeNumber(atempoMin))
+		factor /= atempoMin
+	}
+	if math.Abs(factor-1) > 1e-6 {
+		chain = append(chain, "atempo="+formatRetimeNumber(factor))
+	}
+	return chain
+}
+
+// retimeOutputDuration işlenen aralık süresinden tahmini çıktı süresini hesaplar.
+func retimeOutputDuration(spec videoRetimeSpec, inputSec float64) float64 {
+	switch spec.Kind {
+	case retimeSpeed:
+		return inputSec / spec.Factor
+	case retimeTimelapse:
+		return inputSec / float64(spec.Every)
+	default:
+		return inputSec
+	}
+}
+
+// buildRetimeFFmpegArgs aralık seçimi, filtreler ve codec argümanlarıyla ffmpeg komutunu kurar.
+func buildRetimeFFmpegArgs(input string, output string, startSec float64, endSec float64, spec videoRetimeSpec, hasAudio bool, codecArgs []string, metadataMode string, verbose bool) []string {
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	// Giriş tarafında arama: reverse sadece seçilen aralığı bellekte tutar, zaman damgaları 0'dan başlar.
+	if startSec > 0 {
+		args = append(args, "-ss", formatSecondsForFFmpeg(startSec))
+	}
+	if endSec > startSec {
+		args = append(args, "-t", formatSecondsForFFmpeg(endSec-startSec))
+	}
+	args = append(args, "-i", input, "-map", "0:v:0")
+
+	if vf := retimeVideoFilters(spec); len(vf) > 0 {
+		args = append(args, "-vf", strings.Join(vf, ","))
+	}
+	af, keepAudio := retimeAudioFilters(spec)
+	if keepAudio && hasAudio {
+		args = append(args, "-map", "0:a:0?")
+		if len(af) > 0 {
+			args = append(args, "-af", strings.Join(af, ","))
+		}
+	}
+	args = append(args, codecArgs...)
+	if !keepAudio || !hasAudio {
+		args = append(args, "-an")
+	}
+	args = append(args, converter.MetadataFFmpegArgs(metadataMode)...)
+	args = append(args, "-y", output)
+	return args
+}
+
+func runRetimeFFmpeg(input string, output string, plan videoTrimPlan, spec videoRetimeSpec, hasAudio bool, codecArgs []string, metadataMode string, verbose bool) error {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return fmt.Errorf("ffmpeg bulunamadi")
+	}
+	endSec := 0.0
+	if plan.ClipHasEnd {
+		endSec = plan.ClipEndSec
+	}
+	args := buildRetimeFFmpegArgs(input, output, plan.ClipStartSec, endSec, spec, hasAudio, codecArgs, metadataMode, verbose)
+	return runFFmpegCommand(ffmpegPath, args, fmt.Sprintf("video %s ffmpeg hatasi", spec.Kind))
+}
+
+func retimeKindLabel(kind string) string {
+	switch kind {
+	case retimeSpeed:
+		return "hız değiştirme"
+	case retimeReverse:
+		return "ters çevirme"
+	case retimeTimelapse:
+		return "timelapse"
+	default:
+		return "kare hızı dönüşümü"
+	}
+}
+
+func describeRetimeSpec(spec videoRetimeSpec) string {
+	parts := []string{}
+	switch spec.Kind {
+	case retimeSpeed:
+		parts = append(parts, fmt.Sprintf("hız=%sx", formatRetimeNumber(spec.Factor)))
+	case retimeReverse:
+		parts = append(parts, "ters oynatma")
+	case retimeTimelapse:
+		parts = append(parts, fmt.Sprintf("timelapse=her %d karede bir (%dx, ses yok)", spec.Every, spec.Every))
+	}
+	if spec.FPS > 0 {
+		parts = append(parts, fmt.Sprintf("fps=%s (%s)", formatRetimeNumber(spec.FPS), spec.FPSMode))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func retimePlanJSON(plan videoTrimPlan) map[string]interface{} {
+	result := map[string]interface{}{
+		"input":         plan.Input,
+		"output":        plan.Output,
+		"operation":     plan.Retime.Kind,
+		"start_seconds": plan.ClipStartSec,
+		"metadata_mode": plan.MetadataMode,
+		"on_conflict":   plan.ConflictPolicy,
+		"would_skip":    plan.WouldSkip,
+		"video_filters": retimeVideoFilters(*plan.Retime),
+	}
+	if plan.Retime.Factor > 0 {
+		result["factor"] = plan.Retime.Factor
+	}
+	if plan.Retime.Every > 0 {
+		result["every"] = plan.Retime.Every
+	}
+	if plan.Retime.FPS > 0 {
+		result["fps"] = plan.Retime.FPS
+		result["fps_mode"] = plan.Retime.FPSMode
+	}
+	if plan.ClipHasEnd {
+		result["end_seconds"] = plan.ClipEndSec
+		result["output_duration_seconds"] = retimeOutputDuration(*plan.Retime, plan.ClipEndSec-plan.ClipStartSec)
+	}
+	if af, keep := retimeAudioFilters(*plan.Retime); keep && len(af) > 0 {
+		result["audio_filters"] = af
+	}
+	return result
+}
+
+func buildRetimeOutputPath(input string, targetFormat string, customName string, explicit string, spec videoRetimeSpec) string {
+	if strings.TrimSpace(explicit) != "" {
+		return explicit
+	}
+	base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+	switch spec.Kind {
+	case retimeSpeed:
+		base += "_speed" + formatRetimeNumber(spec.Factor) + "x"
+	case retimeReverse:
+		base += "_reverse"
+	case retimeTimelapse:
+		base += "_timelapse" + strconv.Itoa(spec.Every) + "x"
+	default:
+		base += "_" + formatRetimeNumber(spec.FPS) + "fps"
+	}
+	if strings.TrimSpace(customName) != "" {
+		base = customName
+	}
+	dir := filepath.Dir(input)
+	if strings.TrimSpace(outputDir) != "" {
+		dir = outputDir
+	}
+	return filepath.Join(dir, base+"."+targetFormat)
+}
+
+func formatRetimeNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*10000)/10000, 'f', -1, 64)
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAtempoChain(t *testing.T) {
+	cases := map[float64]string{
+		2:    "atempo=2",
+		1.5:  "atempo=1.5",
+		4:    "atempo=2,atempo=2",
+		3:    "atempo=2,atempo=1.5",
+		0.5:  "atempo=0.5",
+		0.25: "atempo=0.5,atempo=0.5",
+		0.1:  "atempo=0.5,atempo=0.5,atempo=0.5,atempo=0.8",
+		1:    "",
+	}
+	for factor, want := range cases {
+		if got := strings.Join(atempoChain(factor), ","); got != want {
+			t.Fatalf("atempoChain(%g) = %q, want %q", factor, got, want)
+		}
+	}
+}
+
+func TestBuildRetimeSpecValidation(t *testing.T) {
+	if _, err := buildRetimeSpec(retimeSpeed, retimeFlagValues{}); err == nil {
+		t.Fatalf("expected error without --factor")
+	}
+	if _, err := buildRetimeSpec(retimeSpeed, retimeFlagValues{Factor: 150}); err == nil {
+		t.Fatalf("expected error for out of range factor")
+	}
+	if _, err := buildRetimeSpec(retimeTimelapse, retimeFlagValues{Every: 1}); err == nil {
+		t.Fatalf("expected error for --every < 2")
+	}
+	if _, err := buildRetimeSpec(retimeFPS, retimeFlagValues{}); err == nil {
+		t.Fatalf("expected error without --fps")
+	}
+	if _, err := buildRetimeSpec(retimeReverse, retimeFlagValues{FPSMode: "warp"}); err == nil {
+		t.Fatalf("expected error for invalid fps mode")
+	}
+	spec, err := buildRetimeSpec(retimeFPS, retimeFlagValues{FPS: 60, FPSMode: "motion"})
+	if err != nil || spec.FPSMode != fpsModeInterpolate {
+		t.Fatalf("unexpected spec: %+v %v", spec, err)
+	}
+}
+
+func TestRetimeFilters(t *testing.T) {
+	speed := videoRetimeSpec{Kind: retimeSpeed, Factor: 0.5, FPS: 60, FPSMode: fpsModeInterpolate}
+	if got := strings.Join(retimeVideoFilters(speed), ","); got != "setpts=PTS/0.5,minterpolate=fps=60:mi_mode=mci:mc_mode=aobmc:vsbmc=1" {
+		t.Fatalf("unexpected speed filters: %s", got)
+	}
+	lapse := videoRetimeSpec{Kind: retimeTimelapse, Every: 10, FPS: 24, FPSMode: fpsModeDrop}
+	if got := strings.Join(retimeVideoFilters(lapse), ","); got != "framestep=10,setpts=PTS/10,fps=24" {
+		t.Fatalf("unexpected timelapse filters: %s", got)
+	}
+	if _, keep := retimeAudioFilters(lapse); keep {
+		t.Fatalf("timelapse must drop audio")
+	}
+	if af, keep := retimeAudioFilters(videoRetimeSpec{Kind: retimeReverse}); !keep || af[0] != "areverse" {
+		t.Fatalf("reverse must reverse audio, got %v", af)
+	}
+	if got := retimeOutputDuration(videoRetimeSpec{Kind: retimeSpeed, Factor: 2}, 30); got != 15 {
+		t.Fatalf("unexpected speed output duration: %v", got)
+	}
+	if got := retimeOutputDuration(lapse, 600); got != 60 {
+		t.Fatalf("unexpected timelapse output duration: %v", got)
+	}
+}
+
+func TestBuildRetimeFFmpegArgs(t *testing.T) {
+	spec := videoRetimeSpec{Kind: retimeSpeed, Factor: 4}
+	args := strings.Join(buildRetimeFFmpegArgs("in.mp4", "out.mp4", 10, 40, spec, true, []string{"-c:v", "libx264"}, "", false), " ")
+	for _, want := range []string{"-ss 10 -t 30 -i in.mp4", "-map 0:v:0", "-vf setpts=PTS/4", "-map 0:a:0? -af atempo=2,atempo=2", "-c:v libx264"} {
+		if !strings.Contains(args, want) {
+			t.Fatalf("expected %q in args: %s", want, args)
+		}
+	}
+	if strings.Contains(args, "-an") {
+		t.Fatalf("speed with audio must keep audio: %s", args)
+	}
+
+	lapse := videoRetimeSpec{Kind: retimeTimelapse, Every: 30}
+	args = strings.Join(buildRetimeFFmpegArgs("in.mp4", "out.mp4", 0, 0, lapse, true, nil, "", false), " ")
+	if strings.Contains(args, "-ss") || strings.Contains(args, "-t ") || !strings.Contains(args, "-an") || strings.Contains(args, "0:a") {
+		t.Fatalf("unexpected timelapse args: %s", args)
+	}
+}
+
+func TestBuildRetimeOutputPath(t *testing.T) {
+	dir := filepath.Join("videos")
+	cases := map[string]videoRetimeSpec{
+		"klip_speed0.5x.mp4":    {Kind: retimeSpeed, Factor: 0.5},
+		"klip_reverse.mp4":      {Kind: retimeReverse},
+		"klip_timelapse30x.mp4": {Kind: retimeTimelapse, Every: 30},
+		"klip_60fps.mp4":        {Kind: retimeFPS, FPS: 60},
+	}
+	for want, spec := range cases {
+		got := buildRetimeOutputPath(filepath.Join(dir, "klip.mov"), "mp4", "", "", spec)
+		if got != filepath.Join(dir, want) {
+			t.Fatalf("unexpected output path: %s, want %s", got, want)
+		}
+	}
+}
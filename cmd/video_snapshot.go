@@ -16,11 +16,18 @@ import (
 )
 
 var (
-	snapshotAt       string
-	snapshotTo       string
-	snapshotQuality  int
-	snapshotName     string
-	snapshotConflict string
+	snapshotAt           string
+	snapshotTo           string
+	snapshotQuality      int
+	snapshotName         string
+	snapshotNameTmpl     string
+	snapshotConflict     string
+	snapshotEvery        string
+	snapshotCount        int
+	snapshotScenes       bool
+	snapshotScene        float64
+	snapshotSheet        string
+	snapshotManifestPath string
 )
 
 // Desteklenen snapshot çıktı formatları
@@ -28,19 +35,29 @@ var snapshotOutputFormats = []string{"png", "jpg", "webp", "bmp"}
 
 var snapshotCmd = &cobra.Command{
 	Use:   "snapshot <video-dosyası>",
-	Short: "Videonun belirli bir anından kare yakalar",
-	Long: `Video dosyasından belirli bir zaman noktasında tek kare çıkarır.
+	Short: "Videodan tek kare, kare dizisi veya kontak sayfası çıkarır",
+	Long: `Video dosyasından belirli bir zaman noktasında tek kare ya da birden fazla kare çıkarır.
 
 Zaman belirtme yöntemleri:
   - Saniye: --at 30 veya --at 5.5
   - Zaman formatı: --at 00:01:30
   - Yüzde: --at %50 (videonun ortasından)
 
+Çoklu kare modları (her biri zaman → dosya eşleşmesini içeren JSON manifest üretir):
+  - --every 10s: belirtilen aralıkla kare
+  - --count 12: video boyunca eşit aralıklı N kare
+  - --scenes: sahne değişimlerinde kare (--scene-threshold ile hassasiyet)
+  - --sheet 4x4: zaman damgalı kontak sayfası
+
 Örnekler:
   fileconverter-cli video snapshot video.mp4 --at 10
   fileconverter-cli video snapshot video.mp4 --at 00:01:30 --to jpg
   fileconverter-cli video snapshot video.mp4 --at %50 --name thumbnail
-  fileconverter-cli video snapshot video.mp4 --at 5.5 --to webp --quality 90`,
+  fileconverter-cli video snapshot video.mp4 --at 5.5 --to webp --quality 90
+  fileconverter-cli video snapshot video.mp4 --every 10s --to jpg
+  fileconverter-cli video snapshot video.mp4 --count 12 --manifest kareler.json
+  fileconverter-cli video snapshot video.mp4 --scenes --scene-threshold 0.4
+  fileconverter-cli video snapshot video.mp4 --sheet 4x4 --to jpg`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		input := args[0]
@@ -62,20 +79,28 @@ Zaman belirtme yöntemleri:
 			return fmt.Errorf("desteklenmeyen görsel formatı: %s (desteklenen: %s)", targetFormat, strings.Join(snapshotOutputFormats, ", "))
 		}
 
-		atValue := strings.TrimSpace(snapshotAt)
-		if atValue == "" {
-			return fmt.Errorf("--at flag'i zorunludur (örn: --at 10, --at 00:01:30, --at %%50)")
+		mode, err := resolveSnapshotMode(snapshotAt, snapshotEvery, snapshotCount, snapshotScenes, snapshotSheet)
+		if err != nil {
+			return err
+		}
+		conflict := converter.NormalizeConflictPolicy(snapshotConflict)
+		if conflict == "" {
+			return fmt.Errorf("gecersiz on-conflict politikasi: %s", snapshotConflict)
+		}
+		if mode != snapshotModeSingle {
+			return runSnapshotSeriesCommand(input, targetFormat, mode, conflict)
 		}
 
+		atValue := strings.TrimSpace(snapshotAt)
 		seekSeconds, err := resolveSnapshotTime(atValue, input)
 		if err != nil {
 			return err
 		}
 
-		outputPath := buildSnapshotOutputPath(input, targetFormat, snapshotName, seekSeconds)
-		conflict := converter.NormalizeConflictPolicy(snapshotConflict)
-		if conflict == "" {
-			return fmt.Errorf("gecersiz on-conflict politikasi: %s", snapshotConflict)
+		outputPath, err := resolveNamedOutputPath(snapshotNameTmpl, snapshotName, "", input, targetFormat, snapshotQuality,
+			buildSnapshotOutputPath(input, targetFormat, snapshotName, seekSeconds))
+		if err != nil {
+			return err
 		}
 		outputPath, skip, err := converter.ResolveOutputPathConflict(outputPath, conflict)
 		if err != nil {
@@ -99,18 +124,110 @@ Zaman belirtme yöntemleri:
 			return err
 		}
 
+		if strings.TrimSpace(snapshotManifestPath) != "" {
+			manifest := snapshotManifest{
+				Input:  input,
+				Mode:   snapshotModeSingle,
+				Format: targetFormat,
+				Frames: []snapshotFrame{newSnapshotFrame(1, seekSeconds, outputPath)},
+			}
+			if err := writeSnapshotManifest(snapshotManifestPath, manifest); err != nil {
+				return err
+			}
+		}
+
 		ui.PrintSuccess("Kare yakalama tamamlandı!")
 		ui.PrintDuration(time.Since(started))
 		return nil
 	},
 }
 
+// runSnapshotSeriesCommand every/count/scenes/sheet modlarını CLI'dan çalıştırır.
+func runSnapshotSeriesCommand(input string, targetFormat string, mode string, conflict string) error {
+	req := snapshotRequest{
+		Input:          input,
+		Format:         targetFormat,
+		Quality:        snapshotQuality,
+		Name:           snapshotName,
+		OutputDir:      outputDir,
+		Conflict:       conflict,
+		Mode:           mode,
+		Count:          snapshotCount,
+		SceneThreshold: snapshotScene,
+		Verbose:        verbose,
+	}
+
+	var err error
+	switch mode {
+	case snapshotModeEvery:
+		req.Every, err = parseSnapshotInterval(snapshotEvery)
+	case snapshotModeCount:
+		if snapshotCount < 1 || snapshotCount > maxSnapshotFrames {
+			err = fmt.Errorf("--count 1-%d aralığında olmalı", maxSnapshotFrames)
+		}
+	case snapshotModeScenes:
+		if snapshotScene <= 0 || snapshotScene >= 1 {
+			err = fmt.Errorf("--scene-threshold 0 ile 1 arasında olmalı")
+		}
+	case snapshotModeSheet:
+		req.SheetCols, req.SheetRows, err = parseSnapshotSheet(snapshotSheet)
+	}
+	if err != nil {
+		return err
+	}
+
+	jsonOutput := isJSONOutput()
+	manifestPath := strings.TrimSpace(snapshotManifestPath)
+	if manifestPath == "" {
+		manifestPath = buildSnapshotManifestPath(req)
+	}
+
+	if !jsonOutput {
+		ui.PrintInfo(fmt.Sprintf("Snapshot modu: %s", mode))
+	}
+	started := time.Now()
+	manifest, err := runSnapshotSeries(req)
+	if err != nil {
+		ui.PrintError(err.Error())
+		return err
+	}
+	if err := writeSnapshotManifest(manifestPath, manifest); err != nil {
+		ui.PrintError(err.Error())
+		return err
+	}
+
+	if jsonOutput {
+		return printJSON(map[string]interface{}{
+			"status":      "success",
+			"manifest":    manifestPath,
+			"mode":        manifest.Mode,
+			"frames":      manifest.Frames,
+			"duration_ms": time.Since(started).Milliseconds(),
+		})
+	}
+	if len(manifest.Frames) == 0 {
+		ui.PrintWarning("Hiç kare üretilmedi.")
+	} else {
+		ui.PrintSuccess(fmt.Sprintf("%d kare yakalandı.", len(manifest.Frames)))
+	}
+	ui.PrintInfo(fmt.Sprintf("Manifest: %s", manifestPath))
+	ui.PrintDuration(time.Since(started))
+	return nil
+}
+
 func init() {
 	snapshotCmd.Flags().StringVar(&snapshotAt, "at", "", "Zaman noktası (saniye, HH:MM:SS veya %yüzde)")
 	snapshotCmd.Flags().StringVarP(&snapshotTo, "to", "t", "png", "Çıktı görsel formatı (png, jpg, webp, bmp)")
 	snapshotCmd.Flags().IntVarP(&snapshotQuality, "quality", "q", 0, "Görsel kalitesi (1-100)")
 	snapshotCmd.Flags().StringVarP(&snapshotName, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	addNameTemplateFlag(snapshotCmd, &snapshotNameTmpl)
 	snapshotCmd.Flags().StringVar(&snapshotConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+	snapshotCmd.Flags().StringVar(&snapshotEvery, "every", "", "Belirtilen aralıkla kare çıkar (ör: 10s, 1m, 00:00:30)")
+	snapshotCmd.Flags().IntVar(&snapshotCount, "count", 0, "Video boyunca eşit aralıklı N kare çıkar")
+	snapshotCmd.Flags().BoolVar(&snapshotScenes, "scenes", false, "Sahne değişimlerinde kare çıkar")
+	snapshotCmd.Flags().Float64Var(&snapshotScene, "scene-threshold", defaultSnapshotThreshold, "Sahne değişimi eşiği (0-1, düşük değer daha fazla kare)")
+	snapshotCmd.Flags().StringVar(&snapshotSheet, "sheet", "", "Zaman damgalı kontak sayfası düzeni (ör: 4x4, 5x3)")
+	snapshotCmd.Flags().StringVar(&snapshotManifestPath, "manifest", "", "JSON manifest dosya yolu (varsayılan: çıktı klasöründe <ad>_manifest.json)")
 
 	videoCmd.AddCommand(snapshotCmd)
 }
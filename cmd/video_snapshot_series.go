@@ -0,0 +1,417 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// Snapshot modları
+const (
+	snapshotModeSingle = "single"
+	snapshotModeEvery  = "every"
+	snapshotModeCount  = "count"
+	snapshotModeScenes = "scenes"
+	snapshotModeSheet  = "sheet"
+)
+
+const (
+	// maxSnapshotFrames tek çalıştırmada üretilebilecek en fazla kare sayısı.
+	maxSnapshotFrames        = 1000
+	maxSnapshotSheetSide     = 10
+	snapshotSheetTileWidth   = 320
+	defaultSnapshotThreshold = 0.3
+)
+
+var showinfoPTSPattern = regexp.MustCompile(`pts_time:\s*([0-9]+(?:\.[0-9]+)?)`)
+
+// snapshotRequest çoklu kare modlarının girdi parametrelerini taşır.
+type snapshotRequest struct {
+	Input          string
+	Format         string
+	Quality        int
+	Name           string
+	OutputDir      string
+	Conflict       string
+	Mode           string
+	Every          float64
+	Count          int
+	SceneThreshold float64
+	SheetCols      int
+	SheetRows      int
+	Verbose        bool
+}
+
+// snapshotFrame manifest içindeki tek bir kareyi temsil eder.
+type snapshotFrame struct {
+	Index     int     `json:"index"`
+	TimeSec   float64 `json:"time_sec"`
+	Timestamp string  `json:"timestamp"`
+	File      string  `json:"file"`
+}
+
+// snapshotManifest üretilen karelerin zaman → dosya eşleşmesini tutar.
+type snapshotManifest struct {
+	Input          string          `json:"input"`
+	Mode           string          `json:"mode"`
+	Format         string          `json:"format"`
+	Sheet          string          `json:"sheet,omitempty"`
+	SceneThreshold float64         `json:"scene_threshold,omitempty"`
+	Frames         []snapshotFrame `json:"frames"`
+}
+
+// resolveSnapshotMode verilen flag'lerden tek bir snapshot modu seçer.
+func resolveSnapshotMode(at string, every string, count int, scenes bool, sheet string) (string, error) {
+	modes := make([]string, 0, 1)
+	if strings.TrimSpace(at) != "" {
+		modes = append(modes, snapshotModeSingle)
+	}
+	if strings.TrimSpace(every) != "" {
+		modes = append(modes, snapshotModeEvery)
+	}
+	if count != 0 {
+		modes = append(modes, snapshotModeCount)
+	}
+	if scenes {
+		modes = append(modes, snapshotModeScenes)
+	}
+	if strings.TrimSpace(sheet) != "" {
+		modes = append(modes, snapshotModeSheet)
+	}
+
+	switch len(modes) {
+	case 0:
+		return "", fmt.Errorf("--at, --every, --count, --scenes veya --sheet flag'lerinden biri gerekli (örn: --at 10, --every 10s, --sheet 4x4)")
+	case 1:
+		return modes[0], nil
+	default:
+		return "", fmt.Errorf("--at, --every, --count, --scenes ve --sheet birlikte kullanılamaz")
+	}
+}
+
+// parseSnapshotInterval "10s", "1m30s", "15" veya "00:00:10" biçimindeki aralığı saniyeye çevirir.
+func parseSnapshotInterval(raw string) (float64, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if value == "" {
+		return 0, fmt.Errorf("aralık değeri boş olamaz")
+	}
+
+	var seconds float64
+	if strings.ContainsAny(value, "hms") {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("geçersiz aralık: %s", raw)
+		}
+		seconds = d.Seconds()
+	} else {
+		parsed, err := parseVideoTrimToSeconds(strings.ReplaceAll(value, ",", "."))
+		if err != nil {
+			return 0, fmt.Errorf("geçersiz aralık: %s", raw)
+		}
+		seconds = parsed
+	}
+	if seconds <= 0 {
+		return 0, fmt.Errorf("aralık sıfırdan büyük olmalı")
+	}
+	return seconds, nil
+}
+
+// parseSnapshotSheet "4x4" biçimindeki kontak sayfası düzenini sütun/satır olarak döner.
+func parseSnapshotSheet(raw string) (int, int, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	parts := strings.Split(value, "x")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("geçersiz sayfa düzeni: %s (örn: 4x4)", raw)
+	}
+	cols, errCols := strconv.Atoi(strings.TrimSpace(parts[0]))
+	rows, errRows := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if errCols != nil || errRows != nil {
+		return 0, 0, fmt.Errorf("geçersiz sayfa düzeni: %s (örn: 4x4)", raw)
+	}
+	if cols < 1 || rows < 1 || cols > maxSnapshotSheetSide || rows > maxSnapshotSheetSide {
+		return 0, 0, fmt.Errorf("sayfa düzeni 1x1 ile %dx%d arasında olmalı", maxSnapshotSheetSide, maxSnapshotSheetSide)
+	}
+	return cols, rows, nil
+}
+
+// snapshotEveryTimes süre boyunca her aralıkta bir zaman noktası üretir.
+func snapshotEveryTimes(duration float64, every float64) ([]float64, error) {
+	if duration <= 0 || every <= 0 {
+		return nil, fmt.Errorf("süre ve aralık sıfırdan büyük olmalı")
+	}
+	count := int(math.Ceil(duration / every))
+	if count > maxSnapshotFrames {
+		return nil, fmt.Errorf("aralık çok küçük: %d kare üretilecekti (en fazla %d)", count, maxSnapshotFrames)
+	}
+	times := make([]float64, 0, count)
+	for i := 0; i < count; i++ {
+		t := roundSnapshotTime(float64(i) * every)
+		if t >= duration {
+			break
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+// snapshotCountTimes süreyi n eşit parçaya bölüp her parçanın ortasını seçer.
+func snapshotCountTimes(duration float64, count int) ([]float64, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("video süresi sıfırdan büyük olmalı")
+	}
+	if count < 1 || count > maxSnapshotFrames {
+		return nil, fmt.Errorf("kare sayısı 1-%d aralığında olmalı", maxSnapshotFrames)
+	}
+	times := make([]float64, count)
+	for i := range times {
+		times[i] = roundSnapshotTime(duration * (float64(i) + 0.5) / float64(count))
+	}
+	return times, nil
+}
+
+func roundSnapshotTime(value float64) float64 {
+	return math.Round(value*1000) / 1000
+}
+
+// parseSceneTimes showinfo filtresinin çıktısından kare zamanlarını okur.
+func parseSceneTimes(output string) []float64 {
+	var times []float64
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.Contains(line, "showinfo") {
+			continue
+		}
+		match := showinfoPTSPattern.FindStringSubmatch(line)
+		if len(match) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseFloat(match[1], 64); err == nil {
+			times = append(times, roundSnapshotTime(v))
+		}
+	}
+	return times
+}
+
+// snapshotBaseName çıktı dosyaları için kök adı ve klasörü belirler.
+func snapshotBaseName(req snapshotRequest) (string, string) {
+	base := strings.TrimSuffix(filepath.Base(req.Input), filepath.Ext(req.Input))
+	if strings.TrimSpace(req.Name) != "" {
+		base = req.Name
+	} else if req.Mode == snapshotModeSheet {
+		base += "_sheet"
+	} else {
+		base += "_snapshot"
+	}
+	dir := filepath.Dir(req.Input)
+	if strings.TrimSpace(req.OutputDir) != "" {
+		dir = req.OutputDir
+	}
+	return dir, base
+}
+
+// buildSnapshotSeriesPath çoklu kare modunda index'e göre çıktı yolunu üretir.
+func buildSnapshotSeriesPath(req snapshotRequest, index int) string {
+	dir, base := snapshotBaseName(req)
+	return filepath.Join(dir, fmt.Sprintf("%s_%03d.%s", base, index, req.Format))
+}
+
+// buildSnapshotManifestPath manifest dosyasının varsayılan yolunu üretir.
+func buildSnapshotManifestPath(req snapshotRequest) string {
+	dir, base := snapshotBaseName(req)
+	return filepath.Join(dir, base+"_manifest.json")
+}
+
+// snapshotSheetTileFilter kontak sayfası karesi için ölçek ve zaman damgası filtresini üretir.
+func snapshotSheetTileFilter(seconds float64) string {
+	stamp := strings.ReplaceAll(formatTrimSecondsHuman(seconds), ":", `\:`)
+	return fmt.Sprintf(
+		"scale=%d:-2,drawtext=text='%s':x=8:y=h-th-8:fontsize=18:fontcolor=white:box=1:boxcolor=black@0.6:boxborderw=4",
+		snapshotSheetTileWidth, stamp,
+	)
+}
+
+// runSnapshotSeries every/count/scenes/sheet modlarını çalıştırıp manifest döner.
+func runSnapshotSeries(req snapshotRequest) (snapshotManifest, error) {
+	manifest := snapshotManifest{Input: req.Input, Mode: req.Mode, Format: req.Format, Frames: []snapshotFrame{}}
+	dir, _ := snapshotBaseName(req)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return manifest, err
+	}
+
+	switch req.Mode {
+	case snapshotModeEvery, snapshotModeCount:
+		duration, ok := probeMediaDurationSeconds(req.Input)
+		if !ok {
+			return manifest, fmt.Errorf("video süresi alınamadı (ffprobe gerekli)")
+		}
+		var times []float64
+		var err error
+		if req.Mode == snapshotModeEvery {
+			times, err = snapshotEveryTimes(duration, req.Every)
+		} else {
+			times, err = snapshotCountTimes(duration, req.Count)
+		}
+		if err != nil {
+			return manifest, err
+		}
+		for i, t := range times {
+			output, skip, err := converter.ResolveOutputPathConflict(buildSnapshotSeriesPath(req, i+1), req.Conflict)
+			if err != nil {
+				return manifest, err
+			}
+			if skip {
+				continue
+			}
+			if err := runSnapshotFFmpeg(req.Input, output, t, req.Format, req.Quality, req.Verbose); err != nil {
+				return manifest, err
+			}
+			manifest.Frames = append(manifest.Frames, newSnapshotFrame(i+1, t, output))
+		}
+		return manifest, nil
+
+	case snapshotModeScenes:
+		manifest.SceneThreshold = req.SceneThreshold
+		return runSnapshotScenes(req, manifest)
+
+	case snapshotModeSheet:
+		manifest.Sheet = fmt.Sprintf("%dx%d", req.SheetCols, req.SheetRows)
+		return runSnapshotSheet(req, manifest)
+
+	default:
+		return manifest, fmt.Errorf("desteklenmeyen snapshot modu: %s", req.Mode)
+	}
+}
+
+func newSnapshotFrame(index int, seconds float64, file string) snapshotFrame {
+	return snapshotFrame{Index: index, TimeSec: seconds, Timestamp: formatTrimSecondsHuman(seconds), File: file}
+}
+
+// runSnapshotScenes sahne değişimi filtresiyle kareleri geçici klasöre çıkarıp
+// zaman bilgisiyle birlikte hedef isimlere taşır.
+func runSnapshotScenes(req snapshotRequest, manifest snapshotManifest) (snapshotManifest, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return manifest, fmt.Errorf("ffmpeg bulunamadi")
+	}
+	dir, _ := snapshotBaseName(req)
+	tempDir, err := os.MkdirTemp(dir, ".snapshot-scenes-*")
+	if err != nil {
+		return manifest, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	args := []string{"-hide_banner", "-i", req.Input}
+	args = append(args, "-vf", fmt.Sprintf("select='gt(scene,%s)',showinfo", strconv.FormatFloat(req.SceneThreshold, 'f', -1, 64)))
+	args = append(args, "-vsync", "vfr")
+	args = append(args, snapshotCodecArgs(req.Format, req.Quality)...)
+	args = append(args, filepath.Join(tempDir, "scene_%04d."+req.Format))
+	out, err := exec.Command(ffmpegPath, args...).CombinedOutput()
+	if err != nil {
+		return manifest, fmt.Errorf("sahne tespiti ffmpeg hatasi: %s\n%s", err.Error(), string(out))
+	}
+
+	times := parseSceneTimes(string(out))
+	for i, t := range times {
+		if i >= maxSnapshotFrames {
+			break
+		}
+		tempFile := filepath.Join(tempDir, fmt.Sprintf("scene_%04d.%s", i+1, req.Format))
+		if !hasContent(tempFile) {
+			continue
+		}
+		output, skip, err := converter.ResolveOutputPathConflict(buildSnapshotSeriesPath(req, i+1), req.Conflict)
+		if err != nil {
+			return manifest, err
+		}
+		if skip {
+			continue
+		}
+		if err := os.Rename(tempFile, output); err != nil {
+			return manifest, err
+		}
+		manifest.Frames = append(manifest.Frames, newSnapshotFrame(i+1, t, output))
+	}
+	return manifest, nil
+}
+
+// runSnapshotSheet eşit aralıklı kareleri zaman damgasıyla çıkarıp tek bir kontak sayfasında birleştirir.
+func runSnapshotSheet(req snapshotRequest, manifest snapshotManifest) (snapshotManifest, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return manifest, fmt.Errorf("ffmpeg bulunamadi")
+	}
+	duration, ok := probeMediaDurationSeconds(req.Input)
+	if !ok {
+		return manifest, fmt.Errorf("video süresi alınamadı (ffprobe gerekli)")
+	}
+	times, err := snapshotCountTimes(duration, req.SheetCols*req.SheetRows)
+	if err != nil {
+		return manifest, err
+	}
+
+	dir, base := snapshotBaseName(req)
+	output, skip, err := converter.ResolveOutputPathConflict(filepath.Join(dir, base+"."+req.Format), req.Conflict)
+	if err != nil {
+		return manifest, err
+	}
+	if skip {
+		return manifest, nil
+	}
+
+	tempDir, err := os.MkdirTemp("", "fileconverter-sheet-*")
+	if err != nil {
+		return manifest, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	for i, t := range times {
+		args := []string{}
+		if !req.Verbose {
+			args = append(args, "-loglevel", "error")
+		}
+		args = append(args, "-ss", formatSecondsForFFmpeg(t), "-i", req.Input, "-vframes", "1")
+		args = append(args, "-vf", snapshotSheetTileFilter(t))
+		args = append(args, "-y", filepath.Join(tempDir, fmt.Sprintf("tile_%03d.png", i+1)))
+		if err := runFFmpegCommand(ffmpegPath, args, "kontak sayfası ffmpeg hatasi"); err != nil {
+			return manifest, err
+		}
+	}
+
+	args := []string{}
+	if !req.Verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	args = append(args, "-i", filepath.Join(tempDir, "tile_%03d.png"))
+	args = append(args, "-vf", fmt.Sprintf("tile=%dx%d:padding=4:margin=4", req.SheetCols, req.SheetRows))
+	args = append(args, "-frames:v", "1")
+	args = append(args, snapshotCodecArgs(req.Format, req.Quality)...)
+	args = append(args, "-y", output)
+	if err := runFFmpegCommand(ffmpegPath, args, "kontak sayfası ffmpeg hatasi"); err != nil {
+		return manifest, err
+	}
+
+	for i, t := range times {
+		manifest.Frames = append(manifest.Frames, newSnapshotFrame(i+1, t, output))
+	}
+	return manifest, nil
+}
+
+// writeSnapshotManifest manifest'i JSON olarak diske yazar.
+func writeSnapshotManifest(path string, manifest snapshotManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
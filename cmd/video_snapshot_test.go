@@ -1,6 +1,9 @@
 package cmd
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestBuildSnapshotOutputPath(t *testing.T) {
 	outputDir = ""
@@ -91,3 +94,94 @@ func TestResolveSnapshotTimeInvalid(t *testing.T) {
 		t.Fatalf("expected error for percentage > 100")
 	}
 }
+
+func TestResolveSnapshotMode(t *testing.T) {
+	mode, err := resolveSnapshotMode("", "10s", 0, false, "")
+	if err != nil || mode != snapshotModeEvery {
+		t.Fatalf("expected every mode, got %q err=%v", mode, err)
+	}
+	mode, err = resolveSnapshotMode("", "", 0, false, "4x4")
+	if err != nil || mode != snapshotModeSheet {
+		t.Fatalf("expected sheet mode, got %q err=%v", mode, err)
+	}
+	if _, err := resolveSnapshotMode("", "", 0, false, ""); err == nil {
+		t.Fatalf("expected error without any mode")
+	}
+	if _, err := resolveSnapshotMode("10", "", 5, false, ""); err == nil {
+		t.Fatalf("expected error for multiple modes")
+	}
+}
+
+func TestParseSnapshotIntervalAndSheet(t *testing.T) {
+	for raw, want := range map[string]float64{"10s": 10, "1m30s": 90, "15": 15, "00:00:20": 20, "2,5": 2.5} {
+		got, err := parseSnapshotInterval(raw)
+		if err != nil || got != want {
+			t.Fatalf("parseSnapshotInterval(%q) = %v, %v; want %v", raw, got, err, want)
+		}
+	}
+	if _, err := parseSnapshotInterval("0s"); err == nil {
+		t.Fatalf("expected error for zero interval")
+	}
+
+	cols, rows, err := parseSnapshotSheet("5x3")
+	if err != nil || cols != 5 || rows != 3 {
+		t.Fatalf("unexpected sheet layout: %dx%d err=%v", cols, rows, err)
+	}
+	for _, raw := range []string{"4", "0x4", "11x2", "axb"} {
+		if _, _, err := parseSnapshotSheet(raw); err == nil {
+			t.Fatalf("expected error for sheet %q", raw)
+		}
+	}
+}
+
+func TestSnapshotTimes(t *testing.T) {
+	every, err := snapshotEveryTimes(35, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(every) != 4 || every[0] != 0 || every[3] != 30 {
+		t.Fatalf("unexpected every times: %v", every)
+	}
+	if _, err := snapshotEveryTimes(3600, 0.5); err == nil {
+		t.Fatalf("expected error when too many frames would be produced")
+	}
+
+	count, err := snapshotCountTimes(40, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(count) != 4 || count[0] != 5 || count[3] != 35 {
+		t.Fatalf("unexpected count times: %v", count)
+	}
+}
+
+func TestParseSceneTimes(t *testing.T) {
+	output := `[Parsed_showinfo_1 @ 0x7f] n:   0 pts:  12288 pts_time:0.48    duration:512
+[Parsed_showinfo_1 @ 0x7f] n:   1 pts: 307200 pts_time:12.0123 duration:512
+frame=    2 fps=0.0 q=-0.0 Lsize=N/A time=00:00:12.01`
+	got := parseSceneTimes(output)
+	if len(got) != 2 || got[0] != 0.48 || got[1] != 12.012 {
+		t.Fatalf("unexpected scene times: %v", got)
+	}
+}
+
+func TestSnapshotSeriesPaths(t *testing.T) {
+	req := snapshotRequest{Input: "/tmp/video.mp4", Format: "jpg", Mode: snapshotModeCount}
+	if got := buildSnapshotSeriesPath(req, 3); got != "/tmp/video_snapshot_003.jpg" {
+		t.Fatalf("unexpected series path: %s", got)
+	}
+	if got := buildSnapshotManifestPath(req); got != "/tmp/video_snapshot_manifest.json" {
+		t.Fatalf("unexpected manifest path: %s", got)
+	}
+
+	req.Mode = snapshotModeSheet
+	req.OutputDir = "/out"
+	if got := buildSnapshotManifestPath(req); got != "/out/video_sheet_manifest.json" {
+		t.Fatalf("unexpected sheet manifest path: %s", got)
+	}
+
+	filter := snapshotSheetTileFilter(65)
+	if !strings.Contains(filter, `text='00\:01\:05'`) {
+		t.Fatalf("expected escaped timestamp in drawtext filter, got %s", filter)
+	}
+}
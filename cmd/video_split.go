@@ -0,0 +1,622 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+const (
+	splitModeEvery    = "every"
+	splitModeParts    = "parts"
+	splitModeSize     = "max-size"
+	splitModeChapters = "chapters"
+
+	defaultSplitTemplate = "{name}_part{index:03}"
+	// splitSizeMargin copy ile bölmede anahtar kare kaymasına karşı hedef boyuttan bırakılan pay.
+	splitSizeMargin = 0.95
+	maxSplitParts   = 999
+)
+
+var splitPlaceholder = regexp.MustCompile(`\{(name|index|start|end|title)(?::(\d+))?\}`)
+
+var (
+	splitEvery       string
+	splitParts       int
+	splitMaxSize     string
+	splitByChapters  bool
+	splitCodec       string
+	splitTolerance   float64
+	splitTemplate    string
+	splitManifestOut string
+	splitDryRun      bool
+	splitTo          string
+	splitQuality     int
+	splitConflict    string
+	splitVCodec      string
+	splitACodec      string
+	splitCRF         int
+	splitBitrate     string
+	splitEncPreset   string
+	splitPixFmt      string
+	splitAudioBR     string
+)
+
+// splitPart bölünmüş videonun tek parçası.
+type splitPart struct {
+	Index    int     `json:"index"`
+	File     string  `json:"file"`
+	Start    float64 `json:"start"`
+	End      float64 `json:"end"`
+	Duration float64 `json:"duration"`
+	Codec    string  `json:"codec"`
+	Title    string  `json:"title,omitempty"`
+	Size     int64   `json:"size,omitempty"`
+	Skipped  bool    `json:"skipped,omitempty"`
+}
+
+// splitManifest bölme işleminin parça listesini ve sürelerini içeren JSON manifest.
+type splitManifest struct {
+	Input    string      `json:"input"`
+	Mode     string      `json:"mode"`
+	Duration float64     `json:"duration"`
+	Parts    []splitPart `json:"parts"`
+}
+
+var videoSplitCmd = &cobra.Command{
+	Use:   "split <video-dosyasi>",
+	Short: "Uzun videoyu süreye, parça sayısına, boyuta veya bölümlere göre parçalara ayırır",
+	Long: `Videoyu parçalara ayırır. Bölme kriterlerinden yalnızca biri kullanılabilir:
+  - --every 10m: sabit süreli parçalar
+  - --parts 4: eşit süreli N parça
+  - --max-size 2GB: her parça verilen boyutu aşmayacak şekilde (bitrate'e göre tahmin)
+  - --by-chapters: dosyadaki bölüm işaretlerine göre
+
+Codec modu:
+  - auto (varsayılan): sınır --keyframe-tolerance içinde bir anahtar kareye denk geliyorsa
+    o kareye kaydırılıp kayıpsız kopyalanır; denk gelmeyen parçalar tam sınırda re-encode edilir
+  - copy: tüm sınırlar en yakın anahtar kareye kaydırılır, her şey kopyalanır
+  - reencode: tüm parçalar tam sınırlarda yeniden encode edilir
+
+Dosya adı şablonu yer tutucuları: {name}, {index}, {index:03}, {start}, {end}, {title}
+Her çalıştırma parça dosyalarını ve sürelerini içeren bir JSON manifest yazar.
+
+Örnekler:
+  fileconverter-cli video split kayit.mp4 --every 10m
+  fileconverter-cli video split kayit.mp4 --parts 4 --codec reencode
+  fileconverter-cli video split kayit.mkv --max-size 2GB
+  fileconverter-cli video split kitap.m4v --by-chapters --template "{index:02}_{title}"
+  fileconverter-cli video split kayit.mp4 --every 15m --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input := args[0]
+		jsonOutput := isJSONOutput()
+		if _, err := os.Stat(input); os.IsNotExist(err) {
+			return fmt.Errorf("dosya bulunamadi: %s", input)
+		}
+		if !converter.IsFFmpegAvailable() {
+			return fmt.Errorf("video split için ffmpeg gerekli")
+		}
+
+		applyQualityDefault(cmd, "quality", &splitQuality)
+		applyOnConflictDefault(cmd, "on-conflict", &splitConflict)
+
+		mode, err := resolveSplitMode(splitEvery, splitParts, splitMaxSize, splitByChapters)
+		if err != nil {
+			return err
+		}
+		codecMode := normalizeTrimCodec(splitCodec)
+		if codecMode == "" {
+			return fmt.Errorf("gecersiz codec modu: %s (auto|copy|reencode)", splitCodec)
+		}
+		if splitTolerance < 0 {
+			return fmt.Errorf("--keyframe-tolerance negatif olamaz")
+		}
+		if err := validateSplitTemplate(splitTemplate); err != nil {
+			return err
+		}
+		conflict := converter.NormalizeConflictPolicy(splitConflict)
+		if conflict == "" {
+			return fmt.Errorf("gecersiz on-conflict politikasi: %s", splitConflict)
+		}
+
+		targetFormat := converter.DetectFormat(input)
+		if strings.TrimSpace(splitTo) != "" {
+			targetFormat = converter.NormalizeFormat(splitTo)
+		}
+		if !converter.IsVideoFormat(targetFormat) {
+			return fmt.Errorf("video split için video hedef formatı gerekli: %s", targetFormat)
+		}
+		if targetFormat != converter.DetectFormat(input) {
+			if codecMode == "copy" {
+				return fmt.Errorf("--codec copy yalnızca aynı formatta kullanılabilir; --codec reencode seçin")
+			}
+			codecMode = "reencode"
+		}
+		videoSpec, err := converter.BuildVideoSpec(splitVCodec, splitCRF, splitBitrate, splitEncPreset, splitPixFmt, splitACodec, splitAudioBR)
+		if err != nil {
+			return err
+		}
+		if err := converter.ValidateVideoSpec(videoSpec, targetFormat); err != nil {
+			return err
+		}
+		if videoSpec != nil {
+			if codecMode == "copy" || videoSpec.Codec == converter.VideoCodecCopy {
+				return fmt.Errorf("--codec copy ile --video-codec/--crf/--bitrate gibi encode ayarları birlikte kullanılamaz")
+			}
+			codecMode = "reencode"
+		}
+
+		duration, ok := probeMediaDurationSeconds(input)
+		if !ok {
+			return fmt.Errorf("video süresi alınamadı (ffprobe gerekli)")
+		}
+		parts, err := planSplitParts(input, mode, duration)
+		if err != nil {
+			return err
+		}
+		if codecMode != "reencode" {
+			keyframes, err := probeKeyframeTimes(input)
+			if err != nil && codecMode == "copy" {
+				return err
+			}
+			parts = alignSplitBoundaries(parts, keyframes, splitTolerance, codecMode, duration)
+		} else {
+			parts = alignSplitBoundaries(parts, nil, 0, codecMode, duration)
+		}
+
+		base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+		dir := filepath.Dir(input)
+		if strings.TrimSpace(outputDir) != "" {
+			dir = outputDir
+		}
+		reserved := map[string]bool{}
+		for i := range parts {
+			name := renderSplitName(splitTemplate, base, parts[i])
+			out := filepath.Join(dir, name+"."+targetFormat)
+			if reserved[out] {
+				return fmt.Errorf("şablon aynı dosya adını birden fazla parça için üretiyor: %s ({index} kullanın)", out)
+			}
+			reserved[out] = true
+			resolved, skip, err := converter.ResolveOutputPathConflict(out, conflict)
+			if err != nil {
+				return err
+			}
+			parts[i].File = resolved
+			parts[i].Skipped = skip
+		}
+		manifestPath := strings.TrimSpace(splitManifestOut)
+		if manifestPath == "" {
+			manifestPath = filepath.Join(dir, base+"_split.json")
+		}
+		manifest := splitManifest{Input: input, Mode: mode, Duration: duration, Parts: parts}
+
+		if splitDryRun {
+			if jsonOutput {
+				return printJSON(map[string]interface{}{
+					"dry_run":  true,
+					"manifest": manifestPath,
+					"mode":     mode,
+					"duration": duration,
+					"parts":    parts,
+				})
+			}
+			ui.PrintInfo("Ön izleme modu (--dry-run) — işlem yapılmayacak.")
+			printSplitPlan(manifest)
+			return nil
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		if codecMode != "copy" {
+			if err := prepareVideoEncoders(videoSpec, targetFormat, jsonOutput); err != nil {
+				return err
+			}
+		}
+		if !jsonOutput {
+			printSplitPlan(manifest)
+		}
+		started := time.Now()
+		ffmpegPath, err := exec.LookPath("ffmpeg")
+		if err != nil {
+			return fmt.Errorf("ffmpeg bulunamadi")
+		}
+		codecArgs := trimCodecArgs(targetFormat, "reencode", splitQuality, videoSpec)
+		for i := range manifest.Parts {
+			part := &manifest.Parts[i]
+			if part.Skipped {
+				continue
+			}
+			args := buildSplitPartArgs(input, *part, codecArgs, verbose)
+			if err := runFFmpegCommand(ffmpegPath, args, fmt.Sprintf("parça %d üretilemedi", part.Index)); err != nil {
+				if jsonOutput {
+					return err
+				}
+				ui.PrintError(err.Error())
+				return err
+			}
+			if st, err := os.Stat(part.File); err == nil {
+				part.Size = st.Size()
+			}
+			if !jsonOutput {
+				ui.PrintSuccess(fmt.Sprintf("Parça %d: %s", part.Index, part.File))
+			}
+		}
+		if err := writeSplitManifest(manifestPath, manifest); err != nil {
+			return err
+		}
+
+		oversized := []string{}
+		if mode == splitModeSize {
+			maxBytes, _ := converter.ParseSize(splitMaxSize)
+			for _, p := range manifest.Parts {
+				if p.Size > maxBytes {
+					oversized = append(oversized, filepath.Base(p.File))
+				}
+			}
+		}
+
+		if jsonOutput {
+			return printJSON(map[string]interface{}{
+				"success":     true,
+				"manifest":    manifestPath,
+				"mode":        mode,
+				"parts":       manifest.Parts,
+				"oversized":   oversized,
+				"duration_ms": time.Since(started).Milliseconds(),
+			})
+		}
+		if len(oversized) > 0 {
+			ui.PrintWarning(fmt.Sprintf("Hedef boyutu aşan parçalar (bitrate değişken): %s", strings.Join(oversized, ", ")))
+		}
+		ui.PrintInfo(fmt.Sprintf("Manifest: %s", manifestPath))
+		ui.PrintDuration(time.Since(started))
+		return nil
+	},
+}
+
+func init() {
+	f := videoSplitCmd.Flags()
+	f.StringVar(&splitEvery, "every", "", "Sabit parça süresi (ör: 10m, 90s, 00:15:00)")
+	f.IntVar(&splitParts, "parts", 0, "Eşit süreli parça sayısı")
+	f.StringVar(&splitMaxSize, "max-size", "", "Parça başına en fazla boyut (ör: 2GB, 700mb)")
+	f.BoolVar(&splitByChapters, "by-chapters", false, "Dosyadaki bölüm işaretlerine göre böl")
+	f.StringVar(&splitCodec, "codec", "auto", "Codec modu: auto, copy veya reencode")
+	f.Float64Var(&splitTolerance, "keyframe-tolerance", 2, "auto modunda sınırın kaydırılabileceği en fazla süre (saniye)")
+	f.StringVar(&splitTemplate, "template", defaultSplitTemplate, "Parça dosya adı şablonu (uzantısız)")
+	f.StringVar(&splitManifestOut, "manifest", "", "JSON manifest yolu (varsayılan: <ad>_split.json)")
+	f.BoolVar(&splitDryRun, "dry-run", false, "Parça planını göster, dosya yazma")
+	f.StringVar(&splitTo, "to", "", "Hedef format (varsayılan: kaynak format; farklıysa re-encode)")
+	f.IntVarP(&splitQuality, "quality", "q", 0, "Re-encode kalite seviyesi (1-100)")
+	f.StringVar(&splitConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+	addVideoCodecFlags(videoSplitCmd, &splitVCodec, &splitACodec, &splitCRF, &splitBitrate, &splitEncPreset, &splitPixFmt, &splitAudioBR)
+
+	videoCmd.AddCommand(videoSplitCmd)
+}
+
+// resolveSplitMode tek bir bölme kriteri verildiğini doğrular ve modu döner.
+func resolveSplitMode(every string, parts int, maxSize string, byChapters bool) (string, error) {
+	modes := []string{}
+	if strings.TrimSpace(every) != "" {
+		modes = append(modes, splitModeEvery)
+	}
+	if parts != 0 {
+		modes = append(modes, splitModeParts)
+	}
+	if strings.TrimSpace(maxSize) != "" {
+		modes = append(modes, splitModeSize)
+	}
+	if byChapters {
+		modes = append(modes, splitModeChapters)
+	}
+	switch len(modes) {
+	case 0:
+		return "", fmt.Errorf("--every, --parts, --max-size veya --by-chapters seçeneklerinden biri gerekli")
+	case 1:
+		return modes[0], nil
+	default:
+		return "", fmt.Errorf("--every, --parts, --max-size ve --by-chapters birlikte kullanılamaz")
+	}
+}
+
+// planSplitParts seçilen moda göre ham parça aralıklarını üretir.
+func planSplitParts(input string, mode string, duration float64) ([]splitPart, error) {
+	switch mode {
+	case splitModeEvery:
+		every, err := parseSnapshotInterval(splitEvery)
+		if err != nil {
+			return nil, err
+		}
+		return splitRangesByInterval(duration, every)
+	case splitModeParts:
+		return splitRangesByCount(duration, splitParts)
+	case splitModeSize:
+		maxBytes, err := converter.ParseSize(splitMaxSize)
+		if err != nil {
+			return nil, err
+		}
+		st, err := os.Stat(input)
+		if err != nil {
+			return nil, err
+		}
+		every, err := splitIntervalForSize(st.Size(), duration, maxBytes)
+		if err != nil {
+			return nil, err
+		}
+		return splitRangesByInterval(duration, every)
+	case splitModeChapters:
+		chapters, err := converter.ProbeChapters(input)
+		if err != nil {
+			return nil, err
+		}
+		return splitRangesFromChapters(chapters, duration)
+	default:
+		return nil, fmt.Errorf("desteklenmeyen split modu: %s", mode)
+	}
+}
+
+func splitRangesByInterval(duration float64, every float64) ([]splitPart, error) {
+	if every <= 0 {
+		return nil, fmt.Errorf("parça süresi sıfırdan büyük olmalı")
+	}
+	count := int(math.Ceil(duration/every - 1e-9))
+	if count > maxSplitParts {
+		return nil, fmt.Errorf("çok fazla parça (%d), en fazla %d", count, maxSplitParts)
+	}
+	parts := make([]splitPart, 0, count)
+	for start := 0.0; start < duration-0.001; start += every {
+		end := math.Min(start+every, duration)
+		parts = append(parts, splitPart{Index: len(parts) + 1, Start: start, End: end})
+	}
+	return parts, nil
+}
+
+func splitRangesByCount(duration float64, count int) ([]splitPart, error) {
+	if count < 2 || count > maxSplitParts {
+		return nil, fmt.Errorf("--parts 2-%d aralığında olmalı", maxSplitParts)
+	}
+	return splitRangesByInterval(duration, duration/float64(count))
+}
+
+// splitIntervalForSize ortalama bitrate'e göre verilen boyuta sığacak parça süresini tahmin eder.
+func splitIntervalForSize(fileSize int64, duration float64, maxBytes int64) (float64, error) {
+	if maxBytes <= 0 || duration <= 0 || fileSize <= 0 {
+		return 0, fmt.Errorf("boyuta göre bölme için geçerli dosya boyutu ve süre gerekli")
+	}
+	bytesPerSecond := float64(fileSize) / duration
+	every := float64(maxBytes) * splitSizeMargin / bytesPerSecond
+	if every < 1 {
+		return 0, fmt.Errorf("--max-size çok küçük: bir saniyelik video bile sığmıyor")
+	}
+	return every, nil
+}
+
+func splitRangesFromChapters(chapters []converter.Chapter, duration float64) ([]splitPart, error) {
+	if len(chapters) == 0 {
+		return nil, fmt.Errorf("dosyada bölüm bilgisi bulunamadı")
+	}
+	parts := make([]splitPart, 0, len(chapters))
+	for _, c := range chapters {
+		end := c.End
+		if duration > 0 && end > duration {
+			end = duration
+		}
+		if end <= c.Start {
+			continue
+		}
+		parts = append(parts, splitPart{Index: len(parts) + 1, Start: c.Start, End: end, Title: c.Title})
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("dosyada geçerli bölüm bulunamadı")
+	}
+	return parts, nil
+}
+
+// alignSplitBoundaries iç sınırları anahtar karelere kaydırır ve her parçanın codec modunu belirler.
+// copy modunda tüm sınırlar en yakın anahtar kareye kaydırılır; auto modunda sadece tolerans
+// içindekiler kaydırılır ve iki ucu da hizalı olmayan parçalar re-encode edilir.
+func alignSplitBoundaries(parts []splitPart, keyframes []float64, tolerance float64, codecMode string, duration float64) []splitPart {
+	const epsilon = 0.001
+	aligned := make([]splitPart, 0, len(parts))
+	prevEnd := 0.0
+	prevAligned := true
+	for i, p := range parts {
+		start, startAligned := p.Start, prevAligned
+		if i > 0 {
+			start = prevEnd
+		} else if start > epsilon {
+			start, startAligned = snapToKeyframe(start, keyframes, tolerance, codecMode)
+		}
+		end, endAligned := p.End, true
+		if duration <= 0 || p.End < duration-epsilon {
+			end, endAligned = snapToKeyframe(p.End, keyframes, tolerance, codecMode)
+		}
+		prevEnd, prevAligned = end, endAligned
+		if end <= start+epsilon {
+			continue
+		}
+		p.Start, p.End = start, end
+		p.Duration = math.Round((end-start)*1000) / 1000
+		switch {
+		case codecMode == "copy":
+			p.Codec = "copy"
+		case codecMode == "auto" && startAligned && endAligned:
+			p.Codec = "copy"
+		default:
+			p.Codec = "reencode"
+		}
+		p.Index = len(aligned) + 1
+		aligned = append(aligned, p)
+	}
+	return aligned
+}
+
+// snapToKeyframe sınırı en yakın anahtar kareye kaydırır; auto modunda tolerans dışındaysa olduğu gibi bırakır.
+func snapToKeyframe(t float64, keyframes []float64, tolerance float64, codecMode string) (float64, bool) {
+	if codecMode == "reencode" || len(keyframes) == 0 {
+		return t, false
+	}
+	i := sort.SearchFloat64s(keyframes, t)
+	best := -1.0
+	bestDiff := math.MaxFloat64
+	for _, j := range []int{i - 1, i} {
+		if j >= 0 && j < len(keyframes) {
+			if d := math.Abs(keyframes[j] - t); d < bestDiff {
+				best, bestDiff = keyframes[j], d
+			}
+		}
+	}
+	if best < 0 {
+		return t, false
+	}
+	if codecMode == "copy" || bestDiff <= tolerance {
+		return best, true
+	}
+	return t, false
+}
+
+// probeKeyframeTimes ilk video akışının anahtar kare zamanlarını okur.
+func probeKeyframeTimes(input string) ([]float64, error) {
+	ffprobePath, err := exec.LookPath("ffprobe")
+	if err != nil {
+		return nil, fmt.Errorf("anahtar kare bilgisi için ffprobe gerekli")
+	}
+	cmd := exec.Command(ffprobePath,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-skip_frame", "nokey",
+		"-show_entries", "frame=pts_time",
+		"-of", "csv=p=0",
+		input,
+	)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("anahtar kareler okunamadı: %w", err)
+	}
+	return parseKeyframeTimes(string(out)), nil
+}
+
+// parseKeyframeTimes ffprobe csv çıktısındaki zamanları sıralı listeye çevirir.
+func parseKeyframeTimes(output string) []float64 {
+	times := []float64{}
+	for _, line := range strings.Split(output, "\n") {
+		value := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(line), ","))
+		if value == "" {
+			continue
+		}
+		if t, err := strconv.ParseFloat(value, 64); err == nil && t >= 0 {
+			times = append(times, t)
+		}
+	}
+	sort.Float64s(times)
+	return times
+}
+
+func validateSplitTemplate(template string) error {
+	if strings.TrimSpace(template) == "" {
+		return fmt.Errorf("--template boş olamaz")
+	}
+	if strings.ContainsAny(template, `/\`) {
+		return fmt.Errorf("--template klasör içeremez, çıktı klasörü için -o kullanın")
+	}
+	rest := splitPlaceholder.ReplaceAllString(template, "")
+	if strings.ContainsAny(rest, "{}") {
+		return fmt.Errorf("--template bilinmeyen yer tutucu içeriyor: %s (desteklenen: {name}, {index}, {start}, {end}, {title})", template)
+	}
+	return nil
+}
+
+// renderSplitName şablondaki yer tutucuları parça bilgisiyle doldurur.
+func renderSplitName(template string, name string, part splitPart) string {
+	return splitPlaceholder.ReplaceAllStringFunc(template, func(match string) string {
+		sub := splitPlaceholder.FindStringSubmatch(match)
+		switch sub[1] {
+		case "name":
+			return name
+		case "index":
+			if sub[2] != "" {
+				width, _ := strconv.Atoi(sub[2])
+				return fmt.Sprintf("%0*d", width, part.Index)
+			}
+			return strconv.Itoa(part.Index)
+		case "start":
+			return strings.ReplaceAll(formatTrimSecondsHuman(part.Start), ":", "-")
+		case "end":
+			return strings.ReplaceAll(formatTrimSecondsHuman(part.End), ":", "-")
+		case "title":
+			if part.Title == "" {
+				return fmt.Sprintf("part%d", part.Index)
+			}
+			return sanitizeSplitTitle(part.Title)
+		}
+		return match
+	})
+}
+
+func sanitizeSplitTitle(title string) string {
+	replacer := strings.NewReplacer("/", "_", "\\", "_", ":", "_", "*", "_", "?", "_", "\"", "_", "<", "_", ">", "_", "|", "_")
+	return strings.TrimSpace(replacer.Replace(title))
+}
+
+// buildSplitPartArgs parçayı copy ile veya tam sınırlarda re-encode ederek üreten ffmpeg komutunu kurar.
+func buildSplitPartArgs(input string, part splitPart, codecArgs []string, verbose bool) []string {
+	args := []string{}
+	if !verbose {
+		args = append(args, "-loglevel", "error")
+	}
+	if part.Start > 0 {
+		args = append(args, "-ss", formatSecondsForFFmpeg(part.Start))
+	}
+	args = append(args, "-i", input, "-t", formatSecondsForFFmpeg(part.End-part.Start))
+	if part.Codec == "copy" {
+		args = append(args, "-map", "0", "-c", "copy", "-avoid_negative_ts", "make_zero")
+	} else {
+		args = append(args, "-map", "0:v:0", "-map", "0:a?")
+		args = append(args, codecArgs...)
+	}
+	args = append(args, "-map_chapters", "-1", "-y", part.File)
+	return args
+}
+
+func printSplitPlan(manifest splitManifest) {
+	ui.PrintInfo(fmt.Sprintf("Kaynak: %s (%s), mod=%s, parça=%d", manifest.Input, formatTrimSecondsHuman(manifest.Duration), manifest.Mode, len(manifest.Parts)))
+	for _, p := range manifest.Parts {
+		line := fmt.Sprintf("  [%d] %s -> %s (%s, %s) %s", p.Index, formatTrimSecondsHuman(p.Start), formatTrimSecondsHuman(p.End),
+			formatTrimSecondsHuman(p.Duration), strings.ToUpper(p.Codec), p.File)
+		if p.Title != "" {
+			line += fmt.Sprintf(" — %s", p.Title)
+		}
+		if p.Skipped {
+			line += " (mevcut, atlanacak)"
+		}
+		ui.PrintInfo(line)
+	}
+}
+
+func writeSplitManifest(path string, manifest splitManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("manifest yazılamadı: %w", err)
+	}
+	return nil
+}
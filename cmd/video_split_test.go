@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func TestResolveSplitMode(t *testing.T) {
+	if _, err := resolveSplitMode("", 0, "", false); err == nil {
+		t.Fatalf("expected error without split criteria")
+	}
+	if _, err := resolveSplitMode("10m", 3, "", false); err == nil {
+		t.Fatalf("expected error for multiple criteria")
+	}
+	mode, err := resolveSplitMode("", 0, "2GB", false)
+	if err != nil || mode != splitModeSize {
+		t.Fatalf("unexpected mode %q err=%v", mode, err)
+	}
+	mode, err = resolveSplitMode("", 0, "", true)
+	if err != nil || mode != splitModeChapters {
+		t.Fatalf("unexpected mode %q err=%v", mode, err)
+	}
+}
+
+func TestSplitRangesByInterval(t *testing.T) {
+	parts, err := splitRangesByInterval(25, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+	if parts[2].Start != 20 || parts[2].End != 25 || parts[2].Index != 3 {
+		t.Fatalf("unexpected last part: %+v", parts[2])
+	}
+	if _, err := splitRangesByInterval(100000, 1); err == nil {
+		t.Fatalf("expected error for too many parts")
+	}
+}
+
+func TestSplitRangesByCount(t *testing.T) {
+	parts, err := splitRangesByCount(90, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parts) != 3 || parts[1].Start != 30 || parts[2].End != 90 {
+		t.Fatalf("unexpected parts: %+v", parts)
+	}
+	if _, err := splitRangesByCount(90, 1); err == nil {
+		t.Fatalf("expected error for --parts 1")
+	}
+}
+
+func TestSplitIntervalForSize(t *testing.T) {
+	// 1000 MB, 1000 sn -> 1 MB/sn; 100 MB limit %95 pay ile 95 sn
+	every, err := splitIntervalForSize(1000*1024*1024, 1000, 100*1024*1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if every < 94.99 || every > 95.01 {
+		t.Fatalf("unexpected interval: %g", every)
+	}
+	if _, err := splitIntervalForSize(1000*1024*1024, 1000, 1024); err == nil {
+		t.Fatalf("expected error for tiny max size")
+	}
+}
+
+func TestSplitRangesFromChapters(t *testing.T) {
+	chapters := []converter.Chapter{
+		{Index: 1, Start: 0, End: 60, Title: "Giriş"},
+		{Index: 2, Start: 60, End: 130, Title: "Bölüm 1"},
+	}
+	parts, err := splitRangesFromChapters(chapters, 120)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parts) != 2 || parts[1].End != 120 || parts[1].Title != "Bölüm 1" {
+		t.Fatalf("unexpected parts: %+v", parts)
+	}
+	if _, err := splitRangesFromChapters(nil, 120); err == nil {
+		t.Fatalf("expected error without chapters")
+	}
+}
+
+func TestAlignSplitBoundariesAuto(t *testing.T) {
+	parts, _ := splitRangesByInterval(30, 10)
+	keyframes := []float64{0, 4, 9.5, 14, 25}
+	aligned := alignSplitBoundaries(parts, keyframes, 1, "auto", 30)
+	if len(aligned) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(aligned))
+	}
+	if aligned[0].End != 9.5 || aligned[0].Codec != "copy" {
+		t.Fatalf("first boundary should snap to keyframe: %+v", aligned[0])
+	}
+	if aligned[1].Start != 9.5 || aligned[1].End != 20 || aligned[1].Codec != "reencode" {
+		t.Fatalf("second part should be re-encoded at exact boundary: %+v", aligned[1])
+	}
+	if aligned[2].Start != 20 || aligned[2].End != 30 || aligned[2].Codec != "reencode" {
+		t.Fatalf("unexpected last part: %+v", aligned[2])
+	}
+}
+
+func TestAlignSplitBoundariesCopyDropsEmptyParts(t *testing.T) {
+	parts, _ := splitRangesByInterval(30, 10)
+	aligned := alignSplitBoundaries(parts, []float64{0, 18}, 0, "copy", 30)
+	if len(aligned) != 2 {
+		t.Fatalf("expected collapsed part to be dropped, got %+v", aligned)
+	}
+	if aligned[0].End != 18 || aligned[1].Start != 18 || aligned[1].Index != 2 {
+		t.Fatalf("unexpected parts: %+v", aligned)
+	}
+	for _, p := range aligned {
+		if p.Codec != "copy" {
+			t.Fatalf("copy mode should copy every part: %+v", p)
+		}
+	}
+}
+
+func TestAlignSplitBoundariesWithoutKeyframes(t *testing.T) {
+	parts, _ := splitRangesByCount(20, 2)
+	aligned := alignSplitBoundaries(parts, nil, 2, "auto", 20)
+	for _, p := range aligned {
+		if p.Codec != "reencode" {
+			t.Fatalf("parts should be re-encoded without keyframe info: %+v", p)
+		}
+	}
+	if aligned[0].End != 10 {
+		t.Fatalf("boundary should stay exact: %+v", aligned[0])
+	}
+}
+
+func TestParseKeyframeTimes(t *testing.T) {
+	got := parseKeyframeTimes("4.004000\n0.000000\n\nN/A\n8.008000,\n")
+	want := []float64{0, 4.004, 8.008}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected keyframes: %v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected keyframes: %v", got)
+		}
+	}
+}
+
+func TestRenderSplitName(t *testing.T) {
+	part := splitPart{Index: 7, Start: 600, End: 1200, Title: "Soru/Cevap: 1"}
+	cases := map[string]string{
+		defaultSplitTemplate:   "kayit_part007",
+		"{index}-{name}":       "7-kayit",
+		"{index:02}_{title}":   "07_Soru_Cevap_ 1",
+		"{name}_{start}_{end}": "kayit_" + strings.ReplaceAll(formatTrimSecondsHuman(600), ":", "-") + "_" + strings.ReplaceAll(formatTrimSecondsHuman(1200), ":", "-"),
+	}
+	for template, want := range cases {
+		if got := renderSplitName(template, "kayit", part); got != want {
+			t.Fatalf("renderSplitName(%q) = %q, want %q", template, got, want)
+		}
+	}
+	if got := renderSplitName("{title}", "kayit", splitPart{Index: 2}); got != "part2" {
+		t.Fatalf("empty title fallback mismatch: %q", got)
+	}
+}
+
+func TestValidateSplitTemplate(t *testing.T) {
+	if err := validateSplitTemplate("{name}_{index:03}"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, template := range []string{"", "out/{index}", "{name}_{foo}"} {
+		if err := validateSplitTemplate(template); err == nil {
+			t.Fatalf("expected error for template %q", template)
+		}
+	}
+}
+
+func TestBuildSplitPartArgs(t *testing.T) {
+	copyArgs := strings.Join(buildSplitPartArgs("in.mp4", splitPart{Start: 10, End: 20, Codec: "copy", File: "out.mp4"}, nil, false), " ")
+	for _, want := range []string{"-ss 10", "-i in.mp4", "-t 10", "-c copy", "-avoid_negative_ts make_zero", "-y out.mp4"} {
+		if !strings.Contains(copyArgs, want) {
+			t.Fatalf("copy args missing %q: %s", want, copyArgs)
+		}
+	}
+	encArgs := strings.Join(buildSplitPartArgs("in.mp4", splitPart{Start: 0, End: 5, Codec: "reencode", File: "out.mp4"}, []string{"-c:v", "libx264"}, false), " ")
+	if strings.Contains(encArgs, "-ss") || !strings.Contains(encArgs, "-c:v libx264") || strings.Contains(encArgs, "-c copy") {
+		t.Fatalf("unexpected re-encode args: %s", encArgs)
+	}
+}
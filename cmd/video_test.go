@@ -219,12 +219,12 @@ func TestResolveEffectiveTrimCodec(t *testing.T) {
 }
 
 func TestTrimReencodeArgsByTargetFormat(t *testing.T) {
-	gifArgs := trimCodecArgs("gif", "reencode", 80)
+	gifArgs := trimCodecArgs("gif", "reencode", 80, nil)
 	if len(gifArgs) == 0 || gifArgs[0] != "-loop" {
 		t.Fatalf("expected gif reencode args, got %v", gifArgs)
 	}
 
-	webmArgs := trimCodecArgs("webm", "reencode", 80)
+	webmArgs := trimCodecArgs("webm", "reencode", 80, nil)
 	foundVP9 := false
 	for i := 0; i < len(webmArgs)-1; i++ {
 		if webmArgs[i] == "-c:v" && webmArgs[i+1] == "libvpx-vp9" {
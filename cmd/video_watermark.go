@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/ui"
+)
+
+var (
+	watermarkValues     watermarkFlagValues
+	watermarkTo         string
+	watermarkName       string
+	watermarkNameTmpl   string
+	watermarkOutputFile string
+	watermarkProfile    string
+	watermarkQuality    int
+	watermarkConflict   string
+	watermarkPreserveMD bool
+	watermarkStripMD    bool
+	watermarkVCodec     string
+	watermarkACodec     string
+	watermarkCRF        int
+	watermarkBitrate    string
+	watermarkEncPreset  string
+	watermarkPixFmt     string
+	watermarkAudioBR    string
+)
+
+// watermarkFlagValues video watermark bayraklarını taşır.
+type watermarkFlagValues struct {
+	Image        string
+	Text         string
+	Burn         string
+	Position     string
+	BurnPosition string
+	Margin       int
+	Opacity      float64
+	Scale        float64
+	FontSize     int
+	FontColor    string
+	Start        string
+	End          string
+}
+
+var videoWatermarkCmd = &cobra.Command{
+	Use:   "watermark <video-dosyasi>",
+	Short: "Videoya logo/yazı bindirir veya zaman kodu/dosya adı yakar",
+	Long: `Videonun üzerine PNG logo veya yazı bindirir. Konum anchor'ı, kenar boşluğu,
+opaklık, video genişliğine oranla logo ölçeği ve isteğe bağlı görünme aralığı
+ayarlanabilir. İnceleme kopyaları için zaman kodu ve/veya dosya adı videoya yakılabilir.
+
+Konumlar: top-left, top, top-right, left, center, right, bottom-left, bottom, bottom-right
+
+Örnekler:
+  fileconverter-cli video watermark klip.mp4 --image logo.png
+  fileconverter-cli video watermark klip.mp4 --image logo.png --position top-right --opacity 0.7 --scale 0.1
+  fileconverter-cli video watermark klip.mp4 --text "© Stüdyo" --position bottom-left --font-size 36
+  fileconverter-cli video watermark klip.mp4 --image logo.png --start 5 --end 00:00:15
+  fileconverter-cli video watermark klip.mp4 --burn both --name klip_inceleme
+  fileconverter-cli video watermark klip.mov --profile review-copy --to mp4`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input := args[0]
+		jsonOutput := isJSONOutput()
+		if _, err := os.Stat(input); os.IsNotExist(err) {
+			return fmt.Errorf("dosya bulunamadi: %s", input)
+		}
+		if !converter.IsFFmpegAvailable() {
+			return fmt.Errorf("video watermark için ffmpeg gerekli")
+		}
+
+		applyProfileDefault(cmd, "profile", &watermarkProfile)
+		applyQualityDefault(cmd, "quality", &watermarkQuality)
+		applyOnConflictDefault(cmd, "on-conflict", &watermarkConflict)
+		applyMetadataDefault(cmd, "preserve-metadata", &watermarkPreserveMD, "strip-metadata", &watermarkStripMD)
+
+		var profileWatermark *converter.WatermarkSpec
+		if p, ok, err := resolveProfile(watermarkProfile); err != nil {
+			return err
+		} else if ok {
+			if p.Quality != nil && !cmd.Flags().Changed("quality") {
+				watermarkQuality = *p.Quality
+			}
+			if p.OnConflict != "" && !cmd.Flags().Changed("on-conflict") {
+				watermarkConflict = p.OnConflict
+			}
+			applyProfileMetadata(cmd, p, "preserve-metadata", &watermarkPreserveMD, "strip-metadata", &watermarkStripMD)
+			profileWatermark = p.Watermark
+		}
+
+		metadataMode, err := metadataModeFromFlags(watermarkPreserveMD, watermarkStripMD)
+		if err != nil {
+			return err
+		}
+
+		spec, err := resolveWatermarkSpec(cmd, watermarkValues, profileWatermark)
+		if err != nil {
+			return err
+		}
+
+		targetFormat := converter.DetectFormat(input)
+		if strings.TrimSpace(watermarkTo) != "" {
+			targetFormat = converter.NormalizeFormat(watermarkTo)
+		}
+		if !converter.IsVideoFormat(targetFormat) {
+			return fmt.Errorf("watermark için video hedef formatı gerekli: %s", targetFormat)
+		}
+
+		videoSpec, err := converter.BuildVideoSpec(watermarkVCodec, watermarkCRF, watermarkBitrate, watermarkEncPreset, watermarkPixFmt, watermarkACodec, watermarkAudioBR)
+		if err != nil {
+			return err
+		}
+		if err := converter.ValidateVideoSpec(videoSpec, targetFormat); err != nil {
+			return err
+		}
+		if videoSpec != nil && videoSpec.Codec == converter.VideoCodecCopy {
+			return fmt.Errorf("watermark için re-encode gerekir, --video-codec copy kullanılamaz")
+		}
+		if err := prepareVideoEncoders(videoSpec, targetFormat, jsonOutput); err != nil {
+			return err
+		}
+
+		outputPath, err := resolveNamedOutputPath(watermarkNameTmpl, watermarkName, watermarkOutputFile, input, targetFormat, 0,
+			buildWatermarkOutputPath(input, targetFormat, watermarkName, watermarkOutputFile))
+		if err != nil {
+			return err
+		}
+		conflict := converter.NormalizeConflictPolicy(watermarkConflict)
+		if conflict == "" {
+			return fmt.Errorf("gecersiz on-conflict politikasi: %s", watermarkConflict)
+		}
+		outputPath, skip, err := converter.ResolveOutputPathConflict(outputPath, conflict)
+		if err != nil {
+			return err
+		}
+		if skip {
+			if jsonOutput {
+				return printJSON(map[string]interface{}{
+					"success": true,
+					"skipped": true,
+					"input":   input,
+					"output":  outputPath,
+				})
+			}
+			ui.PrintWarning(fmt.Sprintf("Çıktı dosyası mevcut, atlandı: %s", outputPath))
+			return nil
+		}
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+			return err
+		}
+
+		conv, err := converter.FindConverter(converter.DetectFormat(input), targetFormat)
+		if err != nil {
+			return err
+		}
+
+		if !jsonOutput {
+			ui.PrintInfo(fmt.Sprintf("Watermark: %s", describeWatermarkSpec(spec)))
+			ui.PrintConversion(input, outputPath)
+		}
+		started := time.Now()
+
+		err = conv.Convert(input, outputPath, converter.Options{
+			Quality:      watermarkQuality,
+			Verbose:      verbose,
+			MetadataMode: metadataMode,
+			Video:        videoSpec,
+			Watermark:    spec,
+		})
+		if err != nil {
+			if jsonOutput {
+				return err
+			}
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		if jsonOutput {
+			return printJSON(map[string]interface{}{
+				"success":     true,
+				"input":       input,
+				"output":      outputPath,
+				"watermark":   spec,
+				"duration_ms": time.Since(started).Milliseconds(),
+			})
+		}
+		ui.PrintSuccess("Watermark uygulandı!")
+		ui.PrintDuration(time.Since(started))
+		return nil
+	},
+}
+
+func init() {
+	addWatermarkFlags(videoWatermarkCmd, &watermarkValues)
+	f := videoWatermarkCmd.Flags()
+	f.StringVar(&watermarkTo, "to", "", "Hedef format (varsayılan: kaynak format)")
+	f.StringVarP(&watermarkName, "name", "n", "", "Çıktı dosya adı (uzantısız)")
+	addNameTemplateFlag(videoWatermarkCmd, &watermarkNameTmpl)
+	f.StringVar(&watermarkOutputFile, "output-file", "", "Tam çıktı dosya yolu")
+	f.StringVar(&watermarkProfile, "profile", "", "Hazır profil (ör: social-story, review-copy)")
+	f.IntVarP(&watermarkQuality, "quality", "q", 0, "Kalite seviyesi (1-100)")
+	f.StringVar(&watermarkConflict, "on-conflict", converter.ConflictVersioned, "Çakışma politikası: overwrite, skip, versioned")
+	f.BoolVar(&watermarkPreserveMD, "preserve-metadata", false, "Metadata bilgisini korumayı dene")
+	f.BoolVar(&watermarkStripMD, "strip-metadata", false, "Metadata bilgisini temizle")
+	addVideoCodecFlags(videoWatermarkCmd, &watermarkVCodec, &watermarkACodec, &watermarkCRF, &watermarkBitrate, &watermarkEncPreset, &watermarkPixFmt, &watermarkAudioBR)
+
+	videoCmd.AddCommand(videoWatermarkCmd)
+}
+
+// addWatermarkFlags logo/yazı/burn bayraklarını komuta ekler.
+func addWatermarkFlags(cmd *cobra.Command, values *watermarkFlagValues) {
+	f := cmd.Flags()
+	f.StringVar(&values.Image, "image", "", "Bindirilecek logo dosyası (PNG önerilir)")
+	f.StringVar(&values.Text, "text", "", "Bindirilecek yazı")
+	f.StringVar(&values.Burn, "burn", "", "Videoya yak: timecode, filename veya both")
+	f.StringVar(&values.Position, "position", "bottom-right", "Logo/yazı konumu (ör: top-left, center, bottom-right)")
+	f.StringVar(&values.BurnPosition, "burn-position", "top-left", "Zaman kodu/dosya adı konumu")
+	f.IntVar(&values.Margin, "margin", 24, "Kenardan boşluk (piksel)")
+	f.Float64Var(&values.Opacity, "opacity", 1, "Opaklık (0-1)")
+	f.Float64Var(&values.Scale, "scale", 0.15, "Logo genişliğinin video genişliğine oranı (0-1)")
+	f.IntVar(&values.FontSize, "font-size", 0, "Yazı boyutu (0=video yüksekliğine göre otomatik)")
+	f.StringVar(&values.FontColor, "font-color", "white", "Yazı rengi (ör: white, yellow, #ff0000)")
+	f.StringVar(&values.Start, "start", "", "Bindirmenin görünmeye başlayacağı zaman (ör: 5, 00:00:05)")
+	f.StringVar(&values.End, "end", "", "Bindirmenin kaybolacağı zaman (ör: 15, 00:00:15)")
+}
+
+// resolveWatermarkSpec profil watermark'ını taban alır, açıkça verilen bayraklarla ezer ve doğrular.
+func resolveWatermarkSpec(cmd *cobra.Command, values watermarkFlagValues, base *converter.WatermarkSpec) (*converter.WatermarkSpec, error) {
+	spec := &converter.WatermarkSpec{}
+	if base != nil {
+		*spec = *base
+	}
+	changed := cmd.Flags().Changed
+	if changed("image") {
+		spec.Image = values.Image
+	}
+	if changed("text") {
+		spec.Text = values.Text
+	}
+	if changed("burn") {
+		spec.Burn = values.Burn
+	}
+	if changed("position") || spec.Position == "" {
+		spec.Position = values.Position
+	}
+	if changed("burn-position") || spec.BurnPosition == "" {
+		spec.BurnPosition = values.BurnPosition
+	}
+	if changed("margin") || spec.Margin == 0 {
+		spec.Margin = values.Margin
+	}
+	if changed("opacity") || spec.Opacity == 0 {
+		spec.Opacity = values.Opacity
+	}
+	if changed("scale") || spec.Scale == 0 {
+		spec.Scale = values.Scale
+	}
+	if changed("font-size") {
+		spec.FontSize = values.FontSize
+	}
+	if changed("font-color") || spec.FontColor == "" {
+		spec.FontColor = values.FontColor
+	}
+	if changed("start") {
+		start, err := parseWatermarkTime(values.Start, "--start")
+		if err != nil {
+			return nil, err
+		}
+		spec.Start = start
+	}
+	if changed("end") {
+		end, err := parseWatermarkTime(values.End, "--end")
+		if err != nil {
+			return nil, err
+		}
+		spec.End = end
+	}
+
+	if spec.IsZero() {
+		return nil, fmt.Errorf("--image, --text veya --burn seçeneklerinden en az biri gerekli")
+	}
+	if err := converter.ValidateWatermarkSpec(spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func parseWatermarkTime(value string, flag string) (float64, error) {
+	if strings.TrimSpace(value) == "" {
+		return 0, nil
+	}
+	seconds, err := parseVideoTrimToSeconds(strings.ReplaceAll(value, ",", "."))
+	if err != nil {
+		return 0, fmt.Errorf("geçersiz %s: %w", flag, err)
+	}
+	return seconds, nil
+}
+
+// resolveProfileWatermark profil watermark'ını video hedeflerine uygular; diğer hedeflerde yok sayılır.
+func resolveProfileWatermark(base *converter.WatermarkSpec, targetFormat string) (*converter.WatermarkSpec, error) {
+	if base.IsZero() || !converter.IsVideoFormat(targetFormat) {
+		return nil, nil
+	}
+	spec := *base
+	if err := converter.ValidateWatermarkSpec(&spec); err != nil {
+		return nil, fmt.Errorf("profil watermark ayarı geçersiz: %w", err)
+	}
+	return &spec, nil
+}
+
+func describeWatermarkSpec(spec *converter.WatermarkSpec) string {
+	if spec.IsZero() {
+		return ""
+	}
+	parts := make([]string, 0, 6)
+	if spec.Image != "" {
+		parts = append(parts, fmt.Sprintf("logo=%s (%%%.0f genişlik)", filepath.Base(spec.Image), spec.Scale*100))
+	}
+	if spec.Text != "" {
+		parts = append(parts, fmt.Sprintf("yazı=%q", spec.Text))
+	}
+	if spec.Image != "" || spec.Text != "" {
+		parts = append(parts, fmt.Sprintf("konum=%s", spec.Position))
+		if spec.Opacity > 0 && spec.Opacity < 1 {
+			parts = append(parts, fmt.Sprintf("opaklık=%.2f", spec.Opacity))
+		}
+	}
+	if spec.Burn != "" {
+		parts = append(parts, fmt.Sprintf("burn=%s (%s)", spec.Burn, spec.BurnPosition))
+	}
+	if spec.End > 0 {
+		parts = append(parts, fmt.Sprintf("aralık=%s-%s", formatTrimSecondsHuman(spec.Start), formatTrimSecondsHuman(spec.End)))
+	} else if spec.Start > 0 {
+		parts = append(parts, fmt.Sprintf("başlangıç=%s", formatTrimSecondsHuman(spec.Start)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func buildWatermarkOutputPath(input string, targetFormat string, customName string, explicit string) string {
+	if strings.TrimSpace(explicit) != "" {
+		return explicit
+	}
+	base := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input)) + "_watermarked"
+	if strings.TrimSpace(customName) != "" {
+		base = customName
+	}
+	dir := filepath.Dir(input)
+	if strings.TrimSpace(outputDir) != "" {
+		dir = outputDir
+	}
+	return filepath.Join(dir, base+"."+targetFormat)
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func newWatermarkTestCommand(values *watermarkFlagValues) *cobra.Command {
+	cmd := &cobra.Command{Use: "watermark"}
+	addWatermarkFlags(cmd, values)
+	return cmd
+}
+
+func TestResolveWatermarkSpecFromFlags(t *testing.T) {
+	var values watermarkFlagValues
+	cmd := newWatermarkTestCommand(&values)
+	if err := cmd.ParseFlags([]string{"--text", "Demo", "--position", "tl", "--opacity", "0.5", "--start", "5", "--end", "00:00:15"}); err != nil {
+		t.Fatalf("parse flags failed: %v", err)
+	}
+	spec, err := resolveWatermarkSpec(cmd, values, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Text != "Demo" || spec.Position != "top-left" || spec.Opacity != 0.5 || spec.Start != 5 || spec.End != 15 {
+		t.Fatalf("unexpected spec: %+v", spec)
+	}
+	if spec.Margin != 24 || spec.Scale != 0.15 {
+		t.Fatalf("expected flag defaults to fill margin/scale, got %+v", spec)
+	}
+}
+
+func TestResolveWatermarkSpecProfileOverride(t *testing.T) {
+	var values watermarkFlagValues
+	cmd := newWatermarkTestCommand(&values)
+	if err := cmd.ParseFlags([]string{"--burn-position", "bottom"}); err != nil {
+		t.Fatalf("parse flags failed: %v", err)
+	}
+	base := &converter.WatermarkSpec{Burn: "both", BurnPosition: "top-right"}
+	spec, err := resolveWatermarkSpec(cmd, values, base)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Burn != converter.WatermarkBurnBoth || spec.BurnPosition != "bottom" {
+		t.Fatalf("flag should override profile burn position: %+v", spec)
+	}
+	if base.BurnPosition != "top-right" {
+		t.Fatalf("profile spec must not be mutated: %+v", base)
+	}
+}
+
+func TestResolveWatermarkSpecRequiresContent(t *testing.T) {
+	var values watermarkFlagValues
+	cmd := newWatermarkTestCommand(&values)
+	if _, err := resolveWatermarkSpec(cmd, values, nil); err == nil {
+		t.Fatalf("expected error without --image/--text/--burn")
+	}
+}
+
+func TestResolveProfileWatermark(t *testing.T) {
+	base := &converter.WatermarkSpec{Burn: "timecode"}
+	spec, err := resolveProfileWatermark(base, "mp4")
+	if err != nil || spec == nil || spec == base {
+		t.Fatalf("expected validated copy for video target, got %v %v", spec, err)
+	}
+	spec, err = resolveProfileWatermark(base, "png")
+	if err != nil || spec != nil {
+		t.Fatalf("expected nil for non-video target, got %v %v", spec, err)
+	}
+}
+
+func TestBuildWatermarkOutputPath(t *testing.T) {
+	outputDir = ""
+	got := buildWatermarkOutputPath("/tmp/klip.mov", "mp4", "", "")
+	if got != filepath.Join("/tmp", "klip_watermarked.mp4") {
+		t.Fatalf("unexpected output path: %s", got)
+	}
+	got = buildWatermarkOutputPath("/tmp/klip.mov", "mp4", "marka", "")
+	if got != filepath.Join("/tmp", "marka.mp4") {
+		t.Fatalf("unexpected output path with name: %s", got)
+	}
+	got = buildWatermarkOutputPath("/tmp/klip.mov", "mp4", "", "/out/x.mp4")
+	if got != "/out/x.mp4" {
+		t.Fatalf("explicit output should win: %s", got)
+	}
+}
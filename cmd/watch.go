@@ -28,6 +28,24 @@ var (
 	watchRetryDelay time.Duration
 	watchInterval   time.Duration
 	watchSettle     time.Duration
+	watchSampleRate int
+	watchBitDepth   string
+	watchChannels   int
+	watchBitrate    string
+	watchVBR        bool
+	watchCBR        bool
+	watchAudioCodec string
+	watchAudioBR    string
+	watchVideoCodec string
+	watchCRF        int
+	watchEncPreset  string
+	watchPixFmt     string
+	watchVideoBR    string
+	watchFilter     filterFlagValues
+	watchSchedule   scheduleFlagValues
+	watchSource     sourceFlagValues
+	watchVerify     verifyFlagValues
+	watchNameTmpl   string
 )
 
 var watchCmd = &cobra.Command{
@@ -40,7 +58,12 @@ otomatik dönüştürür.
   fileconverter-cli watch ./incoming --from webp --to jpg
   fileconverter-cli watch ./videos --from mp4 --to gif --recursive --quality 80
   fileconverter-cli watch ./inbox --from png --to jpg --on-conflict versioned
-  fileconverter-cli watch ./incoming --from mov --to mp4 --profile archive-lossless --preserve-metadata`,
+  fileconverter-cli watch ./incoming --from mov --to mp4 --profile archive-lossless --preserve-metadata
+  fileconverter-cli watch ./kayitlar --from wav --to mp3 --channels 1 --bitrate 96k --cbr
+  fileconverter-cli watch ./camera --from jpg --to webp -r --exclude "**/thumbs/**" --min-width 1200
+  fileconverter-cli watch ./scans --from png --to pdf --name-template "{now:2006-01-02}/{index:04}_{name}.{ext}"
+  fileconverter-cli watch ./inbox --from png --to webp --on-success delete --on-failure move:./hatali
+  fileconverter-cli watch ./scans --from png --to pdf --verify --retry 1`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		sourceDir := args[0]
@@ -51,9 +74,11 @@ otomatik dönüştürür.
 		applyMetadataDefault(cmd, "preserve-metadata", &watchPreserveMD, "strip-metadata", &watchStripMD)
 		applyRetryDefaults(cmd, "retry", &watchRetry, "retry-delay", &watchRetryDelay)
 
+		var profileWatermark *converter.WatermarkSpec
 		if p, ok, err := resolveProfile(watchProfile); err != nil {
 			return err
 		} else if ok {
+			profileWatermark = p.Watermark
 			applyProfileToWatch(cmd, p)
 			applyProfileMetadata(cmd, p, "preserve-metadata", &watchPreserveMD, "strip-metadata", &watchStripMD)
 		}
@@ -78,11 +103,81 @@ otomatik dönüştürür.
 		if conflictPolicy == "" {
 			return fmt.Errorf("gecersiz on-conflict politikasi: %s", watchOnConflict)
 		}
+		audioSpec, videoSpec, err := resolveEncodeSpecs(cmd, encodeFlagValues{
+			SampleRate:   watchSampleRate,
+			BitDepth:     watchBitDepth,
+			Channels:     watchChannels,
+			Bitrate:      watchBitrate,
+			VBR:          watchVBR,
+			CBR:          watchCBR,
+			AudioCodec:   watchAudioCodec,
+			AudioBitrate: watchAudioBR,
+			VideoCodec:   watchVideoCodec,
+			CRF:          watchCRF,
+			EncodePreset: watchEncPreset,
+			PixFmt:       watchPixFmt,
+			VideoBitrate: watchVideoBR,
+		}, targetFormat)
+		if err != nil {
+			return fmt.Errorf("encode parametreleri hatalı: %w", err)
+		}
+		if err := prepareVideoEncoders(videoSpec, targetFormat, false); err != nil {
+			return err
+		}
+		watermarkSpec, err := resolveProfileWatermark(profileWatermark, targetFormat)
+		if err != nil {
+			return err
+		}
+
+		nameTmpl, err := parseNameTemplateFlag(watchNameTmpl, "", "")
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		fileFilter, err := buildFileFilter(watchFilter)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		schedule, err := buildSchedule(watchSchedule)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		verifyOpts, err := buildVerifyOptions(watchVerify)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+
+		sourceHandler, err := buildSourceHandler(watchSource, sourceDir)
+		if err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		if err := checkInPlaceSourceAction(sourceHandler, conflictPolicy, fromFormat, []string{targetFormat}); err != nil {
+			ui.PrintError(err.Error())
+			return err
+		}
+		if sourceHandler != nil && watchRecursive {
+			// Taşınan dosyalar izlenen ağacın içinde kalırsa yeniden dönüştürülürdü
+			for _, dir := range sourceMoveDirs(sourceHandler) {
+				if isWithinDir(dir, sourceDir) {
+					err := fmt.Errorf("taşıma dizini özyinelemeli izlenen dizinin içinde olamaz: %s", dir)
+					ui.PrintError(err.Error())
+					return err
+				}
+			}
+		}
 
 		w, watchBackendErr := convwatch.NewAdaptiveWatcher(sourceDir, fromFormat, watchRecursive, watchSettle)
 		if watchBackendErr != nil {
 			ui.PrintWarning(fmt.Sprintf("Event izleme devre dışı, polling fallback kullanılıyor: %s", watchBackendErr.Error()))
 		}
+		w.SetFilter(fileFilter)
 		if err := w.Bootstrap(); err != nil {
 			return err
 		}
@@ -90,6 +185,8 @@ otomatik dönüştürür.
 
 		pool := batch.NewPool(workers)
 		pool.SetRetry(watchRetry, watchRetryDelay)
+		pool.Schedule = schedule
+		pool.Verify = verifyOpts
 
 		ui.PrintInfo(fmt.Sprintf("İzleme başladı: %s (.%s -> .%s)", sourceDir, converter.FormatFilterLabel(fromFormat), targetFormat))
 		ui.PrintInfo(fmt.Sprintf("İzleme modu: %s", w.Mode()))
@@ -103,6 +200,8 @@ otomatik dönüştürür.
 		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 		defer signal.Stop(sigCh)
 
+		// processed {index} şablon değişkeni için izleme boyunca artan sayaçtır.
+		processed := 0
 		processTick := func() {
 			files, err := w.Poll(time.Now())
 			if err != nil {
@@ -116,7 +215,14 @@ otomatik dönüştürür.
 			jobs := make([]batch.Job, 0, len(files))
 			reserved := make(map[string]struct{}, len(files))
 			for _, f := range files {
-				baseOutput := converter.BuildOutputPath(f, outputDir, targetFormat, "")
+				processed++
+				baseOutput, err := templatedOutputPath(nameTmpl,
+					converter.TemplateData{InputPath: f, TargetFormat: targetFormat, Index: processed, Quality: watchQuality},
+					converter.BuildOutputPath(f, outputDir, targetFormat, ""))
+				if err != nil {
+					ui.PrintError(fmt.Sprintf("Çıktı yolu oluşturulamadı: %s", err.Error()))
+					continue
+				}
 				resolvedOutput, skipReason, err := resolveBatchOutputPath(baseOutput, conflictPolicy, reserved)
 				if err != nil {
 					ui.PrintError(fmt.Sprintf("Çıktı yolu oluşturulamadı: %s", err.Error()))
@@ -132,6 +238,9 @@ otomatik dönüştürür.
 						Quality:      watchQuality,
 						Verbose:      verbose,
 						MetadataMode: metadataMode,
+						Audio:        audioSpec,
+						Video:        videoSpec,
+						Watermark:    watermarkSpec,
 					},
 				})
 			}
@@ -153,6 +262,8 @@ otomatik dönüştürür.
 				}
 				fmt.Println()
 			}
+
+			applySourceActions(sourceHandler, results, false)
 		}
 
 		for {
@@ -182,6 +293,24 @@ func init() {
 	watchCmd.Flags().DurationVar(&watchRetryDelay, "retry-delay", 500*time.Millisecond, "Retry denemeleri arası bekleme (örn: 500ms, 2s)")
 	watchCmd.Flags().DurationVar(&watchInterval, "interval", 2*time.Second, "Klasör tarama aralığı")
 	watchCmd.Flags().DurationVar(&watchSettle, "settle", 1500*time.Millisecond, "Dosyanın stabil sayılması için bekleme süresi")
+	watchCmd.Flags().IntVar(&watchSampleRate, "sample-rate", 0, "Ses örnekleme hızı (Hz, ör: 44100, 48000)")
+	watchCmd.Flags().StringVar(&watchBitDepth, "bit-depth", "", "Ses bit derinliği: 16, 24, 32f (sadece wav/flac)")
+	watchCmd.Flags().IntVar(&watchChannels, "channels", 0, "Ses kanal sayısı (1=mono, 2=stereo)")
+	watchCmd.Flags().StringVar(&watchBitrate, "bitrate", "", "Bitrate: ses çıktısında ses, video çıktısında video bitrate'i (ör: 192k, 4M)")
+	watchCmd.Flags().BoolVar(&watchVBR, "vbr", false, "Değişken bitrate (VBR) kullan")
+	watchCmd.Flags().BoolVar(&watchCBR, "cbr", false, "Sabit bitrate (CBR) kullan")
+	watchCmd.Flags().StringVar(&watchAudioCodec, "audio-codec", "", "Ses codec'i (ses çıktısı: libmp3lame, alac vb.; video ses izi: aac, opus, copy, none)")
+	watchCmd.Flags().StringVar(&watchAudioBR, "audio-bitrate", "", "Video çıktısında ses izi bitrate'i (ör: 128k)")
+	watchCmd.Flags().StringVar(&watchVideoCodec, "video-codec", "", "Video codec: h264, h265, av1, vp9, copy")
+	watchCmd.Flags().IntVar(&watchCRF, "crf", -1, "Sabit kalite değeri (h264/h265: 0-51, av1/vp9: 0-63)")
+	watchCmd.Flags().StringVar(&watchEncPreset, "encode-preset", "", "Encoder hız preset'i (ör: fast, medium, slow)")
+	watchCmd.Flags().StringVar(&watchPixFmt, "pix-fmt", "", "Piksel formatı (ör: yuv420p, yuv420p10le)")
+
+	addFilterFlags(watchCmd, &watchFilter)
+	addScheduleFlags(watchCmd, &watchSchedule)
+	addSourceFlags(watchCmd, &watchSource)
+	addVerifyFlags(watchCmd, &watchVerify)
+	addNameTemplateFlag(watchCmd, &watchNameTmpl)
 
 	watchCmd.MarkFlagRequired("to")
 	watchCmd.MarkFlagRequired("from")
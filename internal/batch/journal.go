@@ -0,0 +1,326 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Journal olay türleri.
+const (
+	JournalRun       = "run"
+	JournalResumed   = "resumed"
+	JournalQueued    = "queued"
+	JournalStarted   = "started"
+	JournalSucceeded = "succeeded"
+	JournalFailed    = "failed"
+	JournalFinished  = "finished"
+)
+
+// JournalEvent iş günlüğünün tek satırıdır. run/resumed olayları çalıştırmanın
+// komut satırını, iş olayları girdi/çıktı eşlemesini taşır.
+type JournalEvent struct {
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	Kind       string    `json:"kind,omitempty"`
+	Args       []string  `json:"args,omitempty"`
+	Dir        string    `json:"dir,omitempty"`
+	Input      string    `json:"input,omitempty"`
+	Output     string    `json:"output,omitempty"`
+	From       string    `json:"from,omitempty"`
+	To         string    `json:"to,omitempty"`
+	Attempts   int       `json:"attempts,omitempty"`
+	Size       int64     `json:"size,omitempty"`
+	DurationMS int64     `json:"duration_ms,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Journal yalnızca sona ekleme yapılan JSONL iş günlüğüdür. Her yazımdan sonra dosya
+// diske senkronlanır; süreç öldürülse veya elektrik kesilse bile kayıtlar korunur.
+// nil *Journal üzerindeki kayıt çağrıları hiçbir şey yapmaz.
+type Journal struct {
+	path string
+	mu   sync.Mutex
+	f    *os.File
+}
+
+// OpenJournal günlüğü ekleme modunda açar, yoksa oluşturur. Önceki çalışmanın
+// kesinti sırasında yarım bıraktığı son satır silinir.
+func OpenJournal(path string) (*Journal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("journal dizini oluşturulamadı: %w", err)
+	}
+	if err := trimPartialLine(path); err != nil {
+		return nil, fmt.Errorf("journal onarılamadı: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("journal açılamadı: %w", err)
+	}
+	return &Journal{path: path, f: f}, nil
+}
+
+// trimPartialLine dosya satır sonuyla bitmiyorsa son satırı keser.
+func trimPartialLine(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 || data[len(data)-1] == '\n' {
+		return nil
+	}
+	return os.Truncate(path, int64(bytes.LastIndexByte(data, '\n')+1))
+}
+
+// Path günlük dosyasının yolunu döner.
+func (j *Journal) Path() string {
+	if j == nil {
+		return ""
+	}
+	return j.path
+}
+
+// Append olayları tek yazımda ekler ve dosyayı senkronlar.
+func (j *Journal) Append(events ...JournalEvent) error {
+	if j == nil || len(events) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	now := time.Now()
+	for _, ev := range events {
+		if ev.Time.IsZero() {
+			ev.Time = now
+		}
+		line, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("journal yazılamadı: %w", err)
+	}
+	return j.f.Sync()
+}
+
+// Close günlüğü kapatır.
+func (j *Journal) Close() error {
+	if j == nil {
+		return nil
+	}
+	return j.f.Close()
+}
+
+// RecordQueued çalıştırılacak (atlanmayacak) çıktıları kuyruğa alındı olarak yazar.
+func (j *Journal) RecordQueued(jobs []Job) error {
+	if j == nil {
+		return nil
+	}
+	var events []JournalEvent
+	for _, job := range jobs {
+		for _, t := range job.Targets() {
+			if t.SkipReason != "" {
+				continue
+			}
+			events = append(events, JournalEvent{Type: JournalQueued, Input: t.InputPath, Output: t.OutputPath, From: t.From, To: t.To})
+		}
+	}
+	return j.Append(events...)
+}
+
+// RecordStarted işin çalıştırılacak çıktılarını başladı olarak yazar.
+func (j *Journal) RecordStarted(job Job) error {
+	if j == nil {
+		return nil
+	}
+	var events []JournalEvent
+	for _, t := range job.Targets() {
+		if t.SkipReason != "" {
+			continue
+		}
+		events = append(events, JournalEvent{Type: JournalStarted, Input: t.InputPath, Output: t.OutputPath, To: t.To})
+	}
+	return j.Append(events...)
+}
+
+// RecordResult bir çıktının sonucunu yazar. Atlanan çıktılar kuyruğa alınmadığı için yazılmaz.
+func (j *Journal) RecordResult(r JobResult) error {
+	if j == nil || r.Skipped {
+		return nil
+	}
+	ev := JournalEvent{
+		Input:      r.Job.InputPath,
+		Output:     r.Job.OutputPath,
+		To:         r.Job.To,
+		Attempts:   r.Attempts,
+		DurationMS: r.Duration.Milliseconds(),
+	}
+	switch {
+	case r.Success:
+		ev.Type = JournalSucceeded
+		ev.Size = r.OutputSize
+	default:
+		ev.Type = JournalFailed
+		if r.Error != nil {
+			ev.Error = r.Error.Error()
+		}
+	}
+	return j.Append(ev)
+}
+
+// JournalEntry bir çıktının günlükteki son durumudur.
+type JournalEntry struct {
+	Input  string
+	Output string
+	From   string
+	To     string
+	// Status son olay türüdür: queued, started, succeeded veya failed.
+	Status string
+	Error  string
+}
+
+// Done çıktı başarıyla üretildiyse ve dosya hâlâ yerindeyse true döner.
+func (e JournalEntry) Done() bool {
+	if e.Status != JournalSucceeded {
+		return false
+	}
+	_, err := os.Stat(e.Output)
+	return err == nil
+}
+
+// JournalState okunan günlüğün özetidir.
+type JournalState struct {
+	// Kind günlüğü yazan komuttur (batch veya pipeline).
+	Kind string
+	// Args ilk çalıştırmanın komut adı hariç argümanları, Dir çalışma dizinidir.
+	Args     []string
+	Dir      string
+	Finished bool
+	Entries  []JournalEntry
+	index    map[string]int
+}
+
+func journalKey(input, to string) string {
+	return input + "\x00" + to
+}
+
+// Lookup girdi ve hedef format için kaydı döner.
+func (s *JournalState) Lookup(input, to string) (JournalEntry, bool) {
+	if s == nil {
+		return JournalEntry{}, false
+	}
+	i, ok := s.index[journalKey(input, to)]
+	if !ok {
+		return JournalEntry{}, false
+	}
+	return s.Entries[i], true
+}
+
+// Inputs günlükteki girdileri ilk görüldükleri sırayla döner.
+func (s *JournalState) Inputs() []string {
+	seen := map[string]struct{}{}
+	var inputs []string
+	for _, e := range s.Entries {
+		if _, ok := seen[e.Input]; ok {
+			continue
+		}
+		seen[e.Input] = struct{}{}
+		inputs = append(inputs, e.Input)
+	}
+	return inputs
+}
+
+// Counts tamamlanmış (çıktısı yerinde) ve yeniden çalıştırılacak kayıt sayılarını döner.
+func (s *JournalState) Counts() (done, pending int) {
+	for _, e := range s.Entries {
+		if e.Done() {
+			done++
+		} else {
+			pending++
+		}
+	}
+	return done, pending
+}
+
+// ReadJournal günlüğü okur. Kesinti sırasında yarım kalmış son satır yok sayılır.
+func ReadJournal(path string) (*JournalState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	state := &JournalState{index: map[string]int{}}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNo := 0
+	var pendingErr error
+	for scanner.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if pendingErr != nil {
+			return nil, pendingErr
+		}
+		var ev JournalEvent
+		if err := json.Unmarshal(line, &ev); err != nil {
+			// Sadece son satır yarım olabilir; ortadaki bozuk satır hatadır.
+			pendingErr = fmt.Errorf("journal %d. satır okunamadı: %w", lineNo, err)
+			continue
+		}
+		state.apply(ev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if state.Kind == "" {
+		return nil, fmt.Errorf("journal başlık kaydı bulunamadı: %s", path)
+	}
+	return state, nil
+}
+
+func (s *JournalState) apply(ev JournalEvent) {
+	switch ev.Type {
+	case JournalRun:
+		s.Kind, s.Args, s.Dir = ev.Kind, ev.Args, ev.Dir
+		return
+	case JournalResumed:
+		s.Finished = false
+		return
+	case JournalFinished:
+		s.Finished = true
+		return
+	}
+	if ev.Input == "" {
+		return
+	}
+	key := journalKey(ev.Input, ev.To)
+	i, ok := s.index[key]
+	if !ok {
+		i = len(s.Entries)
+		s.index[key] = i
+		s.Entries = append(s.Entries, JournalEntry{Input: ev.Input, To: ev.To})
+	}
+	e := &s.Entries[i]
+	e.Status = ev.Type
+	e.Error = ev.Error
+	if ev.Output != "" {
+		e.Output = ev.Output
+	}
+	if ev.From != "" {
+		e.From = ev.From
+	}
+}
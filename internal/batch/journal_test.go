@@ -0,0 +1,149 @@
+package batch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJournalRecordAndRead(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs", "batch.journal")
+	done := filepath.Join(dir, "a.html")
+	if err := os.WriteFile(done, []byte("ok"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	j, err := OpenJournal(path)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	jobs := []Job{
+		{InputPath: "a.md", OutputPath: done, From: "md", To: "html"},
+		{InputPath: "b.md", From: "md", Outputs: []JobOutput{
+			{OutputPath: filepath.Join(dir, "b.html"), To: "html"},
+			{OutputPath: filepath.Join(dir, "b.txt"), To: "txt", SkipReason: "output_exists"},
+		}},
+		{InputPath: "c.md", OutputPath: filepath.Join(dir, "c.html"), From: "md", To: "html"},
+	}
+	if err := j.Append(JournalEvent{Type: JournalRun, Kind: "batch", Args: []string{"./src", "--to", "html"}, Dir: dir}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+	if err := j.RecordQueued(jobs); err != nil {
+		t.Fatalf("queued failed: %v", err)
+	}
+	j.RecordStarted(jobs[0])
+	j.RecordResult(JobResult{Job: jobs[0], Success: true, Attempts: 1})
+	j.RecordStarted(jobs[1])
+	j.RecordResult(JobResult{Job: jobs[1].Targets()[0], Error: errors.New("boom"), Attempts: 2})
+	j.RecordResult(JobResult{Job: jobs[1].Targets()[1], Skipped: true, SkipReason: "output_exists"})
+	if err := j.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	state, err := ReadJournal(path)
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if state.Kind != "batch" || state.Dir != dir || len(state.Args) != 3 {
+		t.Fatalf("unexpected header: %+v", state)
+	}
+	if len(state.Entries) != 3 {
+		t.Fatalf("expected 3 entries (skipped outputs are not recorded), got %d", len(state.Entries))
+	}
+	if e, ok := state.Lookup("a.md", "html"); !ok || !e.Done() {
+		t.Fatalf("expected a.md to be done: %+v", e)
+	}
+	if e, ok := state.Lookup("b.md", "html"); !ok || e.Status != JournalFailed || e.Error != "boom" {
+		t.Fatalf("unexpected b.md entry: %+v", e)
+	}
+	if e, ok := state.Lookup("c.md", "html"); !ok || e.Status != JournalQueued || e.From != "md" {
+		t.Fatalf("unexpected c.md entry: %+v", e)
+	}
+	if _, ok := state.Lookup("b.md", "txt"); ok {
+		t.Fatalf("skipped output should not be in journal")
+	}
+	if done, pending := state.Counts(); done != 1 || pending != 2 {
+		t.Fatalf("unexpected counts: done=%d pending=%d", done, pending)
+	}
+	if got := state.Inputs(); len(got) != 3 || got[0] != "a.md" || got[2] != "c.md" {
+		t.Fatalf("unexpected inputs: %v", got)
+	}
+}
+
+func TestJournalDoneRequiresOutput(t *testing.T) {
+	e := JournalEntry{Status: JournalSucceeded, Output: filepath.Join(t.TempDir(), "missing.html")}
+	if e.Done() {
+		t.Fatalf("entry with missing output should not be done")
+	}
+}
+
+func TestReadJournalToleratesTruncatedTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	content := `{"type":"run","kind":"batch","dir":"/tmp"}
+{"type":"queued","input":"a.md","output":"a.html","to":"html"}
+{"type":"succ`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	state, err := ReadJournal(path)
+	if err != nil {
+		t.Fatalf("truncated tail should be ignored: %v", err)
+	}
+	if e, ok := state.Lookup("a.md", "html"); !ok || e.Status != JournalQueued {
+		t.Fatalf("unexpected entry: %+v", e)
+	}
+
+	// Devam eden yazım yarım satırı silip yeni satırdan başlamalı
+	j, err := OpenJournal(path)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	j.Append(JournalEvent{Type: JournalSucceeded, Input: "a.md", Output: "a.html", To: "html"})
+	j.Close()
+	state, err = ReadJournal(path)
+	if err != nil {
+		t.Fatalf("read after append failed: %v", err)
+	}
+	if e, _ := state.Lookup("a.md", "html"); e.Status != JournalSucceeded {
+		t.Fatalf("expected succeeded after resume append, got %s", e.Status)
+	}
+}
+
+func TestReadJournalRejectsCorruptMiddleLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	content := `{"type":"run","kind":"batch"}
+not-json
+{"type":"queued","input":"a.md","to":"html"}
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if _, err := ReadJournal(path); err == nil {
+		t.Fatalf("expected error for corrupt middle line")
+	}
+}
+
+func TestReadJournalRequiresHeader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	if err := os.WriteFile(path, []byte(`{"type":"queued","input":"a.md"}`+"\n"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if _, err := ReadJournal(path); err == nil {
+		t.Fatalf("expected error for journal without header")
+	}
+}
+
+func TestNilJournalIsNoop(t *testing.T) {
+	var j *Journal
+	if err := j.RecordQueued([]Job{{InputPath: "a"}}); err != nil {
+		t.Fatalf("nil journal should ignore records: %v", err)
+	}
+	if err := j.RecordResult(JobResult{}); err != nil {
+		t.Fatalf("nil journal should ignore records: %v", err)
+	}
+	if err := j.Close(); err != nil {
+		t.Fatalf("nil journal close: %v", err)
+	}
+}
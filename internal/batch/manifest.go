@@ -0,0 +1,113 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Manifest girdilerin ve ürettikleri çıktıların SHA-256 özetlerini ve eşlemesini tutar.
+// Watch modunda birden fazla tur aynı manifest'e eklenir; her Write dosyanın tamamını yeniden yazar.
+type Manifest struct {
+	path    string
+	mu      sync.Mutex
+	created time.Time
+	entries []ManifestEntry
+}
+
+// ManifestEntry tek bir girdinin manifest kaydıdır. Özetler girdi taşınmadan veya
+// silinmeden önce hesaplanır; Destination girdinin son konumudur.
+type ManifestEntry struct {
+	Input       string           `json:"input"`
+	InputSHA256 string           `json:"input_sha256,omitempty"`
+	InputSize   int64            `json:"input_size"`
+	Status      string           `json:"status"`
+	Outputs     []ManifestOutput `json:"outputs"`
+	Action      string           `json:"action,omitempty"`
+	Destination string           `json:"destination,omitempty"`
+}
+
+// ManifestOutput bir girdiden üretilen çıktının manifest kaydıdır.
+type ManifestOutput struct {
+	Path   string `json:"path"`
+	Format string `json:"format,omitempty"`
+	Status string `json:"status"`
+	SHA256 string `json:"sha256,omitempty"`
+	Size   int64  `json:"size,omitempty"`
+}
+
+type manifestFile struct {
+	CreatedAt string          `json:"created_at"`
+	UpdatedAt string          `json:"updated_at"`
+	Entries   []ManifestEntry `json:"entries"`
+}
+
+// NewManifest verilen yola yazılacak boş bir manifest oluşturur.
+func NewManifest(path string) *Manifest {
+	return &Manifest{path: path, created: time.Now()}
+}
+
+// Path manifest dosyasının yolunu döner.
+func (m *Manifest) Path() string {
+	return m.path
+}
+
+// Entries şu ana kadar eklenen kayıtların kopyasını döner.
+func (m *Manifest) Entries() []ManifestEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ManifestEntry(nil), m.entries...)
+}
+
+func (m *Manifest) add(entry ManifestEntry) {
+	m.mu.Lock()
+	m.entries = append(m.entries, entry)
+	m.mu.Unlock()
+}
+
+// Write manifest'i JSON olarak yazar. Yarım kalmış bir manifest bırakmamak için
+// önce geçici dosyaya yazılır, sonra yerine taşınır.
+func (m *Manifest) Write() error {
+	m.mu.Lock()
+	payload := manifestFile{
+		CreatedAt: m.created.Format(time.RFC3339),
+		UpdatedAt: time.Now().Format(time.RFC3339),
+		Entries:   append([]ManifestEntry{}, m.entries...),
+	}
+	m.mu.Unlock()
+
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(m.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("manifest dizini oluşturulamadı: %w", err)
+		}
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
+
+// newManifestEntry girdinin ve çıktılarının özetlerini hesaplar.
+func newManifestEntry(input, status string, results []JobResult) *ManifestEntry {
+	entry := &ManifestEntry{Input: input, Status: status, Outputs: make([]ManifestOutput, 0, len(results))}
+	entry.InputSHA256, entry.InputSize = fileDigest(input)
+	for _, r := range results {
+		if r.Job.OutputPath == "" {
+			continue
+		}
+		out := ManifestOutput{Path: r.Job.OutputPath, Format: r.Job.To, Status: resultStatus(r)}
+		if r.Success || (r.Skipped && isCompletedSkip(r.SkipReason)) {
+			out.SHA256, out.Size = fileDigest(r.Job.OutputPath)
+		}
+		entry.Outputs = append(entry.Outputs, out)
+	}
+	return entry
+}
@@ -10,9 +10,11 @@ import (
 	"time"
 
 	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 )
 
-// Job bir dönüşüm işini temsil eder
+// Job bir dönüşüm işini temsil eder. Outputs doluysa aynı girdiden birden fazla
+// çıktı üretilir ve OutputPath/To/Options/SkipReason alanları yok sayılır.
 type Job struct {
 	InputPath  string
 	OutputPath string
@@ -20,6 +22,34 @@ type Job struct {
 	To         string
 	Options    converter.Options
 	SkipReason string
+	Outputs    []JobOutput
+}
+
+// JobOutput çok hedefli bir işin tek bir çıktısını tanımlar
+type JobOutput struct {
+	OutputPath string
+	To         string
+	Options    converter.Options
+	SkipReason string
+}
+
+// Targets işi çıktı başına tek hedefli işlere açar
+func (j Job) Targets() []Job {
+	if len(j.Outputs) == 0 {
+		return []Job{j}
+	}
+	targets := make([]Job, 0, len(j.Outputs))
+	for _, out := range j.Outputs {
+		targets = append(targets, Job{
+			InputPath:  j.InputPath,
+			OutputPath: out.OutputPath,
+			From:       j.From,
+			To:         out.To,
+			Options:    out.Options,
+			SkipReason: out.SkipReason,
+		})
+	}
+	return targets
 }
 
 // JobResult bir işin sonucunu tutar
@@ -28,6 +58,7 @@ type JobResult struct {
 	Success    bool
 	Skipped    bool
 	Attempts   int
+	InputSize  int64
 	OutputSize int64
 	SkipReason string
 	Error      error
@@ -44,6 +75,15 @@ type Pool struct {
 	processed  atomic.Int64
 	totalJobs  int
 	OnProgress func(completed, total int) // İlerleme callback'i
+	// OnStart bir iş worker'a alındığında, OnResult her çıktının sonucu toplandığında
+	// çağrılır; iş günlüğü (journal) bu kancalarla tutulur. OnResult tek goroutine'den çağrılır.
+	OnStart  func(job Job)
+	OnResult func(result JobResult)
+	// Schedule kategori sınırlarını, ağırlıkları, sıralamayı ve bellek bütçesini belirler.
+	Schedule Schedule
+	// Verify nil değilse her çıktı yerine taşınmadan önce doğrulanır; doğrulanamayan
+	// çıktı başarısız deneme sayılır ve retry hakkı varsa yeniden üretilir.
+	Verify *converter.VerifyOptions
 }
 
 // NewPool yeni bir worker pool oluşturur
@@ -60,6 +100,7 @@ func NewPool(workers int) *Pool {
 	return &Pool{
 		Workers:    workers,
 		RetryDelay: 500 * time.Millisecond,
+		Schedule:   DefaultSchedule(),
 	}
 }
 
@@ -75,7 +116,8 @@ func (p *Pool) SetRetry(max int, delay time.Duration) {
 	}
 }
 
-// Execute verilen işleri paralel olarak çalıştırır
+// Execute verilen işleri paralel olarak çalıştırır. Çok hedefli işler çıktı başına
+// bir sonuç üretir; ilerleme ise iş (girdi) sayısı üzerinden bildirilir.
 func (p *Pool) Execute(jobs []Job) []JobResult {
 	p.totalJobs = len(jobs)
 	p.Results = make([]JobResult, 0, len(jobs))
@@ -91,30 +133,37 @@ func (p *Pool) Execute(jobs []Job) []JobResult {
 		workers = len(jobs)
 	}
 
-	jobChan := make(chan Job, len(jobs))
-	resultChan := make(chan JobResult, len(jobs))
+	sched := newScheduler(p.Workers, p.Schedule, jobs)
+	resultChan := make(chan []JobResult, len(jobs))
 
-	// Worker'ları başlat
+	// Worker'ları başlat; her worker sıradaki işi zamanlayıcıdan alır
 	var wg sync.WaitGroup
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			for job := range jobChan {
-				result := p.processJob(job)
+			for {
+				sj, ok := sched.next()
+				if !ok {
+					return
+				}
+				if p.OnStart != nil {
+					p.OnStart(sj.job)
+				}
+				result := p.processJob(sj.job)
+				sched.done(sj)
+				// Girdi boyutu raporlardaki boyut farkı içindir; kaynak eylemleri girdiyi
+				// taşımadan önce ölçülür.
+				if info, err := os.Stat(sj.job.InputPath); err == nil {
+					for i := range result {
+						result[i].InputSize = info.Size()
+					}
+				}
 				resultChan <- result
 			}
 		}()
 	}
 
-	// İşleri gönder
-	go func() {
-		for _, job := range jobs {
-			jobChan <- job
-		}
-		close(jobChan)
-	}()
-
 	// Sonuçları topla
 	go func() {
 		wg.Wait()
@@ -122,9 +171,14 @@ func (p *Pool) Execute(jobs []Job) []JobResult {
 	}()
 
 	// Sonuçları oku ve ilerleme bildir
-	for result := range resultChan {
+	for results := range resultChan {
+		if p.OnResult != nil {
+			for _, r := range results {
+				p.OnResult(r)
+			}
+		}
 		p.mu.Lock()
-		p.Results = append(p.Results, result)
+		p.Results = append(p.Results, results...)
 		p.mu.Unlock()
 
 		completed := int(p.processed.Add(1))
@@ -136,8 +190,103 @@ func (p *Pool) Execute(jobs []Job) []JobResult {
 	return p.Results
 }
 
-// processJob tek bir dönüşüm işini gerçekleştirir
-func (p *Pool) processJob(job Job) JobResult {
+// processJob bir işin tüm çıktılarını üretir. Ortak decode destekleyen dönüştürücüye
+// giden çıktılar kaynağı tek seferde okur, kalanlar sırayla dönüştürülür.
+func (p *Pool) processJob(job Job) []JobResult {
+	targets := job.Targets()
+	if len(targets) == 1 {
+		return []JobResult{p.processTarget(targets[0])}
+	}
+
+	start := time.Now()
+	results := make([]JobResult, len(targets))
+	done := make([]bool, len(targets))
+	groups := make(map[converter.Converter][]int)
+	var order []converter.Converter
+	for i, target := range targets {
+		if target.SkipReason != "" {
+			continue
+		}
+		conv, err := converter.FindConverter(target.From, target.To)
+		if err != nil {
+			continue
+		}
+		if _, ok := conv.(converter.MultiConverter); !ok {
+			continue
+		}
+		if _, seen := groups[conv]; !seen {
+			order = append(order, conv)
+		}
+		groups[conv] = append(groups[conv], i)
+	}
+
+	for _, conv := range order {
+		indexes := groups[conv]
+		if len(indexes) < 2 {
+			continue
+		}
+		outputs := make([]converter.OutputTarget, 0, len(indexes))
+		for _, i := range indexes {
+			if err := os.MkdirAll(filepath.Dir(targets[i].OutputPath), 0755); err != nil {
+				results[i] = JobResult{
+					Job:      targets[i],
+					Attempts: 1,
+					Error:    fmt.Errorf("çıktı dizini oluşturulamadı: %w", err),
+					Duration: time.Since(start),
+				}
+				done[i] = true
+				continue
+			}
+			tmp := converter.TempOutputPath(targets[i].OutputPath)
+			os.Remove(tmp)
+			outputs = append(outputs, converter.OutputTarget{Path: tmp, Options: targets[i].Options})
+		}
+		if len(outputs) < 2 {
+			// Dizini oluşturulamayan çıktılardan sonra tek çıktı kaldıysa ortak decode'un
+			// faydası yoktur; kalan çıktı aşağıda tekil dönüşümle üretilir.
+			continue
+		}
+		pending := make([]int, 0, len(outputs))
+		for _, i := range indexes {
+			if !done[i] {
+				pending = append(pending, i)
+			}
+		}
+		errs := conv.(converter.MultiConverter).ConvertMulti(job.InputPath, outputs)
+		for k, i := range pending {
+			var err error
+			if k < len(errs) {
+				err = errs[k]
+			}
+			done[i] = true
+			tmp := converter.TempOutputPath(targets[i].OutputPath)
+			if check := p.outputCheck(targets[i]); err == nil && check != nil {
+				err = check(tmp)
+			}
+			if err == nil {
+				err = converter.CommitTempOutput(tmp, targets[i].OutputPath)
+			} else {
+				os.Remove(tmp)
+			}
+			if err == nil {
+				results[i] = successResult(targets[i], 1, start)
+				continue
+			}
+			// Ortak decode başarısız olduysa kalan denemeler tekil dönüşümle yapılır.
+			results[i] = p.convertWithRetry(conv, targets[i], 2, err, start)
+		}
+	}
+
+	for i, target := range targets {
+		if !done[i] {
+			results[i] = p.processTarget(target)
+		}
+	}
+	return results
+}
+
+// processTarget tek bir dönüşüm işini gerçekleştirir
+func (p *Pool) processTarget(job Job) JobResult {
 	start := time.Now()
 
 	if job.SkipReason != "" {
@@ -174,27 +323,34 @@ func (p *Pool) processJob(job Job) JobResult {
 		}
 	}
 
-	var lastErr error
+	return p.convertWithRetry(conv, job, 1, nil, start)
+}
+
+// convertWithRetry dönüşümü firstAttempt'ten başlayarak retry sınırına kadar dener.
+// lastErr, önceki başarısız denemenin hatasıdır; deneme hakkı kalmadıysa o döner.
+func (p *Pool) convertWithRetry(conv converter.Converter, job Job, firstAttempt int, lastErr error, start time.Time) JobResult {
 	attempts := p.RetryMax + 1
 	if attempts <= 0 {
 		attempts = 1
 	}
+	if firstAttempt > attempts {
+		return JobResult{
+			Job:      job,
+			Success:  false,
+			Attempts: attempts,
+			Error:    lastErr,
+			Duration: time.Since(start),
+		}
+	}
+	if firstAttempt > 1 && p.RetryDelay > 0 {
+		time.Sleep(p.RetryDelay)
+	}
 
-	for attempt := 1; attempt <= attempts; attempt++ {
-		// Dönüşümü yap
-		err = conv.Convert(job.InputPath, job.OutputPath, job.Options)
+	for attempt := firstAttempt; attempt <= attempts; attempt++ {
+		// Dönüşümü geçici dosyaya yap; başarılıysa çıktı atomik olarak yerine taşınır
+		err := converter.ConvertAtomicVerified(conv, job.InputPath, job.OutputPath, job.Options, p.outputCheck(job))
 		if err == nil {
-			size := int64(0)
-			if info, statErr := os.Stat(job.OutputPath); statErr == nil {
-				size = info.Size()
-			}
-			return JobResult{
-				Job:        job,
-				Success:    true,
-				Attempts:   attempt,
-				OutputSize: size,
-				Duration:   time.Since(start),
-			}
+			return successResult(job, attempt, start)
 		}
 
 		lastErr = err
@@ -212,21 +368,60 @@ func (p *Pool) processJob(job Job) JobResult {
 	}
 }
 
+// outputCheck Verify açıksa işin geçici çıktısını doğrulayan fonksiyonu döner.
+func (p *Pool) outputCheck(job Job) func(tmp string) error {
+	if p.Verify == nil {
+		return nil
+	}
+	opts := *p.Verify
+	return func(tmp string) error {
+		return converter.VerifyOutput(job.InputPath, tmp, job.From, job.To, opts)
+	}
+}
+
+func successResult(job Job, attempt int, start time.Time) JobResult {
+	size := int64(0)
+	if info, statErr := os.Stat(job.OutputPath); statErr == nil {
+		size = info.Size()
+	}
+	return JobResult{
+		Job:        job,
+		Success:    true,
+		Attempts:   attempt,
+		OutputSize: size,
+		Duration:   time.Since(start),
+	}
+}
+
+// SkipCacheHit artımlı modda çıktısı önbellekte güncel bulunan işlerin atlanma sebebidir.
+const SkipCacheHit = "cache_hit"
+
+// SkipJournalDone iş günlüğünde başarıyla tamamlanmış ve çıktısı yerinde olan hedeflerin atlanma sebebidir.
+const SkipJournalDone = "journal_done"
+
+// SkipResumeSuccess önceki batch raporunda başarılı görünen hedeflerin atlanma sebebidir.
+const SkipResumeSuccess = "resume_success"
+
 // Summary toplu iş sonuçlarını özetler
 type Summary struct {
 	Total     int
 	Succeeded int
 	Skipped   int
 	Failed    int
+	// CacheHits atlanan işlerden önbellek isabeti olanların sayısıdır.
+	CacheHits int
 	Duration  time.Duration
 	Errors    []JobError
+	// SourceActions --on-success/--on-failure ile girdilere uygulanan eylemlerdir.
+	SourceActions []SourceAction
 }
 
 // JobError başarısız olan bir işin hata bilgisi
 type JobError struct {
-	InputFile string
-	Error     string
-	Attempts  int
+	InputFile  string
+	OutputFile string
+	Error      string
+	Attempts   int
 }
 
 // GetSummary iş sonuçlarından özet oluşturur
@@ -241,6 +436,9 @@ func GetSummary(results []JobResult, totalDuration time.Duration) Summary {
 			s.Succeeded++
 		} else if r.Skipped {
 			s.Skipped++
+			if r.SkipReason == SkipCacheHit {
+				s.CacheHits++
+			}
 		} else {
 			s.Failed++
 			msg := "bilinmeyen hata"
@@ -248,9 +446,10 @@ func GetSummary(results []JobResult, totalDuration time.Duration) Summary {
 				msg = r.Error.Error()
 			}
 			s.Errors = append(s.Errors, JobError{
-				InputFile: r.Job.InputPath,
-				Error:     msg,
-				Attempts:  r.Attempts,
+				InputFile:  r.Job.InputPath,
+				OutputFile: r.Job.OutputPath,
+				Error:      msg,
+				Attempts:   r.Attempts,
 			})
 		}
 	}
@@ -258,54 +457,25 @@ func GetSummary(results []JobResult, totalDuration time.Duration) Summary {
 	return s
 }
 
-// CollectFiles dizindeki belirli uzantıya sahip dosyaları toplar
-func CollectFiles(dir string, fromFormat string, recursive bool) ([]string, error) {
-	var files []string
-
-	walkFn := func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return nil // Erişilemeyen dosyaları atla
-		}
-
-		if d.IsDir() {
-			// Recursive değilse alt dizinlere girme
-			if !recursive && path != dir {
-				return filepath.SkipDir
-			}
-			return nil
-		}
-
-		if converter.HasFormatExtension(path, fromFormat) {
-			files = append(files, path)
-		}
-
-		return nil
-	}
-
-	if err := filepath.WalkDir(dir, walkFn); err != nil {
+// CollectFiles dizindeki belirli uzantıya sahip dosyaları toplar. fromFormat boşsa
+// tüm dosyalar alınır. Gizli dosyalar, .fileconverterignore, glob/regex, boyut, tarih
+// ve medya kuralları f ile uygulanır; f nil ise varsayılan kurallar geçerlidir.
+func CollectFiles(dir string, fromFormat string, recursive bool, f *filter.Filter) ([]string, error) {
+	files, err := f.Collect(dir, recursive, func(path string) bool {
+		return fromFormat == "" || converter.HasFormatExtension(path, fromFormat)
+	})
+	if err != nil {
 		return nil, fmt.Errorf("dizin taranamadı: %w", err)
 	}
-
 	return files, nil
 }
 
-// CollectFilesFromGlob glob pattern ile dosya toplar
-func CollectFilesFromGlob(pattern string) ([]string, error) {
-	matches, err := filepath.Glob(pattern)
+// CollectFilesFromGlob glob pattern ile dosya toplar. Desen "**" ile alt dizinleri
+// kapsayabilir; f'deki seçim kuralları eşleşen dosyalara da uygulanır.
+func CollectFilesFromGlob(pattern string, f *filter.Filter) ([]string, error) {
+	files, err := f.ExpandGlob(pattern)
 	if err != nil {
 		return nil, fmt.Errorf("glob pattern hatası: %w", err)
 	}
-
-	var files []string
-	for _, m := range matches {
-		info, err := os.Stat(m)
-		if err != nil {
-			continue
-		}
-		if !info.IsDir() {
-			files = append(files, m)
-		}
-	}
-
 	return files, nil
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -94,3 +95,265 @@ func TestPoolSkippedJobAndSummary(t *testing.T) {
 		t.Fatalf("expected failed summary to be 0, got %d", summary.Failed)
 	}
 }
+
+type sharedDecodeConverter struct {
+	from       string
+	to         []string
+	multiCalls int
+	singles    int
+	failMulti  string
+}
+
+func (s *sharedDecodeConverter) Convert(input string, output string, opts converter.Options) error {
+	s.singles++
+	return os.WriteFile(output, []byte("single"), 0644)
+}
+
+func (s *sharedDecodeConverter) ConvertMulti(input string, outputs []converter.OutputTarget) []error {
+	s.multiCalls++
+	errs := make([]error, len(outputs))
+	for i, out := range outputs {
+		if filepath.Ext(out.Path) == "."+s.failMulti {
+			errs[i] = fmt.Errorf("forced failure")
+			continue
+		}
+		errs[i] = os.WriteFile(out.Path, []byte("multi"), 0644)
+	}
+	return errs
+}
+
+func (s *sharedDecodeConverter) SupportsConversion(from, to string) bool {
+	if from != s.from {
+		return false
+	}
+	for _, t := range s.to {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *sharedDecodeConverter) Name() string {
+	return "shared"
+}
+
+func (s *sharedDecodeConverter) SupportedConversions() []converter.ConversionPair {
+	var pairs []converter.ConversionPair
+	for _, t := range s.to {
+		pairs = append(pairs, converter.ConversionPair{From: s.from, To: t})
+	}
+	return pairs
+}
+
+func TestPoolMultiOutputJobSharesDecode(t *testing.T) {
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	from, toA, toB := "msfrom"+suffix, "msa"+suffix, "msb"+suffix
+	sc := &sharedDecodeConverter{from: from, to: []string{toA, toB}, failMulti: toB}
+	converter.Register(sc)
+
+	dir := t.TempDir()
+	job := Job{
+		InputPath: filepath.Join(dir, "in."+from),
+		From:      from,
+		Outputs: []JobOutput{
+			{OutputPath: filepath.Join(dir, "out."+toA), To: toA},
+			{OutputPath: filepath.Join(dir, "out."+toB), To: toB},
+			{OutputPath: filepath.Join(dir, "skip."+toA), To: toA, SkipReason: "output_exists"},
+		},
+	}
+
+	pool := NewPool(1)
+	pool.SetRetry(1, 0)
+	results := pool.Execute([]Job{job})
+	if len(results) != 3 {
+		t.Fatalf("expected one result per output, got %d", len(results))
+	}
+	if sc.multiCalls != 1 {
+		t.Fatalf("expected a single shared decode, got %d", sc.multiCalls)
+	}
+	if !results[0].Success || results[0].Attempts != 1 || results[0].Job.To != toA {
+		t.Fatalf("unexpected first result: %+v", results[0])
+	}
+	// Ortak decode'da başarısız olan çıktı retry ile tekil dönüşüme düşer.
+	if !results[1].Success || results[1].Attempts != 2 || sc.singles != 1 {
+		t.Fatalf("expected fallback retry success, got %+v (singles=%d)", results[1], sc.singles)
+	}
+	if !results[2].Skipped || results[2].SkipReason != "output_exists" {
+		t.Fatalf("expected skipped output, got %+v", results[2])
+	}
+	for _, r := range results {
+		if r.Job.InputPath != job.InputPath {
+			t.Fatalf("expected results to keep input path, got %s", r.Job.InputPath)
+		}
+	}
+}
+
+func TestPoolMultiOutputFailureWithoutRetry(t *testing.T) {
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	from, toA, toB := "mffrom"+suffix, "mfa"+suffix, "mfb"+suffix
+	sc := &sharedDecodeConverter{from: from, to: []string{toA, toB}, failMulti: toA}
+	converter.Register(sc)
+
+	dir := t.TempDir()
+	results := NewPool(1).Execute([]Job{{
+		InputPath: filepath.Join(dir, "in."+from),
+		From:      from,
+		Outputs: []JobOutput{
+			{OutputPath: filepath.Join(dir, "out."+toA), To: toA},
+			{OutputPath: filepath.Join(dir, "out."+toB), To: toB},
+		},
+	}})
+	if results[0].Success || results[0].Error == nil || results[0].Attempts != 1 {
+		t.Fatalf("expected failed output without retry, got %+v", results[0])
+	}
+	if sc.singles != 0 {
+		t.Fatalf("did not expect single conversions, got %d", sc.singles)
+	}
+	summary := GetSummary(results, 0)
+	if summary.Total != 2 || summary.Succeeded != 1 || summary.Failed != 1 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if summary.Errors[0].OutputFile != filepath.Join(dir, "out."+toA) {
+		t.Fatalf("expected failed output path in summary, got %s", summary.Errors[0].OutputFile)
+	}
+}
+
+func TestPoolMultiOutputSkipsSharedDecodeForSingleRemainingOutput(t *testing.T) {
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	from, toA, toB := "mrfrom"+suffix, "mra"+suffix, "mrb"+suffix
+	sc := &sharedDecodeConverter{from: from, to: []string{toA, toB}}
+	converter.Register(sc)
+
+	dir := t.TempDir()
+	// Normal bir dosyanın altında dizin oluşturulamaz
+	blocker := filepath.Join(dir, "blocker")
+	if err := os.WriteFile(blocker, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	results := NewPool(1).Execute([]Job{{
+		InputPath: filepath.Join(dir, "in."+from),
+		From:      from,
+		Outputs: []JobOutput{
+			{OutputPath: filepath.Join(blocker, "sub", "out."+toA), To: toA},
+			{OutputPath: filepath.Join(dir, "out."+toB), To: toB},
+		},
+	}})
+	if results[0].Success || results[0].Error == nil || !strings.Contains(results[0].Error.Error(), "dizini") {
+		t.Fatalf("expected mkdir failure for first output, got %+v", results[0])
+	}
+	if !results[1].Success {
+		t.Fatalf("expected remaining output to succeed, got %+v", results[1])
+	}
+	if sc.multiCalls != 0 || sc.singles != 1 {
+		t.Fatalf("expected single conversion without shared decode, got multi=%d singles=%d", sc.multiCalls, sc.singles)
+	}
+
+	// Hiç çıktı kalmazsa dönüştürücü hiç çağrılmamalı
+	sc.singles = 0
+	results = NewPool(1).Execute([]Job{{
+		InputPath: filepath.Join(dir, "in."+from),
+		From:      from,
+		Outputs: []JobOutput{
+			{OutputPath: filepath.Join(blocker, "a", "out."+toA), To: toA},
+			{OutputPath: filepath.Join(blocker, "b", "out."+toB), To: toB},
+		},
+	}})
+	if results[0].Success || results[1].Success {
+		t.Fatalf("expected both outputs to fail, got %+v", results)
+	}
+	if sc.multiCalls != 0 {
+		t.Fatalf("expected no shared decode without outputs, got %d", sc.multiCalls)
+	}
+}
+
+func TestCollectFilesAllFormats(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.png", "b.md", "c", ".hidden"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+
+	files, err := CollectFiles(dir, "", false, nil)
+	if err != nil {
+		t.Fatalf("CollectFiles failed: %v", err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("expected every visible file, got %v", files)
+	}
+}
+
+func TestPoolHooksAndAtomicOutput(t *testing.T) {
+	from := "utfrom" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	to := "utto" + strconv.FormatInt(time.Now().UnixNano()+1, 36)
+	converter.Register(&flakyConverter{from: from, to: to, failBefore: 1})
+
+	dir := t.TempDir()
+	output := filepath.Join(dir, "out."+to)
+	var started, finished []string
+	pool := NewPool(1)
+	pool.OnStart = func(job Job) { started = append(started, job.InputPath) }
+	pool.OnResult = func(r JobResult) { finished = append(finished, r.Job.OutputPath) }
+	results := pool.Execute([]Job{{InputPath: "in." + from, OutputPath: output, From: from, To: to}})
+
+	if len(started) != 1 || len(finished) != 1 || finished[0] != output {
+		t.Fatalf("unexpected hook calls: started=%v finished=%v", started, finished)
+	}
+	if results[0].Success {
+		t.Fatalf("expected first attempt to fail")
+	}
+	// Başarısız denemede hedef yolda da geçici dosyada da artık kalmamalı
+	for _, p := range []string{output, converter.TempOutputPath(output)} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be absent, stat err: %v", p, err)
+		}
+	}
+}
+
+// emptyOutputConverter ilk emptyBefore denemede boş çıktı üretir.
+type emptyOutputConverter struct {
+	flakyConverter
+	emptyBefore int
+}
+
+func (e *emptyOutputConverter) Convert(input string, output string, opts converter.Options) error {
+	e.attempts++
+	if e.attempts <= e.emptyBefore {
+		return os.WriteFile(output, nil, 0644)
+	}
+	return os.WriteFile(output, []byte("ok"), 0644)
+}
+
+func TestPoolVerifyRetriesInvalidOutput(t *testing.T) {
+	from := "utfrom" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	to := "utto" + strconv.FormatInt(time.Now().UnixNano()+1, 36)
+	ec := &emptyOutputConverter{flakyConverter: flakyConverter{from: from, to: to}, emptyBefore: 1}
+	converter.Register(ec)
+
+	dir := t.TempDir()
+	job := Job{InputPath: filepath.Join(dir, "in."+from), OutputPath: filepath.Join(dir, "out."+to), From: from, To: to}
+
+	pool := NewPool(1)
+	pool.SetRetry(1, 0)
+	pool.Verify = &converter.VerifyOptions{}
+	r := pool.Execute([]Job{job})[0]
+	if !r.Success || r.Attempts != 2 {
+		t.Fatalf("expected success on second attempt, got %+v", r)
+	}
+
+	// Retry hakkı yoksa doğrulama hatası başarısızlık olarak döner ve çıktı bırakılmaz
+	ec.attempts = 0
+	os.Remove(job.OutputPath)
+	pool.SetRetry(0, 0)
+	r = pool.Execute([]Job{job})[0]
+	if r.Success || r.Error == nil || !strings.Contains(r.Error.Error(), "doğrulanamadı") {
+		t.Fatalf("expected verification failure, got %+v", r)
+	}
+	if _, err := os.Stat(job.OutputPath); !os.IsNotExist(err) {
+		t.Fatalf("unverified output must not be committed")
+	}
+	if _, err := os.Stat(converter.TempOutputPath(job.OutputPath)); !os.IsNotExist(err) {
+		t.Fatalf("temp output must be removed")
+	}
+}
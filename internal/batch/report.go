@@ -5,34 +5,110 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/report"
 )
 
 const (
-	ReportOff  = "off"
-	ReportTXT  = "txt"
-	ReportJSON = "json"
+	ReportOff      = "off"
+	ReportTXT      = "txt"
+	ReportJSON     = "json"
+	ReportCSV      = report.FormatCSV
+	ReportJUnit    = report.FormatJUnit
+	ReportMarkdown = report.FormatMarkdown
+	ReportHTML     = report.FormatHTML
 )
 
 type reportItem struct {
 	Input      string `json:"input"`
 	Output     string `json:"output"`
+	Format     string `json:"format,omitempty"`
 	Status     string `json:"status"`
 	Attempts   int    `json:"attempts,omitempty"`
 	DurationMS int64  `json:"duration_ms"`
+	InputSize  int64  `json:"input_size,omitempty"`
 	OutputSize int64  `json:"output_size,omitempty"`
 	Error      string `json:"error,omitempty"`
 	SkipReason string `json:"skip_reason,omitempty"`
 }
 
 type reportPayload struct {
-	StartedAt string       `json:"started_at"`
-	EndedAt   string       `json:"ended_at"`
-	Duration  string       `json:"duration"`
-	Total     int          `json:"total"`
-	Succeeded int          `json:"succeeded"`
-	Skipped   int          `json:"skipped"`
-	Failed    int          `json:"failed"`
-	Items     []reportItem `json:"items"`
+	StartedAt string        `json:"started_at"`
+	EndedAt   string        `json:"ended_at"`
+	Duration  string        `json:"duration"`
+	Total     int           `json:"total"`
+	Succeeded int           `json:"succeeded"`
+	Skipped   int           `json:"skipped"`
+	Failed    int           `json:"failed"`
+	CacheHits int           `json:"cache_hits,omitempty"`
+	Items     []reportItem  `json:"items"`
+	Inputs    []reportInput `json:"inputs"`
+}
+
+// reportInput aynı girdiden üretilen çıktıları tek grupta toplar.
+type reportInput struct {
+	Input        string        `json:"input"`
+	Status       string        `json:"status"`
+	Outputs      []reportItem  `json:"outputs"`
+	SourceAction *SourceAction `json:"source_action,omitempty"`
+}
+
+// inputGroup bir girdinin sonuçlarını ilk görüldüğü sırayla tutar.
+type inputGroup struct {
+	Input   string
+	Results []JobResult
+}
+
+// groupResultsByInput sonuçları girdi dosyasına göre gruplar; sıra korunur.
+func groupResultsByInput(results []JobResult) []inputGroup {
+	index := make(map[string]int, len(results))
+	var groups []inputGroup
+	for _, r := range results {
+		i, ok := index[r.Job.InputPath]
+		if !ok {
+			i = len(groups)
+			index[r.Job.InputPath] = i
+			groups = append(groups, inputGroup{Input: r.Job.InputPath})
+		}
+		groups[i].Results = append(groups[i].Results, r)
+	}
+	return groups
+}
+
+// hasMultipleOutputs en az bir girdiden birden fazla çıktı üretildiyse true döner.
+func hasMultipleOutputs(groups []inputGroup) bool {
+	for _, g := range groups {
+		if len(g.Results) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func resultStatus(r JobResult) string {
+	switch {
+	case r.Success:
+		return "success"
+	case r.Skipped:
+		return "skipped"
+	default:
+		return "failed"
+	}
+}
+
+// groupStatus bir girdinin genel durumunu belirler: herhangi bir çıktı başarısızsa
+// failed, en az biri başarılıysa success, hepsi atlandıysa skipped.
+func groupStatus(results []JobResult) string {
+	status := "skipped"
+	for _, r := range results {
+		switch resultStatus(r) {
+		case "failed":
+			return "failed"
+		case "success":
+			status = "success"
+		}
+	}
+	return status
 }
 
 // NormalizeReportFormat rapor formatını normalize eder.
@@ -45,7 +121,7 @@ func NormalizeReportFormat(format string) string {
 	case ReportJSON:
 		return ReportJSON
 	default:
-		return ""
+		return report.NormalizeFormat(format)
 	}
 }
 
@@ -58,6 +134,8 @@ func RenderReport(format string, summary Summary, results []JobResult, startedAt
 		return renderTXTReport(summary, results, startedAt, endedAt), nil
 	case ReportJSON:
 		return renderJSONReport(summary, results, startedAt, endedAt)
+	case ReportCSV, ReportJUnit, ReportMarkdown, ReportHTML:
+		return report.Render(format, reportDocument(summary, results, startedAt, endedAt))
 	default:
 		return "", fmt.Errorf("gecersiz report formati: %s", format)
 	}
@@ -75,61 +153,96 @@ func renderTXTReport(summary Summary, results []JobResult, startedAt, endedAt ti
 	b.WriteString(fmt.Sprintf("Succeeded: %d\n", summary.Succeeded))
 	b.WriteString(fmt.Sprintf("Skipped:   %d\n", summary.Skipped))
 	b.WriteString(fmt.Sprintf("Failed:    %d\n", summary.Failed))
-	b.WriteString("\nItems:\n")
-
-	for _, r := range results {
-		status := "failed"
-		switch {
-		case r.Success:
-			status = "success"
-		case r.Skipped:
-			status = "skipped"
-		}
+	if summary.CacheHits > 0 {
+		b.WriteString(fmt.Sprintf("Cache hit: %d\n", summary.CacheHits))
+	}
 
-		b.WriteString(fmt.Sprintf("- [%s] %s -> %s", status, r.Job.InputPath, r.Job.OutputPath))
-		if r.Attempts > 0 {
-			b.WriteString(fmt.Sprintf(" (attempts=%d)", r.Attempts))
-		}
-		if r.OutputSize > 0 {
-			b.WriteString(fmt.Sprintf(" (size=%d)", r.OutputSize))
+	groups := groupResultsByInput(results)
+	if hasMultipleOutputs(groups) {
+		b.WriteString("\nInputs:\n")
+		for _, g := range groups {
+			b.WriteString(fmt.Sprintf("- [%s] %s\n", groupStatus(g.Results), g.Input))
+			for _, r := range g.Results {
+				b.WriteString(fmt.Sprintf("  - [%s] %s", resultStatus(r), outputLabel(r)))
+				writeTXTResultDetails(&b, r)
+			}
 		}
-		if r.Skipped && r.SkipReason != "" {
-			b.WriteString(fmt.Sprintf(" (reason=%s)", r.SkipReason))
+	} else {
+		b.WriteString("\nItems:\n")
+		for _, r := range results {
+			b.WriteString(fmt.Sprintf("- [%s] %s -> %s", resultStatus(r), r.Job.InputPath, outputLabel(r)))
+			writeTXTResultDetails(&b, r)
 		}
-		if r.Error != nil {
-			b.WriteString(fmt.Sprintf(" (error=%s)", r.Error.Error()))
+	}
+
+	if len(summary.SourceActions) > 0 {
+		b.WriteString("\nSource actions:\n")
+		for _, a := range summary.SourceActions {
+			b.WriteString(fmt.Sprintf("- [%s] %s", a.Action, a.Input))
+			if a.Destination != "" {
+				b.WriteString(fmt.Sprintf(" -> %s", a.Destination))
+			}
+			if a.Reason != "" {
+				b.WriteString(fmt.Sprintf(" (reason=%s)", a.Reason))
+			}
+			if a.Error != "" {
+				b.WriteString(fmt.Sprintf(" (error=%s)", a.Error))
+			}
+			b.WriteString("\n")
 		}
-		b.WriteString("\n")
 	}
 
 	return b.String()
 }
 
+// outputLabel çıktı yolu planlanmamış (ör. yönlendirilemeyen) işler için "-" döner.
+func outputLabel(r JobResult) string {
+	if r.Job.OutputPath == "" {
+		return "-"
+	}
+	return r.Job.OutputPath
+}
+
+func writeTXTResultDetails(b *strings.Builder, r JobResult) {
+	if r.Attempts > 0 {
+		b.WriteString(fmt.Sprintf(" (attempts=%d)", r.Attempts))
+	}
+	if r.OutputSize > 0 {
+		b.WriteString(fmt.Sprintf(" (size=%d)", r.OutputSize))
+	}
+	if r.Skipped && r.SkipReason != "" {
+		b.WriteString(fmt.Sprintf(" (reason=%s)", r.SkipReason))
+	}
+	if r.Error != nil {
+		b.WriteString(fmt.Sprintf(" (error=%s)", r.Error.Error()))
+	}
+	b.WriteString("\n")
+}
+
 func renderJSONReport(summary Summary, results []JobResult, startedAt, endedAt time.Time) (string, error) {
 	items := make([]reportItem, 0, len(results))
 	for _, r := range results {
-		item := reportItem{
-			Input:      r.Job.InputPath,
-			Output:     r.Job.OutputPath,
-			Attempts:   r.Attempts,
-			DurationMS: r.Duration.Milliseconds(),
-			OutputSize: r.OutputSize,
-		}
+		items = append(items, newReportItem(r))
+	}
 
-		switch {
-		case r.Success:
-			item.Status = "success"
-		case r.Skipped:
-			item.Status = "skipped"
-			item.SkipReason = r.SkipReason
-		default:
-			item.Status = "failed"
-			if r.Error != nil {
-				item.Error = r.Error.Error()
-			}
-		}
+	sourceActions := make(map[string]*SourceAction, len(summary.SourceActions))
+	for i := range summary.SourceActions {
+		sourceActions[summary.SourceActions[i].Input] = &summary.SourceActions[i]
+	}
 
-		items = append(items, item)
+	groups := groupResultsByInput(results)
+	inputs := make([]reportInput, 0, len(groups))
+	for _, g := range groups {
+		outputs := make([]reportItem, 0, len(g.Results))
+		for _, r := range g.Results {
+			outputs = append(outputs, newReportItem(r))
+		}
+		inputs = append(inputs, reportInput{
+			Input:        g.Input,
+			Status:       groupStatus(g.Results),
+			Outputs:      outputs,
+			SourceAction: sourceActions[g.Input],
+		})
 	}
 
 	payload := reportPayload{
@@ -140,7 +253,9 @@ func renderJSONReport(summary Summary, results []JobResult, startedAt, endedAt t
 		Succeeded: summary.Succeeded,
 		Skipped:   summary.Skipped,
 		Failed:    summary.Failed,
+		CacheHits: summary.CacheHits,
 		Items:     items,
+		Inputs:    inputs,
 	}
 
 	data, err := json.MarshalIndent(payload, "", "  ")
@@ -149,3 +264,73 @@ func renderJSONReport(summary Summary, results []JobResult, startedAt, endedAt t
 	}
 	return string(data), nil
 }
+
+func newReportItem(r JobResult) reportItem {
+	item := reportItem{
+		Input:      r.Job.InputPath,
+		Output:     r.Job.OutputPath,
+		Format:     r.Job.To,
+		Status:     resultStatus(r),
+		Attempts:   r.Attempts,
+		DurationMS: r.Duration.Milliseconds(),
+		InputSize:  r.InputSize,
+		OutputSize: r.OutputSize,
+	}
+	switch {
+	case r.Skipped:
+		item.SkipReason = r.SkipReason
+	case !r.Success && r.Error != nil:
+		item.Error = r.Error.Error()
+	}
+	return item
+}
+
+// reportDocument batch sonucunu CSV/JUnit/Markdown/HTML raporlarının ortak modeline çevirir.
+func reportDocument(summary Summary, results []JobResult, startedAt, endedAt time.Time) report.Document {
+	doc := report.Document{
+		Title:     "Batch Report",
+		Suite:     "batch",
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Duration:  summary.Duration,
+		Total:     summary.Total,
+		Succeeded: summary.Succeeded,
+		Skipped:   summary.Skipped,
+		Failed:    summary.Failed,
+		Items:     make([]report.Item, 0, len(results)),
+	}
+	if summary.CacheHits > 0 {
+		doc.Extra = append(doc.Extra, report.Field{Label: "Cache hit", Value: fmt.Sprint(summary.CacheHits)})
+	}
+
+	notes := make(map[string]string, len(summary.SourceActions))
+	for _, a := range summary.SourceActions {
+		note := "source=" + a.Action
+		if a.Destination != "" {
+			note += " -> " + a.Destination
+		}
+		if a.Error != "" {
+			note += " (error=" + a.Error + ")"
+		}
+		notes[a.Input] = note
+	}
+
+	for _, r := range results {
+		item := newReportItem(r)
+		doc.Items = append(doc.Items, report.Item{
+			Group:      r.Job.InputPath,
+			Input:      item.Input,
+			Output:     item.Output,
+			Format:     item.Format,
+			Status:     item.Status,
+			Attempts:   item.Attempts,
+			Duration:   r.Duration,
+			InputSize:  item.InputSize,
+			OutputSize: item.OutputSize,
+			Reason:     item.SkipReason,
+			Error:      item.Error,
+			Note:       notes[r.Job.InputPath],
+		})
+	}
+	return doc
+}
@@ -86,6 +86,115 @@ func TestRenderReportJSON(t *testing.T) {
 	}
 }
 
+func TestRenderReportGroupsByInput(t *testing.T) {
+	summary := Summary{Total: 3, Succeeded: 2, Failed: 1, Duration: time.Second}
+	results := []JobResult{
+		{Job: Job{InputPath: "a.png", OutputPath: "a.jpg", To: "jpg"}, Success: true, Attempts: 1},
+		{Job: Job{InputPath: "b.png", OutputPath: "b.jpg", To: "jpg"}, Success: true, Attempts: 1},
+		{Job: Job{InputPath: "a.png", OutputPath: "a.webp", To: "webp"}, Attempts: 1, Error: errStub("boom")},
+	}
+
+	txt, err := RenderReport(ReportTXT, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatalf("RenderReport failed: %v", err)
+	}
+	if !strings.Contains(txt, "Inputs:") || !strings.Contains(txt, "- [failed] a.png\n  - [success] a.jpg") {
+		t.Fatalf("expected grouped txt report, got:\n%s", txt)
+	}
+	if !strings.Contains(txt, "  - [failed] a.webp (attempts=1) (error=boom)") {
+		t.Fatalf("missing grouped failure line:\n%s", txt)
+	}
+
+	out, err := RenderReport(ReportJSON, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatalf("RenderReport failed: %v", err)
+	}
+	var payload struct {
+		Items  []reportItem  `json:"items"`
+		Inputs []reportInput `json:"inputs"`
+	}
+	if err := json.Unmarshal([]byte(out), &payload); err != nil {
+		t.Fatalf("invalid json output: %v", err)
+	}
+	if len(payload.Items) != 3 || len(payload.Inputs) != 2 {
+		t.Fatalf("unexpected items/inputs: %d/%d", len(payload.Items), len(payload.Inputs))
+	}
+	first := payload.Inputs[0]
+	if first.Input != "a.png" || first.Status != "failed" || len(first.Outputs) != 2 {
+		t.Fatalf("unexpected first group: %+v", first)
+	}
+	if first.Outputs[1].Format != "webp" || first.Outputs[1].Error != "boom" {
+		t.Fatalf("unexpected grouped output: %+v", first.Outputs[1])
+	}
+	if payload.Inputs[1].Status != "success" {
+		t.Fatalf("unexpected second group status: %s", payload.Inputs[1].Status)
+	}
+}
+
 type errStub string
 
 func (e errStub) Error() string { return string(e) }
+
+func TestReportCountsCacheHits(t *testing.T) {
+	results := []JobResult{
+		{Job: Job{InputPath: "a.jpg", OutputPath: "a.webp"}, Skipped: true, SkipReason: SkipCacheHit},
+		{Job: Job{InputPath: "b.jpg", OutputPath: "b.webp"}, Skipped: true, SkipReason: "output_exists"},
+		{Job: Job{InputPath: "c.jpg", OutputPath: "c.webp"}, Success: true, Attempts: 1},
+	}
+	summary := GetSummary(results, time.Second)
+	if summary.Skipped != 2 || summary.CacheHits != 1 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	out, err := RenderReport(ReportJSON, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatalf("RenderReport failed: %v", err)
+	}
+	var payload struct {
+		CacheHits int `json:"cache_hits"`
+	}
+	if err := json.Unmarshal([]byte(out), &payload); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if payload.CacheHits != 1 {
+		t.Fatalf("expected cache_hits=1, got %d", payload.CacheHits)
+	}
+
+	out, err = RenderReport(ReportTXT, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatalf("RenderReport failed: %v", err)
+	}
+	if !strings.Contains(out, "Cache hit: 1") || !strings.Contains(out, "(reason=cache_hit)") {
+		t.Fatalf("txt report should mention cache hits:\n%s", out)
+	}
+}
+
+func TestRenderReportExtraFormats(t *testing.T) {
+	summary := Summary{Total: 2, Succeeded: 1, Failed: 1, Duration: time.Second}
+	results := []JobResult{
+		{Job: Job{InputPath: "a.jpg", OutputPath: "a.webp", To: "webp"}, Success: true, Attempts: 1, InputSize: 200, OutputSize: 100},
+		{Job: Job{InputPath: "b.jpg", OutputPath: "b.webp", To: "webp"}, Attempts: 2, Error: errStub("boom")},
+	}
+
+	for _, format := range []string{"csv", "junit", "md", "html"} {
+		normalized := NormalizeReportFormat(format)
+		if normalized == "" {
+			t.Fatalf("expected %s to be a valid report format", format)
+		}
+		out, err := RenderReport(normalized, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+		if err != nil {
+			t.Fatalf("RenderReport %s failed: %v", format, err)
+		}
+		if !strings.Contains(out, "a.webp") || !strings.Contains(out, "boom") {
+			t.Fatalf("%s report missing items:\n%s", format, out)
+		}
+	}
+
+	out, err := RenderReport(ReportCSV, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "a.jpg,a.webp,webp,success,1,0,200,100,-100") {
+		t.Fatalf("unexpected csv row:\n%s", out)
+	}
+}
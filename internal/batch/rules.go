@@ -0,0 +1,152 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// MatchAny tüm dosyalara uyan yedek kural eşleşmesidir.
+const MatchAny = "*"
+
+// ruleCategories kurallarda match olarak kullanılabilecek kategoriler.
+var ruleCategories = []string{"image", "video", "audio", "document"}
+
+// Rule bir kategori veya format grubunu hedef format(lar)a ve ayarlara yönlendirir.
+type Rule struct {
+	// Match kategori (image, video, audio, document), virgülle ayrılmış format listesi veya "*".
+	Match string `json:"match"`
+	// To hedef format; virgülle birden fazla verilebilir (ör: "jpg,webp").
+	To         string `json:"to"`
+	Quality    int    `json:"quality,omitempty"`
+	TargetSize string `json:"target_size,omitempty"`
+
+	// Ses/video encode ayarları; batch flag'leri ile aynı anlamdadır.
+	Bitrate      string `json:"bitrate,omitempty"`
+	AudioCodec   string `json:"audio_codec,omitempty"`
+	AudioBitrate string `json:"audio_bitrate,omitempty"`
+	SampleRate   int    `json:"sample_rate,omitempty"`
+	BitDepth     string `json:"bit_depth,omitempty"`
+	Channels     int    `json:"channels,omitempty"`
+	VideoCodec   string `json:"video_codec,omitempty"`
+	CRF          *int   `json:"crf,omitempty"`
+	EncodePreset string `json:"encode_preset,omitempty"`
+	PixFmt       string `json:"pix_fmt,omitempty"`
+}
+
+// Rules batch yönlendirme kuralları dosyasını temsil eder.
+type Rules struct {
+	Rules []Rule `json:"rules"`
+}
+
+// LoadRules JSON kurallar dosyasını yükler ve doğrular.
+func LoadRules(path string) (Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Rules{}, err
+	}
+
+	var r Rules
+	if err := json.Unmarshal(data, &r); err != nil {
+		return Rules{}, fmt.Errorf("kurallar dosyasi parse hatasi: %w", err)
+	}
+	if err := ValidateRules(r); err != nil {
+		return Rules{}, err
+	}
+	return r, nil
+}
+
+// ValidateRules kuralların match, to ve target_size alanlarını doğrular.
+func ValidateRules(r Rules) error {
+	if len(r.Rules) == 0 {
+		return fmt.Errorf("en az bir kural gerekli")
+	}
+	known := converter.GetAllFormats()
+	for i, rule := range r.Rules {
+		matches := ruleMatchValues(rule.Match)
+		if len(matches) == 0 {
+			return fmt.Errorf("rules[%d] match zorunlu", i)
+		}
+		for _, m := range matches {
+			if m == MatchAny || slices.Contains(ruleCategories, m) {
+				continue
+			}
+			if !slices.Contains(known, converter.NormalizeFormat(m)) {
+				return fmt.Errorf("rules[%d] bilinmeyen match: %s (kategori: %s, format veya *)", i, m, strings.Join(ruleCategories, ", "))
+			}
+		}
+		targets := RuleTargets(rule)
+		if len(targets) == 0 {
+			return fmt.Errorf("rules[%d] to zorunlu", i)
+		}
+		for _, t := range targets {
+			if !slices.Contains(known, t) {
+				return fmt.Errorf("rules[%d] desteklenmeyen hedef format: %s", i, t)
+			}
+		}
+		if strings.TrimSpace(rule.TargetSize) != "" {
+			if _, err := converter.ParseSize(rule.TargetSize); err != nil {
+				return fmt.Errorf("rules[%d] target_size gecersiz: %w", i, err)
+			}
+		}
+		if rule.Quality < 0 || rule.Quality > 100 {
+			return fmt.Errorf("rules[%d] quality 1-100 arasinda olmali", i)
+		}
+	}
+	return nil
+}
+
+// Match format için en uygun kuralı seçer: format eşleşmesi kategoriden, kategori de
+// "*" kuralından önce gelir. Aynı seviyede dosyadaki ilk kural kazanır.
+func (r Rules) Match(format string) (Rule, bool) {
+	format = converter.NormalizeFormat(format)
+	category := converter.FormatCategory(format)
+
+	best, bestLevel := -1, 0
+	for i, rule := range r.Rules {
+		level := 0
+		for _, m := range ruleMatchValues(rule.Match) {
+			switch {
+			case converter.NormalizeFormat(m) == format && format != "":
+				level = max(level, 3)
+			case m == category:
+				level = max(level, 2)
+			case m == MatchAny:
+				level = max(level, 1)
+			}
+		}
+		if level > bestLevel {
+			best, bestLevel = i, level
+		}
+	}
+	if best < 0 {
+		return Rule{}, false
+	}
+	return r.Rules[best], true
+}
+
+// RuleTargets kuralın hedef formatlarını normalize edilmiş ve tekrarsız olarak döner.
+func RuleTargets(rule Rule) []string {
+	var targets []string
+	for _, part := range strings.Split(rule.To, ",") {
+		t := converter.NormalizeFormat(part)
+		if t != "" && !slices.Contains(targets, t) {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+func ruleMatchValues(match string) []string {
+	var values []string
+	for _, part := range strings.Split(match, ",") {
+		if v := strings.ToLower(strings.TrimSpace(part)); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
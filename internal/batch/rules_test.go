@@ -0,0 +1,75 @@
+package batch
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadRules(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.json")
+	data := `{"rules":[{"match":"image","to":"webp","quality":80},{"match":"audio","to":"mp3,ogg","bitrate":"192k","crf":0}]}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	rules, err := LoadRules(path)
+	if err != nil {
+		t.Fatalf("LoadRules failed: %v", err)
+	}
+	if len(rules.Rules) != 2 || rules.Rules[0].Quality != 80 {
+		t.Fatalf("unexpected rules: %+v", rules.Rules)
+	}
+	if rules.Rules[1].CRF == nil || *rules.Rules[1].CRF != 0 {
+		t.Fatalf("expected explicit crf=0 to be kept")
+	}
+	if got := RuleTargets(rules.Rules[1]); strings.Join(got, ",") != "mp3,ogg" {
+		t.Fatalf("unexpected targets: %v", got)
+	}
+}
+
+func TestValidateRulesErrors(t *testing.T) {
+	cases := map[string]Rules{
+		"empty":       {},
+		"no match":    {Rules: []Rule{{To: "webp"}}},
+		"bad match":   {Rules: []Rule{{Match: "pictures", To: "webp"}}},
+		"no target":   {Rules: []Rule{{Match: "image"}}},
+		"bad target":  {Rules: []Rule{{Match: "image", To: "xyz"}}},
+		"bad size":    {Rules: []Rule{{Match: "image", To: "jpg", TargetSize: "big"}}},
+		"bad quality": {Rules: []Rule{{Match: "image", To: "jpg", Quality: 150}}},
+	}
+	for name, rules := range cases {
+		if err := ValidateRules(rules); err == nil {
+			t.Fatalf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestRulesMatchPriority(t *testing.T) {
+	rules := Rules{Rules: []Rule{
+		{Match: "*", To: "pdf"},
+		{Match: "image", To: "webp"},
+		{Match: "heic,heif", To: "jpg"},
+		{Match: "image", To: "png"},
+	}}
+
+	cases := map[string]string{
+		"heic": "jpg",  // format eşleşmesi kategoriden önce gelir
+		"png":  "webp", // aynı seviyede ilk kural kazanır
+		"md":   "pdf",  // yedek kural
+		"JPEG": "webp",
+	}
+	for format, want := range cases {
+		rule, ok := rules.Match(format)
+		if !ok || rule.To != want {
+			t.Fatalf("%s: expected %s, got %+v (ok=%v)", format, want, rule, ok)
+		}
+	}
+
+	noFallback := Rules{Rules: []Rule{{Match: "audio", To: "mp3"}}}
+	if _, ok := noFallback.Match("png"); ok {
+		t.Fatalf("did not expect a rule for png")
+	}
+}
@@ -0,0 +1,298 @@
+package batch
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// Zamanlama kategorileri. LibreOffice dönüşümleri belge kategorisinden ayrı
+// sınırlanır çünkü LibreOffice aynı anda çok sayıda örnekle verimli çalışmaz.
+const (
+	CategoryVideo       = "video"
+	CategoryAudio       = "audio"
+	CategoryImage       = "image"
+	CategoryDocument    = "document"
+	CategoryLibreOffice = "libreoffice"
+)
+
+// Categories geçerli zamanlama kategorileridir.
+var Categories = []string{CategoryVideo, CategoryAudio, CategoryImage, CategoryDocument, CategoryLibreOffice}
+
+// İş sıralama stratejileri.
+const (
+	OrderInput         = "input"
+	OrderLargestFirst  = "largest-first"
+	OrderSmallestFirst = "smallest-first"
+)
+
+// Schedule pool'un işleri hangi sırayla ve hangi eşzamanlılıkla çalıştıracağını belirler.
+// Sıfır değer tüm işleri girdi sırasıyla, worker sayısı kadar paralel çalıştırır.
+type Schedule struct {
+	// Limits kategori başına aynı anda çalışabilecek en fazla iş sayısıdır; 0 sınırsızdır.
+	Limits map[string]int
+	// Weights kategori başına bir işin kapladığı worker slotu sayısıdır (varsayılan 1).
+	// Örneğin video ağırlığı 4 ise 8 worker'lı pool'da aynı anda en fazla 2 video çalışır.
+	Weights map[string]int
+	// Order işlerin kuyruğa alınma sırasıdır: input, largest-first, smallest-first.
+	Order string
+	// MemoryBudget çalışan işlerin tahmini bellek toplamı için üst sınırdır (byte); 0 kapalıdır.
+	MemoryBudget int64
+}
+
+// DefaultSchedule varsayılan zamanlamayı döner: LibreOffice tek örnekle, video
+// dönüşümleri ise FFmpeg zaten çok çekirdek kullandığı için CPU sayısının dörtte biriyle sınırlanır.
+func DefaultSchedule() Schedule {
+	return Schedule{
+		Limits: map[string]int{
+			CategoryLibreOffice: 1,
+			CategoryVideo:       max(1, runtime.NumCPU()/4),
+		},
+	}
+}
+
+// NormalizeOrder sıralama stratejisini normalize eder; geçersizse boş döner.
+func NormalizeOrder(order string) string {
+	switch strings.ToLower(strings.TrimSpace(order)) {
+	case "", OrderInput:
+		return OrderInput
+	case OrderLargestFirst, "largest":
+		return OrderLargestFirst
+	case OrderSmallestFirst, "smallest":
+		return OrderSmallestFirst
+	default:
+		return ""
+	}
+}
+
+// IsCategory kategori adının geçerli olup olmadığını döner.
+func IsCategory(category string) bool {
+	for _, c := range Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// JobCategory işin zamanlama kategorisini belirler. Çok hedefli işlerde en ağır
+// hedefin kategorisi kullanılır.
+func JobCategory(job Job) string {
+	rank := map[string]int{CategoryImage: 0, CategoryDocument: 1, CategoryAudio: 2, CategoryVideo: 3, CategoryLibreOffice: 4}
+	category := ""
+	for _, t := range job.Targets() {
+		c := targetCategory(t.From, t.To)
+		if category == "" || rank[c] > rank[category] {
+			category = c
+		}
+	}
+	return category
+}
+
+func targetCategory(from, to string) string {
+	fromCat, toCat := converter.FormatCategory(from), converter.FormatCategory(to)
+	switch {
+	case fromCat == "video" || toCat == "video":
+		return CategoryVideo
+	case fromCat == "audio" || toCat == "audio":
+		return CategoryAudio
+	case converter.UsesLibreOffice(from, to):
+		return CategoryLibreOffice
+	case fromCat == "document":
+		return CategoryDocument
+	default:
+		return CategoryImage
+	}
+}
+
+// estimateJobMemory işin yaklaşık bellek ihtiyacını tahmin eder. Görseller decode
+// edildiğinde sıkıştırılmış boyutun katlarına çıktığı için boyuta göre, harici
+// araç kullanan kategoriler ise sabit değerle hesaplanır.
+func estimateJobMemory(category string, inputSize int64) int64 {
+	const mb = int64(1024 * 1024)
+	switch category {
+	case CategoryVideo, CategoryLibreOffice:
+		return 512 * mb
+	case CategoryAudio:
+		return 128 * mb
+	case CategoryDocument:
+		return 64*mb + inputSize*4
+	default:
+		return 64*mb + inputSize*10
+	}
+}
+
+// scheduledJob kuyruktaki bir işin zamanlama bilgileridir.
+type scheduledJob struct {
+	job      Job
+	category string
+	weight   int
+	memory   int64
+}
+
+// scheduler işleri kapasite, kategori sınırı ve bellek bütçesine göre worker'lara dağıtır.
+// Sıradaki iş beklemek zorundaysa arkasındaki uygun iş öne geçebilir.
+type scheduler struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	schedule Schedule
+	capacity int
+	used     int
+	memory   int64
+	running  map[string]int
+	pending  []scheduledJob
+}
+
+func newScheduler(capacity int, schedule Schedule, jobs []Job) *scheduler {
+	s := &scheduler{
+		schedule: schedule,
+		capacity: capacity,
+		running:  map[string]int{},
+		pending:  schedule.plan(jobs, capacity),
+	}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// plan işleri kategorilendirir ve seçilen stratejiye göre sıralar.
+func (sc Schedule) plan(jobs []Job, capacity int) []scheduledJob {
+	planned := make([]scheduledJob, 0, len(jobs))
+	sizes := make([]int64, 0, len(jobs))
+	for _, job := range jobs {
+		var size int64
+		if info, err := os.Stat(job.InputPath); err == nil {
+			size = info.Size()
+		}
+		sizes = append(sizes, size)
+		if !hasRunnableTarget(job) {
+			// Atlanacak işler kaynak tüketmez; sınırlara takılmadan hemen sonuçlanır
+			planned = append(planned, scheduledJob{job: job})
+			continue
+		}
+		category := JobCategory(job)
+		weight := 1
+		if w := sc.Weights[category]; w > 0 {
+			weight = min(w, capacity)
+		}
+		planned = append(planned, scheduledJob{
+			job:      job,
+			category: category,
+			weight:   weight,
+			memory:   estimateJobMemory(category, size),
+		})
+	}
+
+	order := NormalizeOrder(sc.Order)
+	if order == OrderInput {
+		return planned
+	}
+	idx := make([]int, len(planned))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		if order == OrderLargestFirst {
+			return sizes[idx[a]] > sizes[idx[b]]
+		}
+		return sizes[idx[a]] < sizes[idx[b]]
+	})
+	sorted := make([]scheduledJob, len(planned))
+	for i, k := range idx {
+		sorted[i] = planned[k]
+	}
+	return sorted
+}
+
+func hasRunnableTarget(job Job) bool {
+	for _, t := range job.Targets() {
+		if t.SkipReason == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// admissible işin şu an başlatılıp başlatılamayacağını döner. Hiç iş çalışmıyorsa
+// ağırlık ve bellek sınırı yok sayılır; aksi halde tek büyük iş kuyruğu kilitlerdi.
+func (s *scheduler) admissible(j scheduledJob) bool {
+	if limit := s.schedule.Limits[j.category]; limit > 0 && s.running[j.category] >= limit {
+		return false
+	}
+	if s.used == 0 {
+		return true
+	}
+	if s.used+j.weight > s.capacity {
+		return false
+	}
+	if budget := s.schedule.MemoryBudget; budget > 0 && s.memory+j.memory > budget {
+		return false
+	}
+	return true
+}
+
+// next çalıştırılabilir ilk işi kuyruktan alır; uygun iş yoksa bir işin bitmesini bekler.
+// Kuyruk boşsa false döner.
+func (s *scheduler) next() (scheduledJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if len(s.pending) == 0 {
+			return scheduledJob{}, false
+		}
+		for i, j := range s.pending {
+			if !s.admissible(j) {
+				continue
+			}
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			s.used += j.weight
+			s.memory += j.memory
+			s.running[j.category]++
+			return j, true
+		}
+		s.cond.Wait()
+	}
+}
+
+// done biten işin kaynaklarını serbest bırakır ve bekleyen worker'ları uyandırır.
+func (s *scheduler) done(j scheduledJob) {
+	s.mu.Lock()
+	s.used -= j.weight
+	s.memory -= j.memory
+	s.running[j.category]--
+	s.mu.Unlock()
+	s.cond.Broadcast()
+}
+
+// ParseCategoryValues "video=2,image=8" biçimindeki kategori değerlerini okur.
+// Tekrarlanan flag'lerden gelen değerler birleştirilir.
+func ParseCategoryValues(values []string) (map[string]int, error) {
+	out := map[string]int{}
+	for _, raw := range values {
+		for _, part := range strings.Split(raw, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(part, "=")
+			if !ok {
+				return nil, fmt.Errorf("gecersiz deger %q (beklenen: kategori=sayi)", part)
+			}
+			key = strings.ToLower(strings.TrimSpace(key))
+			if !IsCategory(key) {
+				return nil, fmt.Errorf("bilinmeyen kategori: %s (%s)", key, strings.Join(Categories, ", "))
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("gecersiz sayi: %s", part)
+			}
+			out[key] = n
+		}
+	}
+	return out, nil
+}
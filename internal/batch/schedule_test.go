@@ -0,0 +1,258 @@
+package batch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func takeNext(t *testing.T, s *scheduler) scheduledJob {
+	t.Helper()
+	s.mu.Lock()
+	admissible := false
+	for _, j := range s.pending {
+		if s.admissible(j) {
+			admissible = true
+			break
+		}
+	}
+	s.mu.Unlock()
+	if !admissible {
+		t.Fatalf("expected an admissible job, pending=%d", len(s.pending))
+	}
+	j, ok := s.next()
+	if !ok {
+		t.Fatalf("expected a job")
+	}
+	return j
+}
+
+func assertBlocked(t *testing.T, s *scheduler) {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range s.pending {
+		if s.admissible(j) {
+			t.Fatalf("expected all pending jobs to wait, %s is admissible", j.job.InputPath)
+		}
+	}
+}
+
+func TestSchedulerCategoryLimit(t *testing.T) {
+	jobs := []Job{
+		{InputPath: "v1.mp4", From: "mp4", To: "gif"},
+		{InputPath: "v2.mp4", From: "mp4", To: "gif"},
+		{InputPath: "p.png", From: "png", To: "jpg"},
+	}
+	s := newScheduler(4, Schedule{Limits: map[string]int{CategoryVideo: 1}}, jobs)
+
+	if j := takeNext(t, s); j.job.InputPath != "v1.mp4" || j.category != CategoryVideo {
+		t.Fatalf("unexpected first job: %+v", j)
+	}
+	// İkinci video sınıra takılır, arkasındaki görsel öne geçer
+	v1 := scheduledJob{category: CategoryVideo, weight: 1, memory: estimateJobMemory(CategoryVideo, 0)}
+	if j := takeNext(t, s); j.job.InputPath != "p.png" {
+		t.Fatalf("expected image to bypass waiting video, got %s", j.job.InputPath)
+	}
+	assertBlocked(t, s)
+	s.done(v1)
+	if j := takeNext(t, s); j.job.InputPath != "v2.mp4" {
+		t.Fatalf("expected second video after release, got %s", j.job.InputPath)
+	}
+	if _, ok := s.next(); ok {
+		t.Fatalf("expected empty queue")
+	}
+}
+
+func TestSchedulerWeights(t *testing.T) {
+	jobs := []Job{
+		{InputPath: "v1.mp4", From: "mp4", To: "webm"},
+		{InputPath: "v2.mp4", From: "mp4", To: "webm"},
+		{InputPath: "p.png", From: "png", To: "jpg"},
+	}
+	s := newScheduler(4, Schedule{Weights: map[string]int{CategoryVideo: 3}}, jobs)
+	v1 := takeNext(t, s)
+	if v1.weight != 3 {
+		t.Fatalf("expected weight 3, got %d", v1.weight)
+	}
+	if j := takeNext(t, s); j.job.InputPath != "p.png" {
+		t.Fatalf("expected image to fill remaining slot, got %s", j.job.InputPath)
+	}
+	assertBlocked(t, s)
+	s.done(v1)
+	if j := takeNext(t, s); j.job.InputPath != "v2.mp4" {
+		t.Fatalf("unexpected job: %s", j.job.InputPath)
+	}
+}
+
+func TestSchedulerWeightClampedAndIdleBypass(t *testing.T) {
+	s := newScheduler(2, Schedule{Weights: map[string]int{CategoryAudio: 8}, MemoryBudget: 1}, []Job{
+		{InputPath: "a.wav", From: "wav", To: "mp3"},
+	})
+	// Ağırlık kapasiteye indirilir; hiç iş çalışmıyorken bellek bütçesi de engellemez
+	if j := takeNext(t, s); j.weight != 2 {
+		t.Fatalf("expected weight clamped to capacity, got %d", j.weight)
+	}
+}
+
+func TestSchedulerMemoryBudget(t *testing.T) {
+	const mb = 1024 * 1024
+	jobs := []Job{
+		{InputPath: "v1.mp4", From: "mp4", To: "webm"},
+		{InputPath: "v2.mp4", From: "mp4", To: "webm"},
+		{InputPath: "p.png", From: "png", To: "jpg"},
+	}
+	s := newScheduler(8, Schedule{MemoryBudget: 600 * mb}, jobs)
+	v1 := takeNext(t, s)
+	if j := takeNext(t, s); j.job.InputPath != "p.png" {
+		t.Fatalf("expected small image to fit in budget, got %s", j.job.InputPath)
+	}
+	assertBlocked(t, s)
+	s.done(v1)
+	if j := takeNext(t, s); j.job.InputPath != "v2.mp4" {
+		t.Fatalf("unexpected job: %s", j.job.InputPath)
+	}
+}
+
+func TestSchedulerSkippedJobsIgnoreLimits(t *testing.T) {
+	jobs := []Job{
+		{InputPath: "v1.mp4", From: "mp4", To: "gif"},
+		{InputPath: "v2.mp4", From: "mp4", To: "gif", SkipReason: "output_exists"},
+	}
+	s := newScheduler(4, Schedule{Limits: map[string]int{CategoryVideo: 1}}, jobs)
+	takeNext(t, s)
+	if j := takeNext(t, s); j.job.InputPath != "v2.mp4" || j.weight != 0 {
+		t.Fatalf("skipped job should not wait for limits: %+v", j)
+	}
+}
+
+func TestSchedulePlanOrder(t *testing.T) {
+	dir := t.TempDir()
+	var jobs []Job
+	for name, size := range map[string]int{"small.png": 10, "large.png": 1000, "mid.png": 100} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, make([]byte, size), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+		jobs = append(jobs, Job{InputPath: path, From: "png", To: "jpg"})
+	}
+	names := func(planned []scheduledJob) []string {
+		var out []string
+		for _, j := range planned {
+			out = append(out, filepath.Base(j.job.InputPath))
+		}
+		return out
+	}
+
+	largest := names(Schedule{Order: OrderLargestFirst}.plan(jobs, 1))
+	if largest[0] != "large.png" || largest[2] != "small.png" {
+		t.Fatalf("unexpected largest-first order: %v", largest)
+	}
+	smallest := names(Schedule{Order: OrderSmallestFirst}.plan(jobs, 1))
+	if smallest[0] != "small.png" || smallest[2] != "large.png" {
+		t.Fatalf("unexpected smallest-first order: %v", smallest)
+	}
+	input := Schedule{}.plan(jobs, 1)
+	for i := range jobs {
+		if input[i].job.InputPath != jobs[i].InputPath {
+			t.Fatalf("input order should be preserved")
+		}
+	}
+}
+
+func TestJobCategory(t *testing.T) {
+	tests := []struct {
+		job  Job
+		want string
+	}{
+		{Job{From: "mov", To: "mp4"}, CategoryVideo},
+		{Job{From: "mp4", To: "mp3"}, CategoryVideo},
+		{Job{From: "wav", To: "flac"}, CategoryAudio},
+		{Job{From: "png", To: "webp"}, CategoryImage},
+		{Job{From: "md", To: "html"}, CategoryDocument},
+		{Job{From: "png", Outputs: []JobOutput{{To: "jpg"}, {To: "gif"}}}, CategoryImage},
+		{Job{From: "wav", Outputs: []JobOutput{{To: "mp3"}, {To: "mp4"}}}, CategoryVideo},
+	}
+	for _, tt := range tests {
+		if got := JobCategory(tt.job); got != tt.want {
+			t.Fatalf("JobCategory(%s->%s) = %s, want %s", tt.job.From, tt.job.To, got, tt.want)
+		}
+	}
+}
+
+func TestParseCategoryValues(t *testing.T) {
+	got, err := ParseCategoryValues([]string{"video=2, image=8", "libreoffice=1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got[CategoryVideo] != 2 || got[CategoryImage] != 8 || got[CategoryLibreOffice] != 1 {
+		t.Fatalf("unexpected values: %v", got)
+	}
+	for _, bad := range []string{"video", "gpu=2", "video=-1", "video=2x"} {
+		if _, err := ParseCategoryValues([]string{bad}); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+type concurrencyConverter struct {
+	from, to string
+	mu       sync.Mutex
+	active   int
+	peak     int
+}
+
+func (c *concurrencyConverter) Convert(input, output string, opts converter.Options) error {
+	c.mu.Lock()
+	c.active++
+	c.peak = max(c.peak, c.active)
+	c.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	c.mu.Lock()
+	c.active--
+	c.mu.Unlock()
+	return os.WriteFile(output, []byte("ok"), 0644)
+}
+
+func (c *concurrencyConverter) SupportsConversion(from, to string) bool {
+	return from == c.from && to == c.to
+}
+
+func (c *concurrencyConverter) Name() string { return "concurrency" }
+
+func (c *concurrencyConverter) SupportedConversions() []converter.ConversionPair {
+	return []converter.ConversionPair{{From: c.from, To: c.to}}
+}
+
+func TestPoolExecuteRespectsCategoryLimit(t *testing.T) {
+	from := "utfrom" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	to := "utto" + strconv.FormatInt(time.Now().UnixNano()+1, 36)
+	cc := &concurrencyConverter{from: from, to: to}
+	converter.Register(cc)
+
+	dir := t.TempDir()
+	var jobs []Job
+	for i := 0; i < 6; i++ {
+		jobs = append(jobs, Job{
+			InputPath:  filepath.Join(dir, fmt.Sprintf("in%d.%s", i, from)),
+			OutputPath: filepath.Join(dir, fmt.Sprintf("out%d.%s", i, to)),
+			From:       from,
+			To:         to,
+		})
+	}
+	pool := NewPool(4)
+	pool.Schedule = Schedule{Limits: map[string]int{CategoryImage: 2}}
+	results := pool.Execute(jobs)
+	if summary := GetSummary(results, 0); summary.Succeeded != len(jobs) {
+		t.Fatalf("expected all jobs to succeed: %+v", summary)
+	}
+	if cc.peak > 2 {
+		t.Fatalf("category limit exceeded: peak concurrency %d", cc.peak)
+	}
+}
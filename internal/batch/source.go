@@ -0,0 +1,318 @@
+package batch
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/cache"
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// Kaynak dosya eylemleri. Dönüşüm bittikten sonra girdinin ne olacağını belirler.
+const (
+	SourceKeep   = "keep"
+	SourceMove   = "move"
+	SourceDelete = "delete"
+)
+
+// SourcePolicy bir girdiye uygulanacak eylemdir. Dir yalnızca move için kullanılır.
+type SourcePolicy struct {
+	Action string
+	Dir    string
+}
+
+// String politikayı flag biçiminde döner (ör: "move:./done").
+func (p SourcePolicy) String() string {
+	if p.Action == SourceMove {
+		return SourceMove + ":" + p.Dir
+	}
+	if p.Action == "" {
+		return SourceKeep
+	}
+	return p.Action
+}
+
+// ParseSourcePolicy "keep", "delete" veya "move:<dizin>" değerini okur.
+// allowDelete false ise delete kabul edilmez (başarısız girdiler silinmemelidir).
+func ParseSourcePolicy(value string, allowDelete bool) (SourcePolicy, error) {
+	value = strings.TrimSpace(value)
+	action, dir, hasDir := strings.Cut(value, ":")
+	switch strings.ToLower(strings.TrimSpace(action)) {
+	case "", SourceKeep:
+		if hasDir {
+			break
+		}
+		return SourcePolicy{Action: SourceKeep}, nil
+	case SourceDelete:
+		if hasDir || !allowDelete {
+			break
+		}
+		return SourcePolicy{Action: SourceDelete}, nil
+	case SourceMove:
+		dir = strings.TrimSpace(dir)
+		if dir == "" {
+			return SourcePolicy{}, fmt.Errorf("move için hedef dizin gerekli (ör: move:./done)")
+		}
+		return SourcePolicy{Action: SourceMove, Dir: dir}, nil
+	}
+	if allowDelete {
+		return SourcePolicy{}, fmt.Errorf("gecersiz kaynak eylemi: %s (beklenen: keep, delete, move:<dizin>)", value)
+	}
+	return SourcePolicy{}, fmt.Errorf("gecersiz kaynak eylemi: %s (beklenen: keep, move:<dizin>)", value)
+}
+
+// SourceAction bir girdiye uygulanan eylemin sonucudur ve batch raporuna yazılır.
+type SourceAction struct {
+	Input       string `json:"input"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+	Destination string `json:"destination,omitempty"`
+	// Reason politika uygulanmadığında girdinin neden yerinde bırakıldığını açıklar.
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Girdinin olduğu yerde bırakılma sebepleri.
+const (
+	sourceReasonSkipped    = "skipped"
+	sourceReasonUnverified = "output_unverified"
+	// sourceReasonInPlace çıktı girdinin üzerine yazıldığında kullanılır; girdiyi
+	// taşımak veya silmek yeni üretilen çıktıyı kaybettirir.
+	sourceReasonInPlace = "output_is_source"
+)
+
+// SourceHandler dönüşüm sonuçlarına göre girdileri taşır, siler veya yerinde bırakır.
+// Eylemler yalnızca çıktılar doğrulandıktan sonra uygulanır: başarılı görünen ama
+// çıktısı eksik ya da boş olan girdiler başarısız sayılır.
+type SourceHandler struct {
+	OnSuccess SourcePolicy
+	OnFailure SourcePolicy
+	// Root taşımada korunacak klasör yapısının köküdür; boşsa yalnızca dosya adı kullanılır.
+	Root string
+	// Manifest nil değilse girdilerin ve çıktıların SHA-256 özetleri kaydedilir.
+	Manifest *Manifest
+}
+
+// Active handler'ın girdilere dokunacak ya da manifest yazacak bir işi olup olmadığını döner.
+func (h *SourceHandler) Active() bool {
+	if h == nil {
+		return false
+	}
+	return h.Manifest != nil || h.OnSuccess.Action == SourceMove || h.OnSuccess.Action == SourceDelete ||
+		h.OnFailure.Action == SourceMove
+}
+
+// Apply sonuçları girdiye göre gruplar ve her girdiye uygun politikayı uygular.
+// Manifest özetleri girdi taşınmadan önce hesaplanır.
+func (h *SourceHandler) Apply(results []JobResult) []SourceAction {
+	if !h.Active() {
+		return nil
+	}
+	groups := groupResultsByInput(results)
+	actions := make([]SourceAction, 0, len(groups))
+	for _, g := range groups {
+		actions = append(actions, h.applyGroup(g))
+	}
+	return actions
+}
+
+func (h *SourceHandler) applyGroup(g inputGroup) SourceAction {
+	status, reason := sourceStatus(g.Results)
+	action := SourceAction{Input: g.Input, Status: status, Action: SourceKeep, Reason: reason}
+
+	var entry *ManifestEntry
+	if h.Manifest != nil {
+		entry = newManifestEntry(g.Input, status, g.Results)
+	}
+
+	policy := SourcePolicy{Action: SourceKeep}
+	switch status {
+	case "success":
+		policy = h.OnSuccess
+	case "failed":
+		policy = h.OnFailure
+	}
+	if policy.Action != SourceKeep && outputIsSource(g.Input, g.Results) {
+		policy = SourcePolicy{Action: SourceKeep}
+		action.Reason = sourceReasonInPlace
+	}
+
+	switch policy.Action {
+	case SourceMove:
+		dest, err := h.moveSource(g.Input, policy.Dir)
+		action.Action = SourceMove
+		action.Destination = dest
+		if err != nil {
+			action.Error = err.Error()
+		}
+	case SourceDelete:
+		action.Action = SourceDelete
+		if err := os.Remove(g.Input); err != nil {
+			action.Error = err.Error()
+		}
+	}
+
+	if entry != nil {
+		entry.Action = action.Action
+		entry.Destination = action.Destination
+		h.Manifest.add(*entry)
+	}
+	return action
+}
+
+// sourceStatus girdinin eylem açısından durumunu belirler. Tüm hedefleri başarılı
+// (veya önbellek/günlük sayesinde zaten tamamlanmış) ve çıktıları doğrulanmış girdiler
+// success, herhangi bir hedefi başarısız olan ya da dönüştürülmüş çıktısı doğrulanamayan
+// girdiler failed sayılır. Diğer atlanan girdiler yerinde bırakılır.
+func sourceStatus(results []JobResult) (string, string) {
+	status := "success"
+	reason := ""
+	for _, r := range results {
+		switch {
+		case r.Success:
+			if err := verifyOutput(r.Job.OutputPath); err != nil {
+				return "failed", sourceReasonUnverified
+			}
+		case r.Skipped && isCompletedSkip(r.SkipReason):
+			if err := verifyOutput(r.Job.OutputPath); err != nil {
+				status, reason = "skipped", sourceReasonUnverified
+			}
+		case r.Skipped:
+			status, reason = "skipped", sourceReasonSkipped
+		default:
+			return "failed", ""
+		}
+	}
+	return status, reason
+}
+
+// outputIsSource hedeflerden herhangi birinin çıktısının girdinin kendisi olup olmadığını bildirir.
+// Dosyalar varsa os.SameFile ile (sembolik bağlantılar dahil), yoksa temizlenmiş mutlak yollarla karşılaştırılır.
+func outputIsSource(input string, results []JobResult) bool {
+	inputInfo, inputErr := os.Stat(input)
+	inputAbs := absPath(input)
+	for _, r := range results {
+		output := r.Job.OutputPath
+		if strings.TrimSpace(output) == "" {
+			continue
+		}
+		if inputErr == nil {
+			if outputInfo, err := os.Stat(output); err == nil && os.SameFile(inputInfo, outputInfo) {
+				return true
+			}
+		}
+		if absPath(output) == inputAbs {
+			return true
+		}
+	}
+	return false
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// isCompletedSkip hedefin daha önceki bir çalıştırmada başarıyla üretildiği için atlandığını bildirir.
+func isCompletedSkip(reason string) bool {
+	switch reason {
+	case SkipCacheHit, SkipJournalDone, SkipResumeSuccess:
+		return true
+	}
+	return false
+}
+
+// verifyOutput çıktının boş olmayan normal bir dosya olarak yerinde durduğunu doğrular.
+func verifyOutput(path string) error {
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("çıktı yolu yok")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("çıktı normal dosya değil: %s", path)
+	}
+	if info.Size() == 0 {
+		return fmt.Errorf("çıktı boş: %s", path)
+	}
+	return nil
+}
+
+// sourceDestination girdinin taşınacağı yolu Root'a göre klasör yapısını koruyarak hesaplar.
+// Root dışındaki girdiler hedef dizinin köküne taşınır.
+func (h *SourceHandler) sourceDestination(input, dir string) string {
+	if strings.TrimSpace(h.Root) != "" {
+		if rel, err := filepath.Rel(h.Root, input); err == nil && rel != "." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && rel != ".." {
+			return filepath.Join(dir, rel)
+		}
+	}
+	return filepath.Join(dir, filepath.Base(input))
+}
+
+// moveSource girdiyi hedef dizine taşır; aynı adda dosya varsa numaralı ad kullanılır.
+// Farklı disklerde rename başarısız olursa kopyalayıp siler.
+func (h *SourceHandler) moveSource(input, dir string) (string, error) {
+	dest, _, err := converter.ResolveOutputPathConflict(h.sourceDestination(input, dir), converter.ConflictVersioned)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return "", fmt.Errorf("hedef dizin oluşturulamadı: %w", err)
+	}
+	if err := os.Rename(input, dest); err == nil {
+		return dest, nil
+	}
+	if err := copySource(input, dest); err != nil {
+		os.Remove(dest)
+		return "", err
+	}
+	if err := os.Remove(input); err != nil {
+		return dest, fmt.Errorf("kaynak kopyalandı ancak silinemedi: %w", err)
+	}
+	return dest, nil
+}
+
+func copySource(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// fileDigest dosyanın SHA-256 özetini ve boyutunu döner; okunamazsa boş döner.
+func fileDigest(path string) (string, int64) {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", 0
+	}
+	hash, err := cache.HashFile(path)
+	if err != nil {
+		return "", 0
+	}
+	return hash, info.Size()
+}
@@ -0,0 +1,221 @@
+package batch
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSourcePolicy(t *testing.T) {
+	p, err := ParseSourcePolicy("move:./done", true)
+	if err != nil || p.Action != SourceMove || p.Dir != "./done" {
+		t.Fatalf("unexpected move policy: %+v (%v)", p, err)
+	}
+	if p, err := ParseSourcePolicy("", true); err != nil || p.Action != SourceKeep {
+		t.Fatalf("expected keep default, got %+v (%v)", p, err)
+	}
+	if p, err := ParseSourcePolicy("DELETE", true); err != nil || p.Action != SourceDelete {
+		t.Fatalf("expected delete, got %+v (%v)", p, err)
+	}
+	for _, bad := range []string{"move:", "move", "archive", "keep:./x"} {
+		if _, err := ParseSourcePolicy(bad, true); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+	if _, err := ParseSourcePolicy("delete", false); err == nil {
+		t.Fatalf("expected delete to be rejected when not allowed")
+	}
+}
+
+func writeSourceFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSourceHandlerMovesWithPreservedTree(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "inbox")
+	okInput := filepath.Join(root, "a", "ok.md")
+	badInput := filepath.Join(root, "b", "bad.md")
+	okOutput := filepath.Join(dir, "out", "ok.html")
+	writeSourceFile(t, okInput, "# ok")
+	writeSourceFile(t, badInput, "# bad")
+	writeSourceFile(t, okOutput, "<h1>ok</h1>")
+	// Aynı adda dosya varsa üzerine yazılmamalı
+	writeSourceFile(t, filepath.Join(dir, "done", "a", "ok.md"), "old")
+
+	handler := &SourceHandler{
+		OnSuccess: SourcePolicy{Action: SourceMove, Dir: filepath.Join(dir, "done")},
+		OnFailure: SourcePolicy{Action: SourceMove, Dir: filepath.Join(dir, "failed")},
+		Root:      root,
+	}
+	actions := handler.Apply([]JobResult{
+		{Job: Job{InputPath: okInput, OutputPath: okOutput}, Success: true},
+		{Job: Job{InputPath: badInput, OutputPath: filepath.Join(dir, "out", "bad.html")}, Error: errors.New("boom")},
+	})
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 actions, got %d", len(actions))
+	}
+
+	wantOK := filepath.Join(dir, "done", "a", "ok (1).md")
+	if actions[0].Action != SourceMove || actions[0].Destination != wantOK || actions[0].Error != "" {
+		t.Fatalf("unexpected success action: %+v", actions[0])
+	}
+	wantBad := filepath.Join(dir, "failed", "b", "bad.md")
+	if actions[1].Status != "failed" || actions[1].Destination != wantBad {
+		t.Fatalf("unexpected failure action: %+v", actions[1])
+	}
+	for _, path := range []string{wantOK, wantBad} {
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("expected moved file %s: %v", path, err)
+		}
+	}
+	if _, err := os.Stat(okInput); !os.IsNotExist(err) {
+		t.Fatalf("expected input to be moved away")
+	}
+}
+
+func TestSourceHandlerVerifiesOutputsBeforeDelete(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing.md")
+	cached := filepath.Join(dir, "cached.md")
+	partial := filepath.Join(dir, "partial.md")
+	conflict := filepath.Join(dir, "conflict.md")
+	for _, p := range []string{missing, cached, partial, conflict} {
+		writeSourceFile(t, p, "# x")
+	}
+	cachedOut := filepath.Join(dir, "cached.html")
+	writeSourceFile(t, cachedOut, "<p>x</p>")
+	emptyOut := filepath.Join(dir, "partial.html")
+	writeSourceFile(t, emptyOut, "")
+
+	handler := &SourceHandler{OnSuccess: SourcePolicy{Action: SourceDelete}, OnFailure: SourcePolicy{Action: SourceKeep}}
+	actions := handler.Apply([]JobResult{
+		{Job: Job{InputPath: missing, OutputPath: filepath.Join(dir, "missing.html")}, Success: true},
+		{Job: Job{InputPath: cached, OutputPath: cachedOut}, Skipped: true, SkipReason: SkipCacheHit},
+		{Job: Job{InputPath: partial, OutputPath: emptyOut}, Success: true},
+		{Job: Job{InputPath: conflict, OutputPath: filepath.Join(dir, "conflict.html")}, Skipped: true, SkipReason: "output_exists"},
+	})
+
+	if actions[0].Status != "failed" || actions[0].Reason != sourceReasonUnverified || actions[0].Action != SourceKeep {
+		t.Fatalf("missing output must not delete input: %+v", actions[0])
+	}
+	if actions[1].Action != SourceDelete || actions[1].Error != "" {
+		t.Fatalf("cache hit with valid output should delete input: %+v", actions[1])
+	}
+	if actions[2].Status != "failed" || actions[2].Action != SourceKeep {
+		t.Fatalf("empty output must not delete input: %+v", actions[2])
+	}
+	if actions[3].Status != "skipped" || actions[3].Action != SourceKeep {
+		t.Fatalf("conflict skip must keep input: %+v", actions[3])
+	}
+	for _, p := range []string{missing, partial, conflict} {
+		if _, err := os.Stat(p); err != nil {
+			t.Fatalf("expected %s to be kept: %v", p, err)
+		}
+	}
+	if _, err := os.Stat(cached); !os.IsNotExist(err) {
+		t.Fatalf("expected cached input to be deleted")
+	}
+}
+
+func TestSourceHandlerKeepsInPlaceOutput(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "a.png")
+	writeSourceFile(t, input, "converted")
+	// Aynı dosyaya farklı bir yazımla ulaşan çıktı yolu da eşleşmeli
+	output := filepath.Join(dir, ".", "sub", "..", "a.png")
+
+	for _, policy := range []SourcePolicy{
+		{Action: SourceDelete},
+		{Action: SourceMove, Dir: filepath.Join(dir, "done")},
+	} {
+		handler := &SourceHandler{OnSuccess: policy, Root: dir}
+		actions := handler.Apply([]JobResult{
+			{Job: Job{InputPath: input, OutputPath: output}, Success: true},
+		})
+		if len(actions) != 1 || actions[0].Action != SourceKeep || actions[0].Reason != sourceReasonInPlace {
+			t.Fatalf("%s: expected in-place output to be kept, got %+v", policy, actions)
+		}
+		data, err := os.ReadFile(input)
+		if err != nil || string(data) != "converted" {
+			t.Fatalf("%s: in-place output was lost: %q (%v)", policy, data, err)
+		}
+	}
+}
+
+func TestSourceHandlerManifest(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "a.md")
+	output := filepath.Join(dir, "a.html")
+	writeSourceFile(t, input, "abc")
+	writeSourceFile(t, output, "<p>abc</p>")
+	manifestPath := filepath.Join(dir, "reports", "manifest.json")
+
+	handler := &SourceHandler{
+		OnSuccess: SourcePolicy{Action: SourceDelete},
+		Manifest:  NewManifest(manifestPath),
+	}
+	handler.Apply([]JobResult{{Job: Job{InputPath: input, OutputPath: output, To: "html"}, Success: true}})
+	if err := handler.Manifest.Write(); err != nil {
+		t.Fatalf("manifest write failed: %v", err)
+	}
+
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload manifestFile
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("invalid manifest json: %v", err)
+	}
+	if len(payload.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(payload.Entries))
+	}
+	entry := payload.Entries[0]
+	// sha256("abc")
+	if entry.InputSHA256 != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" || entry.InputSize != 3 {
+		t.Fatalf("input digest must be computed before delete: %+v", entry)
+	}
+	if entry.Action != SourceDelete || len(entry.Outputs) != 1 || entry.Outputs[0].SHA256 == "" || entry.Outputs[0].Format != "html" {
+		t.Fatalf("unexpected manifest entry: %+v", entry)
+	}
+}
+
+func TestRenderReportIncludesSourceActions(t *testing.T) {
+	summary := Summary{
+		Total:         1,
+		Succeeded:     1,
+		SourceActions: []SourceAction{{Input: "a.jpg", Status: "success", Action: SourceMove, Destination: "done/a.jpg"}},
+	}
+	results := []JobResult{{Job: Job{InputPath: "a.jpg", OutputPath: "a.webp"}, Success: true}}
+
+	txt, err := RenderReport(ReportTXT, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(txt, "Source actions:") || !strings.Contains(txt, "[move] a.jpg -> done/a.jpg") {
+		t.Fatalf("missing source actions in txt report:\n%s", txt)
+	}
+
+	out, err := RenderReport(ReportJSON, summary, results, time.Unix(0, 0), time.Unix(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload reportPayload
+	if err := json.Unmarshal([]byte(out), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if a := payload.Inputs[0].SourceAction; a == nil || a.Destination != "done/a.jpg" {
+		t.Fatalf("missing source action in json report: %+v", payload.Inputs[0])
+	}
+}
@@ -0,0 +1,362 @@
+// Package cache artımlı (incremental) batch çalışmaları için kalıcı dönüşüm
+// önbelleğini yönetir. Anahtar; girdinin içerik özeti, dönüştürücü, hedef format,
+// normalize edilmiş seçenekler ve harici araç sürümlerinden üretilir.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileName önbellek dizinindeki kayıt dosyasının adıdır.
+const FileName = "batch-cache.json"
+
+// formatVersion kayıt dosyası biçimi değişirse artırılır; eski kayıtlar yok sayılır.
+const formatVersion = 1
+
+// Entry tek bir başarılı dönüşümün önbellek kaydıdır.
+type Entry struct {
+	Key         string    `json:"key"`
+	Input       string    `json:"input"`
+	InputHash   string    `json:"input_hash"`
+	InputSize   int64     `json:"input_size"`
+	InputMTime  int64     `json:"input_mtime"`
+	Output      string    `json:"output"`
+	OutputSize  int64     `json:"output_size"`
+	OutputMTime int64     `json:"output_mtime"`
+	Converter   string    `json:"converter,omitempty"`
+	Format      string    `json:"format,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastHitAt   time.Time `json:"last_hit_at,omitempty"`
+}
+
+// KeyParts önbellek anahtarını oluşturan bileşenlerdir.
+type KeyParts struct {
+	InputHash string            `json:"input_hash"`
+	Converter string            `json:"converter"`
+	From      string            `json:"from"`
+	To        string            `json:"to"`
+	Output    string            `json:"output"`
+	Options   any               `json:"options"`
+	Tools     map[string]string `json:"tools,omitempty"`
+	Version   string            `json:"version,omitempty"`
+	// Assets seçeneklerin yol ile başvurduğu dosyaların (ör. filigran logosu) içerik
+	// özetleridir; dosya yerinde değiştirilirse anahtar da değişir.
+	Assets map[string]string `json:"assets,omitempty"`
+}
+
+// Key bileşenlerden kararlı bir anahtar üretir. Output planlanan (çakışma çözümü
+// öncesi) çıktı yoludur; çıktı klasörü değişirse kayıt eşleşmez.
+func Key(parts KeyParts) (string, error) {
+	if abs, err := filepath.Abs(parts.Output); err == nil {
+		parts.Output = abs
+	}
+	data, err := json.Marshal(parts)
+	if err != nil {
+		return "", fmt.Errorf("önbellek anahtarı üretilemedi: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type filePayload struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+type inputStamp struct {
+	Size  int64
+	MTime int64
+	Hash  string
+}
+
+// Store diskteki önbellek kayıtlarını bellekte tutar. Eşzamanlı kullanım güvenlidir.
+type Store struct {
+	path    string
+	mu      sync.Mutex
+	entries map[string]Entry
+	stamps  map[string]inputStamp
+	dirty   bool
+}
+
+// DefaultDir varsayılan önbellek dizinini döner (~/.fileconverter/cache).
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".fileconverter", "cache"), nil
+}
+
+// Open dizindeki önbelleği okur. Dosya yoksa veya eski biçimdeyse boş önbellek döner.
+func Open(dir string) (*Store, error) {
+	s := &Store{
+		path:    filepath.Join(dir, FileName),
+		entries: map[string]Entry{},
+		stamps:  map[string]inputStamp{},
+	}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("önbellek okunamadı: %w", err)
+	}
+	var payload filePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("önbellek dosyası bozuk (%s): %w", s.path, err)
+	}
+	if payload.Version != formatVersion {
+		return s, nil
+	}
+	for _, e := range payload.Entries {
+		s.entries[e.Key] = e
+		s.stamps[e.Input] = inputStamp{Size: e.InputSize, MTime: e.InputMTime, Hash: e.InputHash}
+	}
+	return s, nil
+}
+
+// Path önbellek dosyasının yolunu döner.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Len kayıt sayısını döner.
+func (s *Store) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+// Save değişiklik varsa önbelleği atomik olarak diske yazar.
+func (s *Store) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.dirty {
+		return nil
+	}
+	entries := make([]Entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Input != entries[j].Input {
+			return entries[i].Input < entries[j].Input
+		}
+		return entries[i].Output < entries[j].Output
+	})
+	data, err := json.MarshalIndent(filePayload{Version: formatVersion, Entries: entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("önbellek dizini oluşturulamadı: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("önbellek yazılamadı: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("önbellek yazılamadı: %w", err)
+	}
+	s.dirty = false
+	return nil
+}
+
+// InputHash girdinin SHA-256 özetini döner. Boyutu ve değişiklik zamanı kayıtlı
+// değerle aynı olan dosyalar yeniden okunmaz.
+func (s *Store) InputHash(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	stamp, ok := s.stamps[abs]
+	s.mu.Unlock()
+	if ok && stamp.Size == info.Size() && stamp.MTime == info.ModTime().UnixNano() {
+		return stamp.Hash, nil
+	}
+
+	hash, err := HashFile(abs)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	s.stamps[abs] = inputStamp{Size: info.Size(), MTime: info.ModTime().UnixNano(), Hash: hash}
+	s.mu.Unlock()
+	return hash, nil
+}
+
+// Lookup anahtara ait kaydı döner. Kaydın çıktısı hâlâ yerinde ve kaydedildiği
+// andaki boyut ve değişiklik zamanına sahipse isabet sayılır.
+func (s *Store) Lookup(key string, now time.Time) (Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || !outputMatches(e) {
+		return Entry{}, false
+	}
+	e.LastHitAt = now
+	s.entries[key] = e
+	s.dirty = true
+	return e, true
+}
+
+// Put başarılı bir dönüşümü kaydeder. Girdi ve çıktının boyut/zaman bilgisi diskten okunur.
+func (s *Store) Put(e Entry, now time.Time) error {
+	input, err := filepath.Abs(e.Input)
+	if err != nil {
+		return err
+	}
+	output, err := filepath.Abs(e.Output)
+	if err != nil {
+		return err
+	}
+	inInfo, err := os.Stat(input)
+	if err != nil {
+		return err
+	}
+	outInfo, err := os.Stat(output)
+	if err != nil {
+		return err
+	}
+	e.Input, e.Output = input, output
+	e.InputSize, e.InputMTime = inInfo.Size(), inInfo.ModTime().UnixNano()
+	e.OutputSize, e.OutputMTime = outInfo.Size(), outInfo.ModTime().UnixNano()
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = now
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[e.Key] = e
+	s.stamps[input] = inputStamp{Size: e.InputSize, MTime: e.InputMTime, Hash: e.InputHash}
+	s.dirty = true
+	return nil
+}
+
+// Stats önbellek özetidir.
+type Stats struct {
+	Path        string    `json:"path"`
+	FileSize    int64     `json:"file_size"`
+	Entries     int       `json:"entries"`
+	Inputs      int       `json:"inputs"`
+	OutputBytes int64     `json:"output_bytes"`
+	Stale       int       `json:"stale"`
+	Oldest      time.Time `json:"oldest,omitempty"`
+	Newest      time.Time `json:"newest,omitempty"`
+}
+
+// Stats kayıt sayısı, toplam çıktı boyutu ve geçersizleşmiş kayıtları hesaplar.
+func (s *Store) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := Stats{Path: s.path, Entries: len(s.entries)}
+	if info, err := os.Stat(s.path); err == nil {
+		st.FileSize = info.Size()
+	}
+	inputs := map[string]struct{}{}
+	for _, e := range s.entries {
+		inputs[e.Input] = struct{}{}
+		st.OutputBytes += e.OutputSize
+		if isStale(e) {
+			st.Stale++
+		}
+		if st.Oldest.IsZero() || e.CreatedAt.Before(st.Oldest) {
+			st.Oldest = e.CreatedAt
+		}
+		if e.CreatedAt.After(st.Newest) {
+			st.Newest = e.CreatedAt
+		}
+	}
+	st.Inputs = len(inputs)
+	return st
+}
+
+// PruneOptions hangi kayıtların silineceğini belirler. Geçersizleşmiş kayıtlar
+// (girdi veya çıktı silinmiş/değişmiş) her zaman silinir.
+type PruneOptions struct {
+	// OlderThan sıfırdan büyükse son kullanımı bu süreden eski kayıtlar da silinir.
+	OlderThan time.Duration
+	// All tüm kayıtları siler.
+	All bool
+	// DryRun silinecek kayıtları sayar ama önbelleği değiştirmez.
+	DryRun bool
+}
+
+// Prune kayıtları temizler ve silinen (DryRun'da silinecek) kayıt sayısını döner.
+func (s *Store) Prune(opts PruneOptions, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed := 0
+	for key, e := range s.entries {
+		if !opts.All && !isStale(e) && !isOlder(e, opts.OlderThan, now) {
+			continue
+		}
+		removed++
+		if !opts.DryRun {
+			delete(s.entries, key)
+			delete(s.stamps, e.Input)
+		}
+	}
+	if removed > 0 && !opts.DryRun {
+		s.dirty = true
+	}
+	return removed
+}
+
+func isOlder(e Entry, olderThan time.Duration, now time.Time) bool {
+	if olderThan <= 0 {
+		return false
+	}
+	last := e.LastHitAt
+	if last.IsZero() || e.CreatedAt.After(last) {
+		last = e.CreatedAt
+	}
+	return now.Sub(last) > olderThan
+}
+
+// isStale girdi değişmiş/silinmiş ya da çıktı artık kayıttakiyle eşleşmiyorsa true döner.
+func isStale(e Entry) bool {
+	info, err := os.Stat(e.Input)
+	if err != nil || info.Size() != e.InputSize || info.ModTime().UnixNano() != e.InputMTime {
+		return true
+	}
+	return !outputMatches(e)
+}
+
+func outputMatches(e Entry) bool {
+	info, err := os.Stat(e.Output)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	return info.Size() == e.OutputSize && info.ModTime().UnixNano() == e.OutputMTime
+}
+
+// HashFile dosyanın SHA-256 özetini hex olarak döner.
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
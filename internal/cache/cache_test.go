@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir failed: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+}
+
+func TestKeyChangesWithParts(t *testing.T) {
+	base := KeyParts{InputHash: "abc", Converter: "Image Converter", From: "png", To: "webp", Output: "out/a.webp", Options: map[string]int{"quality": 80}}
+	k1, err := Key(base)
+	if err != nil {
+		t.Fatalf("key failed: %v", err)
+	}
+	same, _ := Key(base)
+	if k1 != same {
+		t.Fatalf("key should be stable")
+	}
+
+	variants := []KeyParts{base, base, base, base}
+	variants[0].InputHash = "def"
+	variants[1].Options = map[string]int{"quality": 70}
+	variants[2].Tools = map[string]string{"ffmpeg": "ffmpeg version 7.0"}
+	variants[3].Output = "other/a.webp"
+	for i, parts := range variants {
+		k, err := Key(parts)
+		if err != nil {
+			t.Fatalf("key failed: %v", err)
+		}
+		if k == k1 {
+			t.Errorf("variant %d should change the key", i)
+		}
+	}
+}
+
+func TestStoreLookupPutAndPersist(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "in.png")
+	output := filepath.Join(dir, "out", "in.webp")
+	writeFile(t, input, "source")
+	writeFile(t, output, "converted")
+	now := time.Now()
+
+	store, err := Open(filepath.Join(dir, "cache"))
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	hash, err := store.InputHash(input)
+	if err != nil {
+		t.Fatalf("hash failed: %v", err)
+	}
+	if _, ok := store.Lookup("k1", now); ok {
+		t.Fatalf("empty store should miss")
+	}
+	if err := store.Put(Entry{Key: "k1", Input: input, InputHash: hash, Output: output, Format: "webp"}, now); err != nil {
+		t.Fatalf("put failed: %v", err)
+	}
+	if err := store.Save(); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	reopened, err := Open(filepath.Join(dir, "cache"))
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	entry, ok := reopened.Lookup("k1", now)
+	if !ok || entry.Output != output || entry.InputHash != hash {
+		t.Fatalf("expected persisted hit, got %+v (%v)", entry, ok)
+	}
+	if got, _ := reopened.InputHash(input); got != hash {
+		t.Fatalf("hash should be reused, got %s want %s", got, hash)
+	}
+
+	// Çıktı değişirse kayıt isabet sayılmaz.
+	writeFile(t, output, "edited by hand")
+	if _, ok := reopened.Lookup("k1", now); ok {
+		t.Fatalf("modified output should miss")
+	}
+}
+
+func TestStoreStatsAndPrune(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	store, err := Open(filepath.Join(dir, "cache"))
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		input := filepath.Join(dir, name+".png")
+		output := filepath.Join(dir, name+".webp")
+		writeFile(t, input, name)
+		writeFile(t, output, name+name)
+		if err := store.Put(Entry{Key: name, Input: input, Output: output}, now.Add(-48*time.Hour)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+	store.Lookup("b", now)
+	if err := os.Remove(filepath.Join(dir, "a.webp")); err != nil {
+		t.Fatalf("remove failed: %v", err)
+	}
+
+	stats := store.Stats()
+	if stats.Entries != 3 || stats.Inputs != 3 || stats.Stale != 1 || stats.OutputBytes != 6 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	if n := store.Prune(PruneOptions{DryRun: true, OlderThan: 24 * time.Hour}, now); n != 2 || store.Len() != 3 {
+		t.Fatalf("dry-run should count 2 without removing, got %d (len %d)", n, store.Len())
+	}
+	if n := store.Prune(PruneOptions{}, now); n != 1 || store.Len() != 2 {
+		t.Fatalf("expected stale entry to be pruned, got %d (len %d)", n, store.Len())
+	}
+	if n := store.Prune(PruneOptions{OlderThan: 24 * time.Hour}, now); n != 1 {
+		t.Fatalf("expected unused old entry to be pruned, got %d", n)
+	}
+	if _, ok := store.Lookup("b", now); !ok {
+		t.Fatalf("recently used entry should be kept")
+	}
+	if n := store.Prune(PruneOptions{All: true}, now); n != 1 || store.Len() != 0 {
+		t.Fatalf("expected all entries to be pruned, got %d", n)
+	}
+}
+
+func TestOpenIgnoresOtherVersions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, FileName), `{"version":99,"entries":[{"key":"x"}]}`)
+	store, err := Open(dir)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	if store.Len() != 0 {
+		t.Fatalf("entries from other versions should be ignored")
+	}
+
+	writeFile(t, filepath.Join(dir, FileName), `{broken`)
+	if _, err := Open(dir); err == nil {
+		t.Fatalf("expected error for corrupt cache file")
+	}
+}
@@ -0,0 +1,343 @@
+package converter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// animationMaxAttempts --max-size için denenecek en fazla encode sayısı.
+	animationMaxAttempts = 8
+	minAnimationFPS      = 5
+	minAnimationWidth    = 160
+	minAnimationColors   = 32
+	minAnimationQuality  = 20
+	defaultGIFDither     = "sierra2_4a"
+)
+
+// gifDitherChoices paletteuse filtresinin desteklediği dithering yöntemleri.
+var gifDitherChoices = []string{"none", "bayer", "floyd_steinberg", "sierra2", "sierra2_4a"}
+
+// AnimationSpec videodan GIF veya animasyonlu WebP üretimi için ayarları tutar.
+// Sıfır değerler kaliteye göre varsayılanların kullanılacağı anlamına gelir.
+type AnimationSpec struct {
+	FPS      int
+	Colors   int
+	Dither   string
+	Start    float64
+	Duration float64
+	// Loop 0 sonsuz döngü, N ise animasyonun toplam kaç kez oynatılacağıdır.
+	Loop    int
+	MaxSize int64
+}
+
+// IsZero spec'in hiçbir ayar taşımadığını bildirir.
+func (s *AnimationSpec) IsZero() bool {
+	return s == nil || *s == AnimationSpec{}
+}
+
+// IsAnimatedTarget videodan animasyon üretilecek hedefleri (gif, webp) ayırt eder.
+func IsAnimatedTarget(from string, to string) bool {
+	from = NormalizeFormat(from)
+	to = NormalizeFormat(to)
+	return slices.Contains(videoInputFormats, from) && (to == "gif" || to == "webp")
+}
+
+// NormalizeGIFDither dithering adını normalize eder.
+func NormalizeGIFDither(raw string) (string, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	value = strings.ReplaceAll(value, "-", "_")
+	switch value {
+	case "":
+		return "", nil
+	case "floyd", "fs":
+		value = "floyd_steinberg"
+	case "off":
+		value = "none"
+	}
+	if !slices.Contains(gifDitherChoices, value) {
+		return "", fmt.Errorf("geçersiz dithering: %s (desteklenen: %s)", raw, strings.Join(gifDitherChoices, ", "))
+	}
+	return value, nil
+}
+
+// BuildAnimationSpec CLI girdilerinden animasyon spec'i üretir.
+// Hiçbir ayar verilmemişse nil döner.
+func BuildAnimationSpec(fps int, colors int, dither string, start float64, duration float64, loop int, maxSize int64) (*AnimationSpec, error) {
+	normalizedDither, err := NormalizeGIFDither(dither)
+	if err != nil {
+		return nil, err
+	}
+	spec := &AnimationSpec{
+		FPS:      fps,
+		Colors:   colors,
+		Dither:   normalizedDither,
+		Start:    start,
+		Duration: duration,
+		Loop:     loop,
+		MaxSize:  maxSize,
+	}
+	if spec.IsZero() {
+		return nil, nil
+	}
+	return spec, nil
+}
+
+// ValidateAnimationSpec spec değerlerinin hedef formatla uyumunu kontrol eder.
+func ValidateAnimationSpec(spec *AnimationSpec, to string) error {
+	if spec.IsZero() {
+		return nil
+	}
+	to = NormalizeFormat(to)
+	if to != "gif" && to != "webp" {
+		return fmt.Errorf("animasyon ayarları sadece gif ve webp çıktılarında kullanılabilir")
+	}
+	if spec.FPS < 0 || spec.FPS > 50 {
+		return fmt.Errorf("fps 1-50 aralığında olmalı")
+	}
+	if spec.Colors != 0 && (spec.Colors < 2 || spec.Colors > 256) {
+		return fmt.Errorf("renk sayısı 2-256 aralığında olmalı")
+	}
+	if to == "webp" && (spec.Colors != 0 || spec.Dither != "") {
+		return fmt.Errorf("--colors ve --dither sadece gif çıktısında kullanılabilir")
+	}
+	if spec.Start < 0 || spec.Duration < 0 {
+		return fmt.Errorf("başlangıç ve süre negatif olamaz")
+	}
+	if spec.Loop < 0 {
+		return fmt.Errorf("döngü sayısı negatif olamaz (0 = sonsuz)")
+	}
+	if spec.MaxSize < 0 {
+		return fmt.Errorf("maksimum boyut negatif olamaz")
+	}
+	return nil
+}
+
+// animationParams tek bir encode denemesinin parametreleridir.
+type animationParams struct {
+	FPS     int
+	Width   int
+	Colors  int
+	Quality int
+}
+
+// initialAnimationParams kalite profili ve spec'ten ilk denemenin parametrelerini üretir.
+func initialAnimationParams(quality int, spec AnimationSpec) animationParams {
+	fps, width := gifProfile(quality)
+	if spec.FPS > 0 {
+		fps = spec.FPS
+	}
+	colors := 256
+	if spec.Colors > 0 {
+		colors = spec.Colors
+	}
+	webpQuality := quality
+	if webpQuality <= 0 {
+		webpQuality = 75
+	}
+	return animationParams{FPS: fps, Width: width, Colors: colors, Quality: webpQuality}
+}
+
+// shrinkAnimationParams boyut hedefi aşıldığında bir sonraki denemenin parametrelerini seçer.
+// Sırasıyla genişlik, fps ve renk sayısı (webp için kalite) düşürülür; hepsi
+// alt sınırdaysa false döner. keepWidth boyutlandırmanın kullanıcıdan geldiği durumdur.
+func shrinkAnimationParams(p animationParams, to string, attempt int, keepWidth bool) (animationParams, bool) {
+	for i := 0; i < 3; i++ {
+		switch (attempt + i) % 3 {
+		case 0:
+			if !keepWidth && p.Width > minAnimationWidth {
+				p.Width = max(minAnimationWidth, p.Width*85/100)
+				p.Width -= p.Width % 2
+				return p, true
+			}
+		case 1:
+			if p.FPS > minAnimationFPS {
+				p.FPS = max(minAnimationFPS, p.FPS-2)
+				return p, true
+			}
+		case 2:
+			if to == "gif" && p.Colors > minAnimationColors {
+				p.Colors = max(minAnimationColors, p.Colors/2)
+				return p, true
+			}
+			if to == "webp" && p.Quality > minAnimationQuality {
+				p.Quality = max(minAnimationQuality, p.Quality-15)
+				return p, true
+			}
+		}
+	}
+	return p, false
+}
+
+// animationBaseFilter fps ve ölçekleme filtre zincirini üretir.
+func animationBaseFilter(p animationParams, resize *ResizeSpec) (string, error) {
+	filters := []string{fmt.Sprintf("fps=%d", p.FPS)}
+	if resize != nil {
+		resizeFilter, err := BuildVideoResizeFilter(*resize)
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, resizeFilter)
+	} else {
+		filters = append(filters, fmt.Sprintf("scale=%d:-1:flags=lanczos", p.Width))
+	}
+	return strings.Join(filters, ","), nil
+}
+
+// animationInputArgs başlangıç/süre seçimiyle giriş argümanlarını üretir.
+func animationInputArgs(input string, spec AnimationSpec) []string {
+	var args []string
+	if spec.Start > 0 {
+		args = append(args, "-ss", formatSeconds(spec.Start))
+	}
+	if spec.Duration > 0 {
+		args = append(args, "-t", formatSeconds(spec.Duration))
+	}
+	return append(args, "-i", input)
+}
+
+// gifPaletteArgs palettegen (1. geçiş) için FFmpeg argümanlarını üretir.
+func gifPaletteArgs(input string, palette string, spec AnimationSpec, p animationParams, baseFilter string) []string {
+	args := animationInputArgs(input, spec)
+	args = append(args, "-vf", fmt.Sprintf("%s,palettegen=max_colors=%d:stats_mode=diff", baseFilter, p.Colors))
+	return append(args, "-y", palette)
+}
+
+// gifPaletteUseArgs paletteuse (2. geçiş) için FFmpeg argümanlarını üretir.
+func gifPaletteUseArgs(input string, palette string, output string, spec AnimationSpec, baseFilter string) []string {
+	dither := spec.Dither
+	if dither == "" {
+		dither = defaultGIFDither
+	}
+	ditherOpts := "dither=" + dither
+	if dither == "bayer" {
+		ditherOpts += ":bayer_scale=3"
+	}
+	args := animationInputArgs(input, spec)
+	args = append(args, "-i", palette)
+	args = append(args, "-lavfi", fmt.Sprintf("%s[x];[x][1:v]paletteuse=%s:diff_mode=rectangle", baseFilter, ditherOpts))
+	args = append(args, "-loop", strconv.Itoa(gifLoopValue(spec.Loop)), "-an", "-y", output)
+	return args
+}
+
+// webpAnimationArgs animasyonlu WebP için FFmpeg argümanlarını üretir.
+func webpAnimationArgs(input string, output string, spec AnimationSpec, p animationParams, baseFilter string) []string {
+	args := animationInputArgs(input, spec)
+	args = append(args,
+		"-vf", baseFilter,
+		"-c:v", "libwebp",
+		"-lossless", "0",
+		"-q:v", strconv.Itoa(p.Quality),
+		"-compression_level", "6",
+		"-loop", strconv.Itoa(spec.Loop),
+		"-an", "-y", output,
+	)
+	return args
+}
+
+// gifLoopValue kullanıcı döngü sayısını GIF'in tekrar sayısı semantiğine çevirir.
+// GIF'te -1 tek oynatma, 0 sonsuz, N ise ilk oynatmadan sonraki tekrar sayısıdır.
+func gifLoopValue(loop int) int {
+	switch {
+	case loop <= 0:
+		return 0
+	case loop == 1:
+		return -1
+	default:
+		return loop - 1
+	}
+}
+
+func formatSeconds(sec float64) string {
+	return strconv.FormatFloat(sec, 'f', -1, 64)
+}
+
+// convertToAnimation videodan palet tabanlı GIF ya da animasyonlu WebP üretir.
+// MaxSize verilmişse çıktı sığana kadar fps/genişlik/renk düşürülerek yeniden denenir.
+func (v *VideoConverter) convertToAnimation(ffmpegPath string, input string, output string, to string, opts Options) error {
+	spec := AnimationSpec{}
+	if opts.Animation != nil {
+		spec = *opts.Animation
+	}
+	if spec.MaxSize == 0 && opts.TargetSize > 0 {
+		spec.MaxSize = opts.TargetSize
+	}
+	if err := ValidateAnimationSpec(&spec, to); err != nil {
+		return err
+	}
+	transformFilters, err := BuildTransformFilters(opts.Transform)
+	if err != nil {
+		return err
+	}
+
+	tempDir, err := os.MkdirTemp("", "fileconverter-anim-*")
+	if err != nil {
+		return fmt.Errorf("geçici klasör oluşturulamadı: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	palette := filepath.Join(tempDir, "palette.png")
+
+	run := func(args []string) error {
+		if !opts.Verbose {
+			args = append([]string{"-loglevel", "error"}, args...)
+		}
+		if out, err := Command(ffmpegPath, args...).CombinedOutput(); err != nil {
+			return fmt.Errorf("FFmpeg hatası: %s\n%s", err.Error(), string(out))
+		}
+		return nil
+	}
+
+	encode := func(p animationParams, path string) error {
+		baseFilter, err := animationBaseFilter(p, opts.Resize)
+		if err != nil {
+			return err
+		}
+		if len(transformFilters) > 0 {
+			baseFilter = strings.Join(transformFilters, ",") + "," + baseFilter
+		}
+		if to == "gif" {
+			if err := run(gifPaletteArgs(input, palette, spec, p, baseFilter)); err != nil {
+				return err
+			}
+			return run(gifPaletteUseArgs(input, palette, path, spec, baseFilter))
+		}
+		return run(webpAnimationArgs(input, path, spec, p, baseFilter))
+	}
+
+	return fitAnimationToMaxSize(output, spec.MaxSize, initialAnimationParams(opts.Quality, spec), to, opts.Resize != nil, encode)
+}
+
+// fitAnimationToMaxSize encode sonucunu maxSize altına inene kadar tekrarlar. Denemeler
+// geçici dosyaya yazılır ve çıktı yalnızca sığdığında output'a taşınır; başarısız
+// denemelerde output'taki dosyaya dokunulmaz.
+func fitAnimationToMaxSize(output string, maxSize int64, p animationParams, to string, fixedSize bool, encode func(p animationParams, path string) error) error {
+	tmp := TempOutputPath(output)
+	os.Remove(tmp)
+	for attempt := 0; attempt < animationMaxAttempts; attempt++ {
+		if err := encode(p, tmp); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+		info, err := os.Stat(tmp)
+		if err != nil {
+			os.Remove(tmp)
+			return err
+		}
+		size := info.Size()
+		if maxSize <= 0 || size <= maxSize {
+			return CommitTempOutput(tmp, output)
+		}
+		next, ok := shrinkAnimationParams(p, to, attempt, fixedSize)
+		if !ok {
+			os.Remove(tmp)
+			return fmt.Errorf("maksimum boyuta ulaşılamadı: %s > %s", formatInfoSize(size), formatInfoSize(maxSize))
+		}
+		p = next
+	}
+	os.Remove(tmp)
+	return fmt.Errorf("maksimum boyuta ulaşılamadı (%d deneme)", animationMaxAttempts)
+}
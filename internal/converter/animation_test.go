@@ -0,0 +1,176 @@
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBuildAnimationSpec(t *testing.T) {
+	spec, err := BuildAnimationSpec(0, 0, "", 0, 0, 0, 0)
+	if err != nil || spec != nil {
+		t.Fatalf("expected nil spec without options, got %#v err=%v", spec, err)
+	}
+
+	spec, err = BuildAnimationSpec(15, 128, "Floyd-Steinberg", 2, 4, 3, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Dither != "floyd_steinberg" || spec.FPS != 15 || spec.Colors != 128 {
+		t.Fatalf("unexpected spec: %#v", spec)
+	}
+
+	if _, err := BuildAnimationSpec(0, 0, "ordered", 0, 0, 0, 0); err == nil {
+		t.Fatalf("expected error for unknown dither")
+	}
+}
+
+func TestValidateAnimationSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    AnimationSpec
+		target  string
+		wantErr string
+	}{
+		{name: "gif palette", spec: AnimationSpec{Colors: 64, Dither: "bayer"}, target: "gif"},
+		{name: "webp window", spec: AnimationSpec{Start: 1, Duration: 3, Loop: 2}, target: "webp"},
+		{name: "webp colors", spec: AnimationSpec{Colors: 64}, target: "webp", wantErr: "sadece gif"},
+		{name: "colors range", spec: AnimationSpec{Colors: 300}, target: "gif", wantErr: "2-256"},
+		{name: "mp4 target", spec: AnimationSpec{FPS: 10}, target: "mp4", wantErr: "gif ve webp"},
+		{name: "negative loop", spec: AnimationSpec{Loop: -1}, target: "gif", wantErr: "negatif"},
+	}
+	for _, tt := range tests {
+		spec := tt.spec
+		err := ValidateAnimationSpec(&spec, tt.target)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("%s: expected error containing %q, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestIsAnimatedTarget(t *testing.T) {
+	if !IsAnimatedTarget("mp4", "webp") || !IsAnimatedTarget("mov", "gif") {
+		t.Fatalf("expected video → gif/webp to be animated targets")
+	}
+	if IsAnimatedTarget("png", "webp") || IsAnimatedTarget("mp4", "mkv") {
+		t.Fatalf("image sources and video targets must not be animated targets")
+	}
+}
+
+func TestGIFPaletteArgs(t *testing.T) {
+	spec := AnimationSpec{Start: 12, Duration: 4.5, Dither: "bayer", Loop: 1}
+	p := animationParams{FPS: 15, Width: 640, Colors: 128}
+	base, err := animationBaseFilter(p, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if base != "fps=15,scale=640:-1:flags=lanczos" {
+		t.Fatalf("unexpected base filter: %s", base)
+	}
+
+	pass1 := gifPaletteArgs("in.mp4", "palette.png", spec, p, base)
+	want1 := []string{"-ss", "12", "-t", "4.5", "-i", "in.mp4", "-vf", base + ",palettegen=max_colors=128:stats_mode=diff", "-y", "palette.png"}
+	if !slices.Equal(pass1, want1) {
+		t.Fatalf("unexpected palettegen args: %v", pass1)
+	}
+
+	pass2 := gifPaletteUseArgs("in.mp4", "palette.png", "out.gif", spec, base)
+	want2 := []string{
+		"-ss", "12", "-t", "4.5", "-i", "in.mp4", "-i", "palette.png",
+		"-lavfi", base + "[x];[x][1:v]paletteuse=dither=bayer:bayer_scale=3:diff_mode=rectangle",
+		"-loop", "-1", "-an", "-y", "out.gif",
+	}
+	if !slices.Equal(pass2, want2) {
+		t.Fatalf("unexpected paletteuse args: %v", pass2)
+	}
+}
+
+func TestWebPAnimationArgs(t *testing.T) {
+	got := webpAnimationArgs("in.mp4", "out.webp", AnimationSpec{}, animationParams{Quality: 70}, "fps=12,scale=800:-1:flags=lanczos")
+	want := []string{
+		"-i", "in.mp4", "-vf", "fps=12,scale=800:-1:flags=lanczos",
+		"-c:v", "libwebp", "-lossless", "0", "-q:v", "70", "-compression_level", "6",
+		"-loop", "0", "-an", "-y", "out.webp",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected webp args: %v", got)
+	}
+}
+
+func TestFitAnimationToMaxSizeShrinks(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "out.gif")
+	var tried []animationParams
+	err := fitAnimationToMaxSize(output, 1000, animationParams{FPS: 15, Width: 960, Colors: 256}, "gif", false, func(p animationParams, path string) error {
+		tried = append(tried, p)
+		if len(tried) < 4 {
+			return writeSizedOutput(path, 2000)
+		}
+		return writeSizedOutput(path, 900)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tried) != 4 {
+		t.Fatalf("expected 4 attempts, got %d", len(tried))
+	}
+	if info, err := os.Stat(output); err != nil || info.Size() != 900 {
+		t.Fatalf("expected fitting output to be committed, got %v (%v)", info, err)
+	}
+	last := tried[3]
+	if last.Width >= 960 || last.FPS >= 15 || last.Colors >= 256 {
+		t.Fatalf("expected width, fps and colors to shrink, got %#v", last)
+	}
+
+	tried = nil
+	err = fitAnimationToMaxSize(output, 1000, animationParams{FPS: minAnimationFPS, Width: 640, Colors: minAnimationColors}, "gif", true, func(p animationParams, path string) error {
+		tried = append(tried, p)
+		return writeSizedOutput(path, 2000)
+	})
+	if err == nil || !strings.Contains(err.Error(), "ulaşılamadı") {
+		t.Fatalf("expected failure when nothing can shrink, got %v", err)
+	}
+	if len(tried) != 1 {
+		t.Fatalf("fixed width must not be shrunk, got %v", tried)
+	}
+}
+
+func TestFitAnimationToMaxSizeKeepsExistingOutputWhenTooLarge(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "out.webp")
+	if err := os.WriteFile(output, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	err := fitAnimationToMaxSize(output, 1000, animationParams{FPS: 15, Width: 960, Colors: 256}, "webp", false, func(_ animationParams, path string) error {
+		attempts++
+		return writeSizedOutput(path, 5000)
+	})
+	if err == nil || !strings.Contains(err.Error(), "ulaşılamadı") {
+		t.Fatalf("expected max size failure, got %v", err)
+	}
+	if attempts < 2 {
+		t.Fatalf("expected several shrink attempts, got %d", attempts)
+	}
+	if data, _ := os.ReadFile(output); string(data) != "old" {
+		t.Fatalf("oversized animation replaced the existing output (%d bytes)", len(data))
+	}
+	if _, err := os.Stat(TempOutputPath(output)); !os.IsNotExist(err) {
+		t.Fatalf("expected temp output to be removed, got %v", err)
+	}
+}
+
+func TestGIFLoopValue(t *testing.T) {
+	for loop, want := range map[int]int{0: 0, 1: -1, 3: 2} {
+		if got := gifLoopValue(loop); got != want {
+			t.Fatalf("gifLoopValue(%d) = %d, want %d", loop, got, want)
+		}
+	}
+}
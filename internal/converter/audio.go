@@ -60,24 +60,29 @@ func (a *AudioConverter) Convert(input string, output string, opts Options) erro
 	}
 
 	to := DetectFormat(output)
-	args := []string{"-i", input, "-y"} // -y: overwrite
-
-	// Codec ve kalite ayarları
-	args = append(args, a.getCodecArgs(to, opts.Quality)...)
-	args = append(args, MetadataFFmpegArgs(opts.MetadataMode)...)
+	if err := ValidateAudioSpec(opts.Audio, to); err != nil {
+		return err
+	}
+	if opts.TargetSize > 0 {
+		if err := ValidateTargetSizeOptions(to, opts.TargetSize, opts.Audio, nil); err != nil {
+			return err
+		}
+		return a.convertAudioToTargetSize(ffmpegPath, input, output, to, opts)
+	}
 
+	args := []string{}
 	// Verbose değilse sessiz mod
 	if !opts.Verbose {
-		args = append([]string{"-loglevel", "error"}, args[0:]...)
-		// Rebuild: loglevel should come before -i
-		args = []string{"-loglevel", "error", "-i", input, "-y"}
-		args = append(args, a.getCodecArgs(to, opts.Quality)...)
-		args = append(args, MetadataFFmpegArgs(opts.MetadataMode)...)
+		args = append(args, "-loglevel", "error")
 	}
+	args = append(args, "-i", input, "-y") // -y: overwrite
 
+	// Codec ve kalite ayarları
+	args = append(args, a.getCodecArgs(to, opts.Quality, opts.Audio)...)
+	args = append(args, MetadataFFmpegArgs(opts.MetadataMode)...)
 	args = append(args, output)
 
-	cmd := exec.Command(ffmpegPath, args...)
+	cmd := Command(ffmpegPath, args...)
 	if outputBytes, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("FFmpeg hatası: %s\n%s", err.Error(), string(outputBytes))
 	}
@@ -85,41 +90,9 @@ func (a *AudioConverter) Convert(input string, output string, opts Options) erro
 	return nil
 }
 
-// getCodecArgs hedef format ve kaliteye göre FFmpeg argümanlarını döner
-func (a *AudioConverter) getCodecArgs(to string, quality int) []string {
-	// Varsayılan bitrate belirle
-	bitrate := "192k"
-	if quality > 0 {
-		switch {
-		case quality <= 25:
-			bitrate = "96k"
-		case quality <= 50:
-			bitrate = "128k"
-		case quality <= 75:
-			bitrate = "192k"
-		default:
-			bitrate = "320k"
-		}
-	}
-
-	switch to {
-	case "mp3":
-		return []string{"-codec:a", "libmp3lame", "-b:a", bitrate}
-	case "wav":
-		return []string{"-codec:a", "pcm_s16le"} // WAV kalitesiz — lossless
-	case "ogg":
-		return []string{"-codec:a", "libvorbis", "-b:a", bitrate}
-	case "flac":
-		return []string{"-codec:a", "flac"} // FLAC lossless
-	case "aac":
-		return []string{"-codec:a", "aac", "-b:a", bitrate}
-	case "m4a":
-		return []string{"-codec:a", "aac", "-b:a", bitrate}
-	case "wma":
-		return []string{"-codec:a", "wmav2", "-b:a", bitrate}
-	default:
-		return []string{"-b:a", bitrate}
-	}
+// getCodecArgs hedef format, kalite ve ses ayarlarına göre FFmpeg argümanlarını döner
+func (a *AudioConverter) getCodecArgs(to string, quality int, spec *AudioSpec) []string {
+	return audioEncodeArgs(to, quality, spec)
 }
 
 // findFFmpeg sistemde FFmpeg'i arar
@@ -0,0 +1,378 @@
+package converter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	AudioBitrateModeVBR = "vbr"
+	AudioBitrateModeCBR = "cbr"
+)
+
+const (
+	AudioBitDepth16  = "16"
+	AudioBitDepth24  = "24"
+	AudioBitDepth32F = "32f"
+)
+
+// AudioSpec ses çıktısı için ayrıntılı encode ayarlarını tutar.
+// Sıfır değerli alanlar "hedef formatın varsayılanını kullan" anlamına gelir.
+type AudioSpec struct {
+	SampleRate  int    // Hz (ör: 44100, 48000)
+	BitDepth    string // 16, 24, 32f (yalnızca wav/flac)
+	Channels    int    // 1=mono, 2=stereo ...
+	Bitrate     string // normalize edilmiş bitrate (ör: 192k)
+	BitrateMode string // vbr, cbr
+	Codec       string // FFmpeg encoder override (ör: libmp3lame)
+}
+
+// audioCodecChoices format başına izin verilen encoder'lar; ilk eleman varsayılandır.
+var audioCodecChoices = map[string][]string{
+	"mp3":  {"libmp3lame", "libshine"},
+	"wav":  {"pcm_s16le", "pcm_s24le", "pcm_s32le", "pcm_f32le", "pcm_u8", "pcm_alaw", "pcm_mulaw"},
+	"ogg":  {"libvorbis", "libopus", "flac"},
+	"flac": {"flac"},
+	"aac":  {"aac", "libfdk_aac"},
+	"m4a":  {"aac", "libfdk_aac", "alac"},
+	"wma":  {"wmav2", "wmav1"},
+	"opus": {"libopus", "opus"},
+	"webm": {"libopus", "libvorbis"},
+}
+
+// lossyAudioBitrateFormats bitrate ayarının anlamlı olduğu formatlar.
+var lossyAudioBitrateFormats = []string{"mp3", "ogg", "aac", "m4a", "wma", "opus", "webm"}
+
+var opusSampleRates = []int{8000, 12000, 16000, 24000, 48000}
+
+var mp3SampleRates = []int{8000, 11025, 12000, 16000, 22050, 24000, 32000, 44100, 48000}
+
+// IsAudioFormat formatın ses çıktı formatı olup olmadığını döner.
+func IsAudioFormat(format string) bool {
+	return slices.Contains(audioFormats, NormalizeFormat(format))
+}
+
+// IsZero spec'te hiçbir ayar verilmemişse true döner.
+func (s *AudioSpec) IsZero() bool {
+	return s == nil || *s == AudioSpec{}
+}
+
+// BuildAudioSpec bayraklardan ortak ses ayarını üretir.
+// Hiçbir ayar verilmemişse nil döner.
+func BuildAudioSpec(sampleRate int, bitDepth string, channels int, bitrate string, vbr bool, cbr bool, codec string) (*AudioSpec, error) {
+	if vbr && cbr {
+		return nil, fmt.Errorf("--vbr ve --cbr birlikte kullanılamaz")
+	}
+	if sampleRate < 0 {
+		return nil, fmt.Errorf("örnekleme hızı negatif olamaz: %d", sampleRate)
+	}
+	if channels < 0 {
+		return nil, fmt.Errorf("kanal sayısı negatif olamaz: %d", channels)
+	}
+
+	spec := &AudioSpec{
+		SampleRate: sampleRate,
+		Channels:   channels,
+		Codec:      strings.ToLower(strings.TrimSpace(codec)),
+	}
+
+	if strings.TrimSpace(bitDepth) != "" {
+		depth, err := NormalizeAudioBitDepth(bitDepth)
+		if err != nil {
+			return nil, err
+		}
+		spec.BitDepth = depth
+	}
+	if strings.TrimSpace(bitrate) != "" {
+		normalized, err := NormalizeAudioBitrate(bitrate)
+		if err != nil {
+			return nil, err
+		}
+		spec.Bitrate = normalized
+	}
+	switch {
+	case vbr:
+		spec.BitrateMode = AudioBitrateModeVBR
+	case cbr:
+		spec.BitrateMode = AudioBitrateModeCBR
+	}
+
+	if spec.IsZero() {
+		return nil, nil
+	}
+	return spec, nil
+}
+
+// NormalizeAudioBitDepth bit derinliği girişini 16, 24 veya 32f olarak normalize eder.
+func NormalizeAudioBitDepth(raw string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "16", "s16":
+		return AudioBitDepth16, nil
+	case "24", "s24":
+		return AudioBitDepth24, nil
+	case "32f", "f32", "32float", "float":
+		return AudioBitDepth32F, nil
+	default:
+		return "", fmt.Errorf("geçersiz bit derinliği: %s (geçerli: 16, 24, 32f)", raw)
+	}
+}
+
+// NormalizeAudioBitrate "192k", "192kbps", "192000" gibi değerleri "192k" biçimine çevirir.
+func NormalizeAudioBitrate(raw string) (string, error) {
+	kbps, err := parseBitrateKbps(raw)
+	if err != nil {
+		return "", err
+	}
+	if kbps < 8 || kbps > 640 {
+		return "", fmt.Errorf("ses bitrate 8k-640k aralığında olmalı: %s", raw)
+	}
+	return fmt.Sprintf("%dk", kbps), nil
+}
+
+// parseBitrateKbps bitrate girişini kbps cinsinden döner.
+func parseBitrateKbps(raw string) (int, error) {
+	s := strings.ToLower(strings.TrimSpace(raw))
+	s = strings.TrimSuffix(s, "bps")
+	s = strings.TrimSuffix(s, "b")
+	if s == "" {
+		return 0, fmt.Errorf("bitrate değeri boş")
+	}
+
+	multiplier := 0.001
+	switch {
+	case strings.HasSuffix(s, "k"):
+		multiplier = 1
+		s = strings.TrimSuffix(s, "k")
+	case strings.HasSuffix(s, "m"):
+		multiplier = 1000
+		s = strings.TrimSuffix(s, "m")
+	}
+
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("geçersiz bitrate değeri: %s", raw)
+	}
+	kbps := int(v*multiplier + 0.5)
+	if kbps <= 0 {
+		return 0, fmt.Errorf("geçersiz bitrate değeri: %s", raw)
+	}
+	return kbps, nil
+}
+
+// ValidateAudioSpec ses ayarlarının hedef formatla uyumunu FFmpeg çağrılmadan önce kontrol eder.
+func ValidateAudioSpec(spec *AudioSpec, targetFormat string) error {
+	if spec.IsZero() {
+		return nil
+	}
+	to := NormalizeFormat(targetFormat)
+	if !IsAudioFormat(to) {
+		return fmt.Errorf("ses ayarları sadece ses çıktılarında kullanılabilir (hedef: %s)", to)
+	}
+
+	if spec.Codec != "" && spec.Codec != "copy" && !slices.Contains(audioCodecChoices[to], spec.Codec) {
+		return fmt.Errorf("%s için desteklenmeyen codec: %s (geçerli: %s)", to, spec.Codec, strings.Join(audioCodecChoices[to], ", "))
+	}
+	if spec.Codec == "copy" && (spec.SampleRate > 0 || spec.Channels > 0 || spec.BitDepth != "" || spec.Bitrate != "" || spec.BitrateMode != "") {
+		return fmt.Errorf("codec copy modunda diğer ses ayarları kullanılamaz")
+	}
+
+	if spec.SampleRate > 0 {
+		if spec.SampleRate < 8000 || spec.SampleRate > 192000 {
+			return fmt.Errorf("örnekleme hızı 8000-192000 Hz aralığında olmalı: %d", spec.SampleRate)
+		}
+		switch {
+		case to == "mp3" && !slices.Contains(mp3SampleRates, spec.SampleRate):
+			return fmt.Errorf("mp3 için geçersiz örnekleme hızı: %d (geçerli: %s)", spec.SampleRate, joinInts(mp3SampleRates))
+		case (to == "opus" || to == "webm" || spec.Codec == "libopus") && !slices.Contains(opusSampleRates, spec.SampleRate):
+			return fmt.Errorf("opus için geçersiz örnekleme hızı: %d (geçerli: %s)", spec.SampleRate, joinInts(opusSampleRates))
+		}
+	}
+
+	if spec.Channels > 0 {
+		if spec.Channels > 8 {
+			return fmt.Errorf("kanal sayısı 1-8 aralığında olmalı: %d", spec.Channels)
+		}
+		if (to == "mp3" || to == "wma") && spec.Channels > 2 {
+			return fmt.Errorf("%s en fazla 2 kanal destekler", to)
+		}
+	}
+
+	if spec.BitDepth != "" {
+		switch to {
+		case "wav":
+			if spec.Codec != "" {
+				return fmt.Errorf("wav için --bit-depth ve --audio-codec birlikte kullanılamaz")
+			}
+		case "flac":
+			if spec.BitDepth == AudioBitDepth32F {
+				return fmt.Errorf("flac 32f bit derinliğini desteklemez (geçerli: 16, 24)")
+			}
+		default:
+			return fmt.Errorf("--bit-depth sadece wav ve flac çıktılarında kullanılabilir")
+		}
+	}
+
+	if spec.Bitrate != "" && !slices.Contains(lossyAudioBitrateFormats, to) {
+		return fmt.Errorf("%s kayıpsız bir formattır, --bitrate kullanılamaz", to)
+	}
+	if spec.Bitrate != "" && isLosslessAudioCodec(spec.Codec) {
+		return fmt.Errorf("%s kayıpsız bir codec'tir, --bitrate kullanılamaz", spec.Codec)
+	}
+
+	switch spec.BitrateMode {
+	case AudioBitrateModeVBR:
+		switch {
+		case to == "mp3" || to == "ogg":
+			if spec.Bitrate != "" {
+				return fmt.Errorf("%s vbr modunda --bitrate yerine --quality kullanın", to)
+			}
+		case to == "opus" || to == "webm":
+		case (to == "aac" || to == "m4a") && spec.Codec == "libfdk_aac":
+		default:
+			return fmt.Errorf("%s için vbr modu desteklenmiyor", to)
+		}
+	case AudioBitrateModeCBR:
+		if !slices.Contains(lossyAudioBitrateFormats, to) || isLosslessAudioCodec(spec.Codec) {
+			return fmt.Errorf("%s için cbr modu desteklenmiyor", to)
+		}
+	}
+
+	return nil
+}
+
+// audioEncodeArgs hedef format, kalite ve ses ayarlarına göre FFmpeg argümanlarını döner.
+func audioEncodeArgs(to string, quality int, spec *AudioSpec) []string {
+	if spec == nil {
+		spec = &AudioSpec{}
+	}
+	if spec.Codec == "copy" {
+		return []string{"-codec:a", "copy"}
+	}
+
+	bitrate := audioQualityBitrate(quality)
+	if spec.Bitrate != "" {
+		bitrate = spec.Bitrate
+	}
+
+	// opus/webm için encoder seçimi tarihsel olarak FFmpeg'e bırakılır (libopus).
+	codec := spec.Codec
+	emitCodec := codec != ""
+	if codec == "" {
+		if choices := audioCodecChoices[to]; len(choices) > 0 {
+			codec = choices[0]
+		}
+		emitCodec = to != "opus" && to != "webm"
+	}
+	if to == "wav" && spec.Codec == "" {
+		codec = wavCodecForBitDepth(spec.BitDepth)
+	}
+
+	var args []string
+	if emitCodec && codec != "" {
+		args = append(args, "-codec:a", codec)
+	}
+
+	switch {
+	case codec == "flac":
+		switch spec.BitDepth {
+		case AudioBitDepth16:
+			args = append(args, "-sample_fmt", "s16")
+		case AudioBitDepth24:
+			args = append(args, "-sample_fmt", "s32", "-bits_per_raw_sample", "24")
+		}
+	case strings.HasPrefix(codec, "pcm_"), codec == "alac":
+		// Kayıpsız codec'lerde bitrate parametresi kullanılmaz.
+	case spec.BitrateMode == AudioBitrateModeVBR:
+		args = append(args, audioVBRArgs(codec, quality, spec.Bitrate)...)
+	case spec.BitrateMode == AudioBitrateModeCBR:
+		args = append(args, "-b:a", bitrate)
+		switch codec {
+		case "libopus":
+			args = append(args, "-vbr", "off")
+		case "libvorbis":
+			args = append(args, "-minrate", bitrate, "-maxrate", bitrate)
+		}
+	default:
+		args = append(args, "-b:a", bitrate)
+	}
+
+	if spec.SampleRate > 0 {
+		args = append(args, "-ar", strconv.Itoa(spec.SampleRate))
+	}
+	if spec.Channels > 0 {
+		args = append(args, "-ac", strconv.Itoa(spec.Channels))
+	}
+	return args
+}
+
+func audioVBRArgs(codec string, quality int, bitrate string) []string {
+	switch codec {
+	case "libmp3lame":
+		// LAME -q:a: 0 en yüksek, 9 en düşük kalite
+		return []string{"-q:a", strconv.Itoa(scaleQuality(quality, 9, 0, 2))}
+	case "libvorbis":
+		// Vorbis -q:a: 0 en düşük, 10 en yüksek kalite
+		return []string{"-q:a", strconv.Itoa(scaleQuality(quality, 0, 10, 6))}
+	case "libfdk_aac":
+		return []string{"-vbr", strconv.Itoa(scaleQuality(quality, 1, 5, 4))}
+	case "libopus":
+		if bitrate == "" {
+			bitrate = audioQualityBitrate(quality)
+		}
+		return []string{"-b:a", bitrate, "-vbr", "on"}
+	default:
+		return []string{"-b:a", audioQualityBitrate(quality)}
+	}
+}
+
+// scaleQuality 1-100 kalite değerini [worst, best] aralığına ölçekler.
+func scaleQuality(quality int, worst int, best int, fallback int) int {
+	if quality <= 0 {
+		return fallback
+	}
+	if quality > 100 {
+		quality = 100
+	}
+	return worst + (best-worst)*quality/100
+}
+
+func audioQualityBitrate(quality int) string {
+	if quality <= 0 {
+		return "192k"
+	}
+	switch {
+	case quality <= 25:
+		return "96k"
+	case quality <= 50:
+		return "128k"
+	case quality <= 75:
+		return "192k"
+	default:
+		return "320k"
+	}
+}
+
+func isLosslessAudioCodec(codec string) bool {
+	return codec == "flac" || codec == "alac" || strings.HasPrefix(codec, "pcm_")
+}
+
+func wavCodecForBitDepth(depth string) string {
+	switch depth {
+	case AudioBitDepth24:
+		return "pcm_s24le"
+	case AudioBitDepth32F:
+		return "pcm_f32le"
+	default:
+		return "pcm_s16le"
+	}
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,127 @@
+package converter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBuildAudioSpecEmpty(t *testing.T) {
+	spec, err := BuildAudioSpec(0, "", 0, "", false, false, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec != nil {
+		t.Fatalf("expected nil spec when no option is set, got %#v", spec)
+	}
+}
+
+func TestBuildAudioSpecNormalizes(t *testing.T) {
+	spec, err := BuildAudioSpec(48000, "F32", 2, "192000", false, true, " LIBMP3LAME ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.BitDepth != AudioBitDepth32F {
+		t.Fatalf("expected 32f bit depth, got %s", spec.BitDepth)
+	}
+	if spec.Bitrate != "192k" {
+		t.Fatalf("expected 192k bitrate, got %s", spec.Bitrate)
+	}
+	if spec.BitrateMode != AudioBitrateModeCBR {
+		t.Fatalf("expected cbr mode, got %s", spec.BitrateMode)
+	}
+	if spec.Codec != "libmp3lame" {
+		t.Fatalf("expected normalized codec, got %s", spec.Codec)
+	}
+
+	if _, err := BuildAudioSpec(0, "", 0, "", true, true, ""); err == nil {
+		t.Fatalf("expected error when vbr and cbr are both set")
+	}
+	if _, err := BuildAudioSpec(0, "12", 0, "", false, false, ""); err == nil {
+		t.Fatalf("expected error for invalid bit depth")
+	}
+	if _, err := BuildAudioSpec(0, "", 0, "2m", false, false, ""); err == nil {
+		t.Fatalf("expected error for out of range bitrate")
+	}
+}
+
+func TestValidateAudioSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    AudioSpec
+		target  string
+		wantErr string
+	}{
+		{name: "wav 24 bit", spec: AudioSpec{BitDepth: AudioBitDepth24, SampleRate: 96000}, target: "wav"},
+		{name: "flac 32f", spec: AudioSpec{BitDepth: AudioBitDepth32F}, target: "flac", wantErr: "32f"},
+		{name: "mp3 bit depth", spec: AudioSpec{BitDepth: AudioBitDepth16}, target: "mp3", wantErr: "wav ve flac"},
+		{name: "flac bitrate", spec: AudioSpec{Bitrate: "192k"}, target: "flac", wantErr: "kayıpsız"},
+		{name: "mp3 sample rate", spec: AudioSpec{SampleRate: 96000}, target: "mp3", wantErr: "mp3"},
+		{name: "opus sample rate", spec: AudioSpec{SampleRate: 44100}, target: "opus", wantErr: "opus"},
+		{name: "mp3 channels", spec: AudioSpec{Channels: 6}, target: "mp3", wantErr: "2 kanal"},
+		{name: "codec mismatch", spec: AudioSpec{Codec: "libvorbis"}, target: "mp3", wantErr: "desteklenmeyen codec"},
+		{name: "mp3 vbr with bitrate", spec: AudioSpec{BitrateMode: AudioBitrateModeVBR, Bitrate: "192k"}, target: "mp3", wantErr: "--quality"},
+		{name: "aac vbr native", spec: AudioSpec{BitrateMode: AudioBitrateModeVBR}, target: "aac", wantErr: "vbr"},
+		{name: "aac vbr fdk", spec: AudioSpec{BitrateMode: AudioBitrateModeVBR, Codec: "libfdk_aac"}, target: "m4a"},
+		{name: "wav cbr", spec: AudioSpec{BitrateMode: AudioBitrateModeCBR}, target: "wav", wantErr: "cbr"},
+		{name: "non audio target", spec: AudioSpec{Channels: 1}, target: "mp4", wantErr: "ses çıktılarında"},
+	}
+
+	for _, tt := range tests {
+		spec := tt.spec
+		err := ValidateAudioSpec(&spec, tt.target)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("%s: expected error containing %q, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestAudioEncodeArgsDefaultsUnchanged(t *testing.T) {
+	got := audioEncodeArgs("mp3", 80, nil)
+	want := []string{"-codec:a", "libmp3lame", "-b:a", "320k"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected mp3 args: %v", got)
+	}
+
+	got = audioEncodeArgs("wav", 0, nil)
+	if !slices.Equal(got, []string{"-codec:a", "pcm_s16le"}) {
+		t.Fatalf("unexpected wav args: %v", got)
+	}
+
+	got = audioEncodeArgs("opus", 0, nil)
+	if !slices.Equal(got, []string{"-b:a", "192k"}) {
+		t.Fatalf("unexpected opus args: %v", got)
+	}
+}
+
+func TestAudioEncodeArgsWithSpec(t *testing.T) {
+	got := audioEncodeArgs("wav", 0, &AudioSpec{BitDepth: AudioBitDepth24, SampleRate: 48000, Channels: 2})
+	want := []string{"-codec:a", "pcm_s24le", "-ar", "48000", "-ac", "2"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected wav args: %v", got)
+	}
+
+	got = audioEncodeArgs("flac", 0, &AudioSpec{BitDepth: AudioBitDepth24})
+	want = []string{"-codec:a", "flac", "-sample_fmt", "s32", "-bits_per_raw_sample", "24"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected flac args: %v", got)
+	}
+
+	got = audioEncodeArgs("mp3", 100, &AudioSpec{BitrateMode: AudioBitrateModeVBR})
+	want = []string{"-codec:a", "libmp3lame", "-q:a", "0"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected mp3 vbr args: %v", got)
+	}
+
+	got = audioEncodeArgs("opus", 0, &AudioSpec{BitrateMode: AudioBitrateModeCBR, Bitrate: "64k", Channels: 1})
+	want = []string{"-b:a", "64k", "-vbr", "off", "-ac", "1"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected opus cbr args: %v", got)
+	}
+}
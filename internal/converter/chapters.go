@@ -0,0 +1,28 @@
+package converter
+
+// Chapter medya dosyasındaki tek bir bölüm işareti.
+type Chapter struct {
+	Index int     `json:"index"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+	Title string  `json:"title,omitempty"`
+}
+
+// ProbeChapters FFprobe ile dosyanın bölüm listesini okur; bölüm yoksa boş liste döner.
+func ProbeChapters(path string) ([]Chapter, error) {
+	output, err := runFFprobeJSON(path, "-show_chapters")
+	if err != nil {
+		return nil, err
+	}
+	return ParseChapters(output)
+}
+
+// ParseChapters ffprobe -show_chapters JSON çıktısını bölüm listesine çevirir.
+// Süresi olmayan bölümler atlanır, başlık etiketi büyük/küçük harf duyarsız okunur.
+func ParseChapters(data []byte) ([]Chapter, error) {
+	details, err := ParseMediaDetails(data)
+	if err != nil {
+		return nil, err
+	}
+	return details.Chapters, nil
+}
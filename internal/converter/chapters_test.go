@@ -0,0 +1,35 @@
+package converter
+
+import "testing"
+
+func TestParseChapters(t *testing.T) {
+	data := []byte(`{"chapters":[
+		{"start_time":"0.000000","end_time":"60.500000","tags":{"title":" Giriş "}},
+		{"start_time":"60.500000","end_time":"60.500000","tags":{}},
+		{"start_time":"60.500000","end_time":"120.000000","tags":{"TITLE":"Bölüm 1"}},
+		{"start_time":"bad","end_time":"130"}
+	]}`)
+	chapters, err := ParseChapters(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(chapters) != 2 {
+		t.Fatalf("expected 2 chapters, got %+v", chapters)
+	}
+	if chapters[0].Title != "Giriş" || chapters[0].End != 60.5 {
+		t.Fatalf("unexpected first chapter: %+v", chapters[0])
+	}
+	if chapters[1].Index != 2 || chapters[1].Title != "Bölüm 1" || chapters[1].Start != 60.5 {
+		t.Fatalf("unexpected second chapter: %+v", chapters[1])
+	}
+}
+
+func TestParseChaptersEmpty(t *testing.T) {
+	chapters, err := ParseChapters([]byte(`{}`))
+	if err != nil || len(chapters) != 0 {
+		t.Fatalf("expected empty list, got %+v err=%v", chapters, err)
+	}
+	if _, err := ParseChapters([]byte(`not json`)); err == nil {
+		t.Fatalf("expected error for invalid json")
+	}
+}
@@ -23,6 +23,16 @@ type Options struct {
 	Optimize bool
 	// TargetSize: hedef dosya boyutu (byte), 0 = sınırsız
 	TargetSize int64
+	// Audio: ses çıktıları için örnekleme hızı, kanal, bitrate ve codec ayarları
+	Audio *AudioSpec
+	// Video: video çıktıları için codec, CRF/bitrate, preset ve piksel formatı ayarları
+	Video *VideoSpec
+	// Animation: videodan gif/webp üretiminde palet, dithering, aralık ve döngü ayarları
+	Animation *AnimationSpec
+	// Watermark: video çıktılarına logo/yazı bindirme ve zaman kodu/dosya adı yakma ayarları
+	Watermark *WatermarkSpec
+	// Transform: video çıktılarında kırpma, en-boy oranı kırpması, döndürme, çevirme ve otomatik siyah bant kırpma
+	Transform *TransformSpec
 }
 
 // Result dönüşüm sonucunu tutar
@@ -45,6 +55,18 @@ type Converter interface {
 	SupportedConversions() []ConversionPair
 }
 
+// OutputTarget aynı kaynaktan üretilecek tek bir çıktıyı tanımlar
+type OutputTarget struct {
+	Path    string
+	Options Options
+}
+
+// MultiConverter kaynağı bir kez okuyup birden fazla çıktı üretebilen dönüştürücülerin
+// opsiyonel arayüzüdür. Dönen hata listesi outputs ile aynı sıradadır; nil başarı demektir.
+type MultiConverter interface {
+	ConvertMulti(input string, outputs []OutputTarget) []error
+}
+
 // ConversionPair bir kaynak-hedef format çiftini temsil eder
 type ConversionPair struct {
 	From        string
@@ -104,46 +104,77 @@ func (d *DocumentConverter) Convert(input string, output string, opts Options) e
 	from := DetectFormat(input)
 	to := DetectFormat(output)
 
+	r, ok := d.route(from, to, input, output, opts)
+	if !ok {
+		return fmt.Errorf("desteklenmeyen dönüşüm: %s → %s", from, to)
+	}
+	return r.run()
+}
+
+// documentRoute bir belge dönüşümünün nasıl yapılacağını tanımlar.
+type documentRoute struct {
+	run func() error
+	// libreOffice dönüşümün, kuruluysa LibreOffice'i çalıştırabileceğini bildirir.
+	libreOffice bool
+}
+
+// route from -> to dönüşümünün yolunu döner. Convert ve UsesLibreOffice aynı tabloyu
+// kullanır; böylece zamanlayıcının LibreOffice sınırı dönüştürücüyle senkron kalır.
+func (d *DocumentConverter) route(from, to, input, output string, opts Options) (documentRoute, bool) {
+	native := func(run func() error) (documentRoute, bool) {
+		return documentRoute{run: run}, true
+	}
+	// withLibreOffice LibreOffice'i öncelikli deneyen kendi yedek yolu olan dönüşümlerdir.
+	withLibreOffice := func(run func() error) (documentRoute, bool) {
+		return documentRoute{run: run, libreOffice: true}, true
+	}
+	viaLibreOffice := func(targetFmt string, fallback func() error) (documentRoute, bool) {
+		return withLibreOffice(func() error {
+			return d.convertViaLibreOffice(input, output, targetFmt, fallback)
+		})
+	}
+
 	switch {
 	// Markdown dönüşümleri
 	case from == "md" && to == "html":
-		return d.mdToHTML(input, output)
+		return native(func() error { return d.mdToHTML(input, output) })
 	case from == "md" && to == "txt":
-		return d.mdToTxt(input, output)
+		return native(func() error { return d.mdToTxt(input, output) })
 	case from == "md" && to == "pdf":
-		return d.mdToPDF(input, output)
+		// Pandoc yoksa veya başarısız olursa LibreOffice'e düşer
+		return withLibreOffice(func() error { return d.mdToPDF(input, output) })
 	case from == "md" && to == "docx":
-		return d.textToDocx(input, output, true)
+		return native(func() error { return d.textToDocx(input, output, true) })
 	case from == "md" && to == "odt":
-		return d.convertViaLibreOffice(input, output, "odt", func() error {
+		return viaLibreOffice("odt", func() error {
 			return d.textToDocx(input, output, true)
 		})
 	case from == "md" && to == "rtf":
-		return d.convertViaLibreOffice(input, output, "rtf", nil)
+		return viaLibreOffice("rtf", nil)
 	// HTML dönüşümleri
 	case from == "html" && to == "txt":
-		return d.htmlToTxt(input, output)
+		return native(func() error { return d.htmlToTxt(input, output) })
 	case from == "html" && to == "md":
-		return d.htmlToMd(input, output)
+		return native(func() error { return d.htmlToMd(input, output) })
 	case from == "html" && to == "pdf":
-		return d.htmlToPDF(input, output)
+		return withLibreOffice(func() error { return d.htmlToPDF(input, output) })
 	case from == "html" && to == "docx":
-		return d.htmlToDocx(input, output)
+		return native(func() error { return d.htmlToDocx(input, output) })
 	case from == "html" && to == "odt":
-		return d.convertViaLibreOffice(input, output, "odt", nil)
+		return viaLibreOffice("odt", nil)
 	case from == "html" && to == "rtf":
-		return d.convertViaLibreOffice(input, output, "rtf", nil)
+		return viaLibreOffice("rtf", nil)
 	// PDF dönüşümleri
 	case from == "pdf" && to == "txt":
-		return d.pdfToTxt(input, output)
+		return native(func() error { return d.pdfToTxt(input, output) })
 	case from == "pdf" && to == "docx":
-		return d.pdfToDocx(input, output)
+		return native(func() error { return d.pdfToDocx(input, output) })
 	case from == "pdf" && to == "html":
-		return d.pdfToHTML(input, output)
+		return native(func() error { return d.pdfToHTML(input, output) })
 	case from == "pdf" && to == "md":
-		return d.pdfToMd(input, output)
+		return native(func() error { return d.pdfToMd(input, output) })
 	case from == "pdf" && to == "odt":
-		return d.convertViaLibreOffice(input, output, "odt", func() error {
+		return viaLibreOffice("odt", func() error {
 			text, err := d.extractPdfText(input)
 			if err != nil {
 				return err
@@ -151,93 +182,91 @@ func (d *DocumentConverter) Convert(input string, output string, opts Options) e
 			return createSimpleDocx(output, text)
 		})
 	case from == "pdf" && to == "rtf":
-		return d.convertViaLibreOffice(input, output, "rtf", nil)
+		return viaLibreOffice("rtf", nil)
 	// DOCX dönüşümleri
 	case from == "docx" && to == "txt":
-		return d.docxToTxt(input, output)
+		return native(func() error { return d.docxToTxt(input, output) })
 	case from == "docx" && to == "pdf":
-		return d.docxToPDF(input, output)
+		return withLibreOffice(func() error { return d.docxToPDF(input, output) })
 	case from == "docx" && to == "html":
-		return d.docxToHTML(input, output)
+		return native(func() error { return d.docxToHTML(input, output) })
 	case from == "docx" && to == "md":
-		return d.docxToMd(input, output)
+		return native(func() error { return d.docxToMd(input, output) })
 	case from == "docx" && to == "odt":
-		return d.convertViaLibreOffice(input, output, "odt", nil)
+		return viaLibreOffice("odt", nil)
 	case from == "docx" && to == "rtf":
-		return d.convertViaLibreOffice(input, output, "rtf", nil)
+		return viaLibreOffice("rtf", nil)
 	// TXT dönüşümleri
 	case from == "txt" && to == "pdf":
-		return d.txtToPDF(input, output, opts)
+		return native(func() error { return d.txtToPDF(input, output, opts) })
 	case from == "txt" && to == "html":
-		return d.txtToHTML(input, output)
+		return native(func() error { return d.txtToHTML(input, output) })
 	case from == "txt" && to == "docx":
-		return d.textToDocx(input, output, false)
+		return native(func() error { return d.textToDocx(input, output, false) })
 	case from == "txt" && to == "md":
-		return d.txtToMd(input, output)
+		return native(func() error { return d.txtToMd(input, output) })
 	case from == "txt" && to == "odt":
-		return d.convertViaLibreOffice(input, output, "odt", func() error {
+		return viaLibreOffice("odt", func() error {
 			return d.textToDocx(input, output, false)
 		})
 	case from == "txt" && to == "rtf":
-		return d.convertViaLibreOffice(input, output, "rtf", nil)
+		return viaLibreOffice("rtf", nil)
 	// ODT dönüşümleri
 	case from == "odt" && to == "pdf":
-		return d.odtToPDF(input, output)
+		return withLibreOffice(func() error { return d.odtToPDF(input, output) })
 	case from == "odt" && to == "docx":
-		return d.convertViaLibreOffice(input, output, "docx", func() error {
+		return viaLibreOffice("docx", func() error {
 			text := d.extractOdtText(input)
 			return createSimpleDocx(output, text)
 		})
 	case from == "odt" && to == "html":
-		return d.convertViaLibreOffice(input, output, "html", func() error {
+		return viaLibreOffice("html", func() error {
 			text := d.extractOdtText(input)
 			return d.textToHTMLFile(output, text)
 		})
-	case from == "odt" && to == "txt":
-		text := d.extractOdtText(input)
-		return os.WriteFile(output, []byte(text), 0644)
-	case from == "odt" && to == "md":
-		text := d.extractOdtText(input)
-		return os.WriteFile(output, []byte(text), 0644)
+	case from == "odt" && to == "txt", from == "odt" && to == "md":
+		return native(func() error {
+			text := d.extractOdtText(input)
+			return os.WriteFile(output, []byte(text), 0644)
+		})
 	case from == "odt" && to == "rtf":
-		return d.convertViaLibreOffice(input, output, "rtf", nil)
+		return viaLibreOffice("rtf", nil)
 	// RTF dönüşümleri
 	case from == "rtf" && to == "pdf":
-		return d.convertViaLibreOffice(input, output, "pdf", func() error {
+		return viaLibreOffice("pdf", func() error {
 			text := d.extractRtfText(input)
 			return createPDF(output, text, 12)
 		})
 	case from == "rtf" && to == "docx":
-		return d.convertViaLibreOffice(input, output, "docx", func() error {
+		return viaLibreOffice("docx", func() error {
 			text := d.extractRtfText(input)
 			return createSimpleDocx(output, text)
 		})
 	case from == "rtf" && to == "html":
-		return d.convertViaLibreOffice(input, output, "html", func() error {
+		return viaLibreOffice("html", func() error {
 			text := d.extractRtfText(input)
 			return d.textToHTMLFile(output, text)
 		})
-	case from == "rtf" && to == "txt":
-		text := d.extractRtfText(input)
-		return os.WriteFile(output, []byte(text), 0644)
-	case from == "rtf" && to == "md":
-		text := d.extractRtfText(input)
-		return os.WriteFile(output, []byte(text), 0644)
+	case from == "rtf" && to == "txt", from == "rtf" && to == "md":
+		return native(func() error {
+			text := d.extractRtfText(input)
+			return os.WriteFile(output, []byte(text), 0644)
+		})
 	case from == "rtf" && to == "odt":
-		return d.convertViaLibreOffice(input, output, "odt", nil)
+		return viaLibreOffice("odt", nil)
 	// CSV dönüşümleri
 	case from == "csv" && to == "html":
-		return d.csvToHTML(input, output)
+		return native(func() error { return d.csvToHTML(input, output) })
 	case from == "csv" && to == "md":
-		return d.csvToMd(input, output)
+		return native(func() error { return d.csvToMd(input, output) })
 	case from == "csv" && to == "txt":
-		return d.csvToTxt(input, output)
+		return native(func() error { return d.csvToTxt(input, output) })
 	case from == "csv" && to == "pdf":
-		return d.csvToPDF(input, output)
+		return native(func() error { return d.csvToPDF(input, output) })
 	case from == "csv" && to == "xlsx":
-		return d.convertViaLibreOffice(input, output, "xlsx", nil)
+		return viaLibreOffice("xlsx", nil)
 	default:
-		return fmt.Errorf("desteklenmeyen dönüşüm: %s → %s", from, to)
+		return documentRoute{}, false
 	}
 }
 
@@ -128,6 +128,17 @@ func IsLibreOfficeAvailable() bool {
 	return err == nil
 }
 
+// UsesLibreOffice from -> to dönüşümünün LibreOffice ile yapılıp yapılmayacağını döner.
+// Yönlendirme DocumentConverter'ın dönüşüm tablosundan okunur. LibreOffice kurulu
+// değilse yerleşik yedek yollar kullanıldığı için false döner.
+func UsesLibreOffice(from, to string) bool {
+	r, ok := (&DocumentConverter{}).route(NormalizeFormat(from), NormalizeFormat(to), "", "", Options{})
+	if !ok || !r.libreOffice {
+		return false
+	}
+	return IsLibreOfficeAvailable()
+}
+
 // IsPandocAvailable Pandoc'un yüklü olup olmadığını kontrol eder
 func IsPandocAvailable() bool {
 	_, err := findPandoc()
@@ -207,6 +218,16 @@ func ConvertWithLibreOffice(inputPath, outputPath, targetFormat string) error {
 	}
 	defer os.RemoveAll(tmpProfile)
 
+	// LibreOffice çıktıyı her zaman <girdi adı>.<format> olarak --outdir'e yazar. Çıktı
+	// dizinine doğrudan yazdırmak mevcut bir son çıktıyı yerinde ezer ve aynı adlı girdileri
+	// olan paralel işleri çakıştırır; bu yüzden işe özel bir dizin kullanılır. Dizin çıktıyla
+	// aynı dosya sisteminde olduğundan son taşıma atomik bir rename'dir.
+	loOutDir, err := os.MkdirTemp(outDir, ".libreoffice-out-*")
+	if err != nil {
+		return fmt.Errorf("geçici çıktı dizini oluşturulamadı: %w", err)
+	}
+	defer os.RemoveAll(loOutDir)
+
 	// LibreOffice headless komutu
 	args := []string{
 		"--headless",
@@ -214,11 +235,11 @@ func ConvertWithLibreOffice(inputPath, outputPath, targetFormat string) error {
 		"--nologo",
 		"-env:UserInstallation=file://" + tmpProfile,
 		"--convert-to", targetFormat,
-		"--outdir", outDir,
+		"--outdir", loOutDir,
 		inputPath,
 	}
 
-	cmd := exec.Command(soffice, args...)
+	cmd := Command(soffice, args...)
 	cmd.Stderr = nil
 	cmd.Stdout = nil
 
@@ -227,25 +248,15 @@ func ConvertWithLibreOffice(inputPath, outputPath, targetFormat string) error {
 			"  Komut: %s %s", err, soffice, strings.Join(args, " "))
 	}
 
-	// LibreOffice çıktı dosyasını kontrol et
-	// LO, dosyayı girdi dosyasının adıyla çıktı dizinine kaydeder
+	// LO, dosyayı girdi dosyasının adıyla geçici dizine kaydeder
 	inputBase := strings.TrimSuffix(filepath.Base(inputPath), filepath.Ext(inputPath))
-	loOutput := filepath.Join(outDir, inputBase+"."+targetFormat)
-
-	// Eğer çıktı dosya adı farklıysa, yeniden adlandır
-	if loOutput != outputPath {
-		if _, err := os.Stat(loOutput); err == nil {
-			if err := os.Rename(loOutput, outputPath); err != nil {
-				return fmt.Errorf("çıktı dosyası taşınamadı: %w", err)
-			}
-		}
-	}
-
-	// Çıktı dosyasının oluştuğunu doğrula
-	if _, err := os.Stat(outputPath); os.IsNotExist(err) {
+	loOutput := filepath.Join(loOutDir, inputBase+"."+targetFormat)
+	if _, err := os.Stat(loOutput); os.IsNotExist(err) {
 		return fmt.Errorf("LibreOffice dönüşüm tamamlandı ancak çıktı dosyası bulunamadı: %s", outputPath)
 	}
-
+	if err := os.Rename(loOutput, outputPath); err != nil {
+		return fmt.Errorf("çıktı dosyası taşınamadı: %w", err)
+	}
 	return nil
 }
 
@@ -304,7 +315,7 @@ func ConvertWithPandoc(inputPath, outputPath string) error {
 		}
 	}
 
-	cmd := exec.Command(pandoc, args...)
+	cmd := Command(pandoc, args...)
 
 	var stderr strings.Builder
 	cmd.Stderr = &stderr
@@ -0,0 +1,134 @@
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// fakeSoffice LibreOffice gibi çıktıyı --outdir altına <girdi adı>.<format> olarak yazan
+// bir betik kurar ve kullandığı --outdir değerini outdirLog dosyasına kaydeder.
+func fakeSoffice(t *testing.T, outdirLog string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("shell betiği gerektirir")
+	}
+	script := filepath.Join(t.TempDir(), "soffice")
+	content := `#!/bin/sh
+fmt=""; outdir=""; input=""
+while [ $# -gt 0 ]; do
+  case "$1" in
+    --convert-to) fmt="$2"; shift ;;
+    --outdir) outdir="$2"; shift ;;
+    -*) ;;
+    *) input="$1" ;;
+  esac
+  shift
+done
+echo "$outdir" > "` + outdirLog + `"
+base=$(basename "$input"); base="${base%.*}"
+echo converted > "$outdir/$base.$fmt"
+`
+	if err := os.WriteFile(script, []byte(content), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LIBREOFFICE_PATH", script)
+}
+
+func TestConvertWithLibreOfficeUsesPrivateOutdir(t *testing.T) {
+	dir := t.TempDir()
+	outdirLog := filepath.Join(t.TempDir(), "outdir.txt")
+	fakeSoffice(t, outdirLog)
+
+	input := filepath.Join(dir, "report.docx")
+	final := filepath.Join(dir, "report.pdf")
+	partial := filepath.Join(dir, ".report.partial.pdf")
+	if err := os.WriteFile(input, []byte("docx"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(final, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ConvertWithLibreOffice(input, partial, "pdf"); err != nil {
+		t.Fatalf("ConvertWithLibreOffice failed: %v", err)
+	}
+
+	// Mevcut son çıktıya atomik rename'e kadar dokunulmamalı
+	if data, _ := os.ReadFile(final); string(data) != "old" {
+		t.Fatalf("existing output was overwritten in place: %q", data)
+	}
+	if data, _ := os.ReadFile(partial); string(data) != "converted\n" {
+		t.Fatalf("unexpected converted output: %q", data)
+	}
+	used, err := os.ReadFile(outdirLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outdir := filepath.Clean(string(used[:len(used)-1])); outdir == dir {
+		t.Fatalf("LibreOffice should not write into the output directory")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected private outdir to be removed, got %d entries", len(entries))
+	}
+}
+
+func TestUsesLibreOfficeMatchesDocumentRouting(t *testing.T) {
+	outdirLog := filepath.Join(t.TempDir(), "outdir.txt")
+	fakeSoffice(t, outdirLog)
+	// Başarısız bir Pandoc, md -> pdf'in LibreOffice yedeğine düşmesini sağlar
+	pandoc := filepath.Join(t.TempDir(), "pandoc")
+	if err := os.WriteFile(pandoc, []byte("#!/bin/sh\nexit 1\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PANDOC_PATH", pandoc)
+
+	// convertViaLibreOffice ve ConvertWithLibreOffice çağrı noktalarına giden dönüşümler
+	want := map[string]bool{
+		"md>pdf": true, "md>odt": true, "md>rtf": true,
+		"html>pdf": true, "html>odt": true, "html>rtf": true,
+		"pdf>odt": true, "pdf>rtf": true,
+		"docx>pdf": true, "docx>odt": true, "docx>rtf": true,
+		"txt>odt": true, "txt>rtf": true,
+		"odt>pdf": true, "odt>docx": true, "odt>html": true, "odt>rtf": true,
+		"rtf>pdf": true, "rtf>docx": true, "rtf>html": true, "rtf>odt": true,
+		"csv>xlsx": true,
+	}
+
+	d := &DocumentConverter{}
+	seen := map[string]bool{}
+	for _, pair := range d.SupportedConversions() {
+		key := pair.From + ">" + pair.To
+		seen[key] = true
+		if got := UsesLibreOffice(pair.From, pair.To); got != want[key] {
+			t.Fatalf("UsesLibreOffice(%s) = %v, want %v", key, got, want[key])
+		}
+
+		// Dönüşümü gerçekten çalıştırıp soffice'in çağrılıp çağrılmadığını doğrula
+		dir := t.TempDir()
+		input := filepath.Join(dir, "in."+pair.From)
+		if err := os.WriteFile(input, []byte("hello"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		os.Remove(outdirLog)
+		func() {
+			// Yerleşik dönüştürücüler geçersiz girdide panic edebilir; yalnızca yönlendirme önemli
+			defer func() { recover() }()
+			d.Convert(input, filepath.Join(dir, "out."+pair.To), Options{})
+		}()
+		_, err := os.Stat(outdirLog)
+		if invoked := err == nil; invoked != want[key] {
+			t.Fatalf("%s: LibreOffice invoked = %v, routing says %v", key, invoked, want[key])
+		}
+	}
+	for key := range want {
+		if !seen[key] {
+			t.Fatalf("%s is not a supported document conversion", key)
+		}
+	}
+}
@@ -86,15 +86,33 @@ func (ic *ImageConverter) SupportsConversion(from, to string) bool {
 }
 
 func (ic *ImageConverter) Convert(input string, output string, opts Options) error {
-	from := DetectFormat(input)
-	to := DetectFormat(output)
-
 	// Görseli oku
-	img, err := ic.decodeImage(input, from)
+	img, err := ic.decodeImage(input, DetectFormat(input))
 	if err != nil {
 		return err
 	}
+	return ic.writeOutput(img, output, DetectFormat(output), opts)
+}
+
+// ConvertMulti görseli bir kez decode edip her çıktı için ayrı ayrı boyutlandırır ve encode eder.
+func (ic *ImageConverter) ConvertMulti(input string, outputs []OutputTarget) []error {
+	errs := make([]error, len(outputs))
+	img, err := ic.decodeImage(input, DetectFormat(input))
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	for i, out := range outputs {
+		errs[i] = ic.writeOutput(img, out.Path, DetectFormat(out.Path), out.Options)
+	}
+	return errs
+}
 
+// writeOutput decode edilmiş görseli seçeneklere göre tek bir çıktıya yazar
+func (ic *ImageConverter) writeOutput(img image.Image, output string, to string, opts Options) error {
+	var err error
 	if opts.Resize != nil {
 		img, err = ic.resizeImage(img, *opts.Resize)
 		if err != nil {
@@ -274,7 +292,7 @@ func decodeHEIFViaFFmpeg(path string) (image.Image, error) {
 		"-vcodec", "png",
 		"-",
 	}
-	out, err := exec.Command(ffmpegPath, args...).CombinedOutput()
+	out, err := Command(ffmpegPath, args...).CombinedOutput()
 	if err != nil {
 		msg := strings.TrimSpace(string(out))
 		if msg == "" {
@@ -34,6 +34,7 @@ type FileInfo struct {
 
 	// Video / Ses (FFprobe)
 	Duration   string  `json:"duration,omitempty"`
+	DurationS  float64 `json:"duration_seconds,omitempty"`
 	VideoCodec string  `json:"video_codec,omitempty"`
 	AudioCodec string  `json:"audio_codec,omitempty"`
 	Bitrate    string  `json:"bitrate,omitempty"`
@@ -41,6 +42,20 @@ type FileInfo struct {
 	Channels   int     `json:"channels,omitempty"`
 	SampleRate int     `json:"sample_rate,omitempty"`
 	Resolution string  `json:"resolution,omitempty"`
+
+	// ProbeError dosya içeriği çözülemediğinde nedenini taşır (bozuk/eksik dosya).
+	ProbeError string `json:"probe_error,omitempty"`
+
+	// Akış düzeyi ayrıntılar (FFprobe)
+	Container string            `json:"container,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Streams   []StreamInfo      `json:"streams,omitempty"`
+	Chapters  []Chapter         `json:"chapters,omitempty"`
+}
+
+// FormatCategory formatın kategorisini döner: image, video, audio, document veya unknown.
+func FormatCategory(format string) string {
+	return categorizeFormat(NormalizeFormat(format))
 }
 
 // categorizeFormat format adından kategori belirler
@@ -117,6 +132,10 @@ func fillImageInfo(info *FileInfo, path string) {
 
 	cfg, _, err := image.DecodeConfig(f)
 	if err != nil {
+		// SVG, HEIC ve ICO için Go çözücüsü yok; sadece çözülebilir formatlarda hata raporlanır.
+		if decodableImageFormats[strings.ToLower(info.Format)] {
+			info.ProbeError = fmt.Sprintf("görsel çözülemedi: %v", err)
+		}
 		return
 	}
 	info.Width = cfg.Width
@@ -124,6 +143,11 @@ func fillImageInfo(info *FileInfo, path string) {
 	info.Resolution = fmt.Sprintf("%dx%d", cfg.Width, cfg.Height)
 }
 
+// decodableImageFormats Go image paketinin boyut okuyabildiği formatlar
+var decodableImageFormats = map[string]bool{
+	"png": true, "jpg": true, "gif": true, "bmp": true, "tif": true, "webp": true,
+}
+
 // ffprobeResult ffprobe JSON çıktısının ilgili alanları
 type ffprobeResult struct {
 	Format struct {
@@ -153,21 +177,32 @@ func fillMediaInfo(info *FileInfo, path string) {
 		"-print_format", "json",
 		"-show_format",
 		"-show_streams",
+		"-show_chapters",
 		path,
 	)
 	output, err := cmd.Output()
 	if err != nil {
+		info.ProbeError = fmt.Sprintf("medya okunamadı: %v", err)
 		return
 	}
 
+	if details, err := ParseMediaDetails(output); err == nil {
+		info.Container = details.Container
+		info.Tags = details.Tags
+		info.Streams = details.Streams
+		info.Chapters = details.Chapters
+	}
+
 	var result ffprobeResult
 	if err := json.Unmarshal(output, &result); err != nil {
+		info.ProbeError = fmt.Sprintf("ffprobe çıktısı çözümlenemedi: %v", err)
 		return
 	}
 
 	// Duration
 	if result.Format.Duration != "" {
 		if dur, err := strconv.ParseFloat(result.Format.Duration, 64); err == nil {
+			info.DurationS = dur
 			hours := int(dur) / 3600
 			minutes := (int(dur) % 3600) / 60
 			seconds := int(dur) % 60
@@ -100,3 +100,17 @@ func copyFile(src, dst string) error {
 	}
 	return os.WriteFile(dst, data, 0644)
 }
+
+func TestGetFileInfoReportsUndecodableImage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bozuk.png")
+	if err := os.WriteFile(path, []byte("not a png"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	info, err := GetFileInfo(path)
+	if err != nil {
+		t.Fatalf("GetFileInfo failed: %v", err)
+	}
+	if info.ProbeError == "" {
+		t.Fatal("expected probe error for undecodable image")
+	}
+}
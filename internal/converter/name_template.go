@@ -0,0 +1,305 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// nameTemplateVars şablonda kullanılabilecek değişkenler.
+var nameTemplateVars = []string{
+	"name", "ext", "from", "parent", "date", "now", "index", "hash",
+	"width", "height", "duration", "quality",
+}
+
+// nameTemplateProbe boyut/süre değişkenleri için kaynak dosyayı okur; testlerde değiştirilebilir.
+var nameTemplateProbe = GetFileInfo
+
+// NameTemplate "{name}_{width}x{height}.{ext}" biçimindeki çıktı adı şablonudur.
+// Şablondaki "/" alt klasör oluşturur; değişken değerleri klasör ayırıcı içeremez.
+type NameTemplate struct {
+	raw   string
+	parts []templatePart
+}
+
+type templatePart struct {
+	literal  string
+	variable string
+	arg      string
+}
+
+// TemplateData şablon değişkenlerinin kaynağıdır. Width/Height/Duration sıfırsa ve şablon
+// bunları kullanıyorsa girdi dosyası probe edilir.
+type TemplateData struct {
+	InputPath    string
+	TargetFormat string
+	// Index toplu işlerde girdinin 1'den başlayan sırasıdır.
+	Index    int
+	Quality  int
+	Width    int
+	Height   int
+	Duration float64
+	// Now {now} değişkeni için zaman; boşsa o anki zaman kullanılır.
+	Now time.Time
+}
+
+// ParseNameTemplate şablonu ayrıştırır ve değişkenleri doğrular. Boş şablon nil döner.
+func ParseNameTemplate(raw string) (*NameTemplate, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	if filepath.IsAbs(raw) || strings.HasPrefix(raw, "/") {
+		return nil, fmt.Errorf("isim şablonu göreli olmalı: %s", raw)
+	}
+
+	t := &NameTemplate{raw: raw}
+	rest := raw
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			t.parts = append(t.parts, templatePart{literal: rest})
+			break
+		}
+		if open > 0 {
+			t.parts = append(t.parts, templatePart{literal: rest[:open]})
+		}
+		closeIdx := strings.IndexByte(rest[open:], '}')
+		if closeIdx < 0 {
+			return nil, fmt.Errorf("isim şablonunda kapanmamış '{': %s", raw)
+		}
+		token := rest[open+1 : open+closeIdx]
+		name, arg, _ := strings.Cut(token, ":")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if err := validateTemplateVar(name, arg); err != nil {
+			return nil, err
+		}
+		t.parts = append(t.parts, templatePart{variable: name, arg: arg})
+		rest = rest[open+closeIdx+1:]
+	}
+
+	for _, segment := range strings.Split(filepath.ToSlash(raw), "/") {
+		if segment == ".." {
+			return nil, fmt.Errorf("isim şablonu üst klasöre çıkamaz: %s", raw)
+		}
+	}
+	return t, nil
+}
+
+func validateTemplateVar(name, arg string) error {
+	known := false
+	for _, v := range nameTemplateVars {
+		if v == name {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return fmt.Errorf("bilinmeyen şablon değişkeni: {%s} (geçerli: %s)", name, strings.Join(nameTemplateVars, ", "))
+	}
+	switch name {
+	case "date", "now":
+		return nil
+	case "index":
+		if arg != "" {
+			if _, err := strconv.Atoi(arg); err != nil {
+				return fmt.Errorf("{index:%s} genişlik sayı olmalı (ör: {index:03})", arg)
+			}
+		}
+	case "hash":
+		if arg != "" {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 || n > 64 {
+				return fmt.Errorf("{hash:%s} uzunluk 1-64 arası olmalı", arg)
+			}
+		}
+	default:
+		if arg != "" {
+			return fmt.Errorf("{%s} değişkeni parametre almaz", name)
+		}
+	}
+	return nil
+}
+
+// String şablonun ham halini döner.
+func (t *NameTemplate) String() string {
+	if t == nil {
+		return ""
+	}
+	return t.raw
+}
+
+// usesMedia şablonun boyut veya süre değişkeni içerip içermediğini döner.
+func (t *NameTemplate) usesMedia() bool {
+	for _, p := range t.parts {
+		if p.variable == "width" || p.variable == "height" || p.variable == "duration" {
+			return true
+		}
+	}
+	return false
+}
+
+// Render şablonu verilen veriyle göreli çıktı yoluna çevirir. Şablonda {ext} yoksa
+// hedef uzantı sona eklenir. Değişken değerlerindeki klasör ayırıcıları ve dosya
+// sistemlerinde geçersiz karakterler "_" ile değiştirilir.
+func (t *NameTemplate) Render(data TemplateData) (string, error) {
+	if data.TargetFormat == "" {
+		data.TargetFormat = strings.TrimPrefix(filepath.Ext(data.InputPath), ".")
+	}
+	if t.usesMedia() && (data.Width == 0 || data.Height == 0 || data.Duration == 0) {
+		if info, err := nameTemplateProbe(data.InputPath); err == nil {
+			if data.Width == 0 || data.Height == 0 {
+				data.Width, data.Height = info.Width, info.Height
+			}
+			if data.Duration == 0 {
+				data.Duration = info.DurationS
+			}
+		}
+	}
+
+	var b strings.Builder
+	hasExt := false
+	for _, p := range t.parts {
+		if p.variable == "" {
+			b.WriteString(p.literal)
+			continue
+		}
+		if p.variable == "ext" {
+			hasExt = true
+		}
+		value, err := templateValue(p, data)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(sanitizeTemplateValue(value))
+	}
+
+	rendered := filepath.ToSlash(b.String())
+	if !hasExt {
+		rendered += "." + data.TargetFormat
+	}
+
+	segments := strings.Split(rendered, "/")
+	clean := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		segment = strings.TrimSpace(segment)
+		if segment == "" || segment == "." {
+			continue
+		}
+		if segment == ".." {
+			return "", fmt.Errorf("isim şablonu üst klasöre çıkamaz: %s", t.raw)
+		}
+		clean = append(clean, segment)
+	}
+	if len(clean) == 0 || clean[len(clean)-1] == "."+data.TargetFormat {
+		return "", fmt.Errorf("isim şablonu boş dosya adı üretti: %s", t.raw)
+	}
+	return filepath.Join(clean...), nil
+}
+
+// OutputPath şablonu outputDir'e (boşsa girdinin klasörüne) göre tam çıktı yoluna çevirir.
+func (t *NameTemplate) OutputPath(outputDir string, data TemplateData) (string, error) {
+	rel, err := t.Render(data)
+	if err != nil {
+		return "", err
+	}
+	if outputDir == "" {
+		outputDir = filepath.Dir(data.InputPath)
+	}
+	return filepath.Join(outputDir, rel), nil
+}
+
+func templateValue(p templatePart, data TemplateData) (string, error) {
+	switch p.variable {
+	case "name":
+		return strings.TrimSuffix(filepath.Base(data.InputPath), filepath.Ext(data.InputPath)), nil
+	case "ext":
+		return data.TargetFormat, nil
+	case "from":
+		return strings.TrimPrefix(strings.ToLower(filepath.Ext(data.InputPath)), "."), nil
+	case "parent":
+		abs, err := filepath.Abs(data.InputPath)
+		if err != nil {
+			return "", err
+		}
+		return filepath.Base(filepath.Dir(abs)), nil
+	case "date":
+		info, err := os.Stat(data.InputPath)
+		if err != nil {
+			return "", fmt.Errorf("{date} için dosya okunamadı: %w", err)
+		}
+		return info.ModTime().Format(templateLayout(p.arg)), nil
+	case "now":
+		now := data.Now
+		if now.IsZero() {
+			now = time.Now()
+		}
+		return now.Format(templateLayout(p.arg)), nil
+	case "index":
+		index := max(data.Index, 1)
+		if p.arg == "" {
+			return strconv.Itoa(index), nil
+		}
+		width, _ := strconv.Atoi(p.arg)
+		return fmt.Sprintf("%0*d", width, index), nil
+	case "hash":
+		length := 8
+		if p.arg != "" {
+			length, _ = strconv.Atoi(p.arg)
+		}
+		sum, err := fileSHA256(data.InputPath)
+		if err != nil {
+			return "", fmt.Errorf("{hash} için dosya okunamadı: %w", err)
+		}
+		return sum[:length], nil
+	case "width":
+		return strconv.Itoa(data.Width), nil
+	case "height":
+		return strconv.Itoa(data.Height), nil
+	case "duration":
+		return strconv.Itoa(int(math.Round(data.Duration))), nil
+	case "quality":
+		return strconv.Itoa(data.Quality), nil
+	}
+	return "", fmt.Errorf("bilinmeyen şablon değişkeni: {%s}", p.variable)
+}
+
+func templateLayout(arg string) string {
+	if strings.TrimSpace(arg) == "" {
+		return "2006-01-02"
+	}
+	return arg
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// sanitizeTemplateValue değişken değerinden klasör ayırıcılarını ve geçersiz karakterleri temizler.
+func sanitizeTemplateValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			return '_'
+		case strings.ContainsRune(`/\<>:"|?*`, r):
+			return '_'
+		}
+		return r
+	}, value)
+}
@@ -0,0 +1,123 @@
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseNameTemplateErrors(t *testing.T) {
+	if tmpl, err := ParseNameTemplate("  "); err != nil || tmpl != nil {
+		t.Fatalf("empty template should be nil, got %v, %v", tmpl, err)
+	}
+	cases := []string{
+		"/abs/{name}",
+		"../{name}",
+		"{name",
+		"{unknown}",
+		"{index:abc}",
+		"{hash:0}",
+		"{hash:99}",
+		"{name:x}",
+	}
+	for _, raw := range cases {
+		if _, err := ParseNameTemplate(raw); err == nil {
+			t.Errorf("expected error for %q", raw)
+		}
+	}
+}
+
+func TestNameTemplateRender(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "album")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("mkdir failed: %v", err)
+	}
+	input := filepath.Join(dir, "photo.png")
+	if err := os.WriteFile(input, []byte("hello"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	mtime := time.Date(2024, 3, 15, 10, 0, 0, 0, time.Local)
+	if err := os.Chtimes(input, mtime, mtime); err != nil {
+		t.Fatalf("chtimes failed: %v", err)
+	}
+
+	cases := []struct {
+		raw  string
+		data TemplateData
+		want string
+	}{
+		{"{name}_{quality}.{ext}", TemplateData{InputPath: input, TargetFormat: "webp", Quality: 80}, "photo_80.webp"},
+		{"{date:2006-01-02}/{name}.{ext}", TemplateData{InputPath: input, TargetFormat: "jpg"}, filepath.Join("2024-03-15", "photo.jpg")},
+		{"{parent}-{from}-{index:03}", TemplateData{InputPath: input, TargetFormat: "jpg", Index: 7}, "album-png-007.jpg"},
+		{"{name}_{hash:8}.{ext}", TemplateData{InputPath: input, TargetFormat: "jpg"}, "photo_2cf24dba.jpg"},
+		{"{now:2006}/{name}", TemplateData{InputPath: input, TargetFormat: "jpg", Now: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}, filepath.Join("2030", "photo.jpg")},
+		{"./out//{name}_{width}x{height}.{ext}", TemplateData{InputPath: input, TargetFormat: "jpg", Width: 640, Height: 480}, filepath.Join("out", "photo_640x480.jpg")},
+	}
+	for _, tc := range cases {
+		tmpl, err := ParseNameTemplate(tc.raw)
+		if err != nil {
+			t.Fatalf("parse %q failed: %v", tc.raw, err)
+		}
+		got, err := tmpl.Render(tc.data)
+		if err != nil {
+			t.Fatalf("render %q failed: %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Errorf("render %q = %q, want %q", tc.raw, got, tc.want)
+		}
+	}
+}
+
+func TestNameTemplateSanitizesValues(t *testing.T) {
+	tmpl, err := ParseNameTemplate("{name}.{ext}")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	got, err := tmpl.Render(TemplateData{InputPath: filepath.Join("dir", `a:b|c?.png`), TargetFormat: "jpg"})
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if got != "a_b_c_.jpg" {
+		t.Fatalf("unexpected sanitized name: %q", got)
+	}
+
+	tmpl, err = ParseNameTemplate("{quality}/")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if _, err := tmpl.Render(TemplateData{InputPath: "a.png", TargetFormat: "jpg"}); err == nil || !strings.Contains(err.Error(), "boş") {
+		t.Fatalf("expected empty name error, got %v", err)
+	}
+}
+
+func TestNameTemplateProbesMediaInfo(t *testing.T) {
+	prev := nameTemplateProbe
+	defer func() { nameTemplateProbe = prev }()
+	probed := 0
+	nameTemplateProbe = func(path string) (FileInfo, error) {
+		probed++
+		return FileInfo{Width: 1920, Height: 1080, DurationS: 61.6}, nil
+	}
+
+	tmpl, err := ParseNameTemplate("{name}_{width}x{height}_{duration}s")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	path, err := tmpl.OutputPath("out", TemplateData{InputPath: filepath.Join("in", "clip.mov"), TargetFormat: "mp4"})
+	if err != nil {
+		t.Fatalf("output path failed: %v", err)
+	}
+	if path != filepath.Join("out", "clip_1920x1080_62s.mp4") || probed != 1 {
+		t.Fatalf("unexpected path %q (probed %d)", path, probed)
+	}
+
+	path, err = tmpl.OutputPath("", TemplateData{InputPath: filepath.Join("in", "clip.mov"), TargetFormat: "mp4", Width: 1280, Height: 720, Duration: 5})
+	if err != nil {
+		t.Fatalf("output path failed: %v", err)
+	}
+	if path != filepath.Join("in", "clip_1280x720_5s.mp4") || probed != 1 {
+		t.Fatalf("unexpected path %q (probed %d)", path, probed)
+	}
+}
@@ -65,3 +65,46 @@ func ResolveOutputPathConflict(path, policy string) (resolvedPath string, skip b
 		return "", false, fmt.Errorf("gecersiz on-conflict politikasi: %s", policy)
 	}
 }
+
+// TempOutputPath çıktının önce yazılacağı geçici yolu döner. Dosya aynı klasörde,
+// gizli ve aynı uzantıyla oluşturulur; böylece taşıma atomik olur ve formatı
+// uzantıdan okuyan araçlar etkilenmez.
+func TempOutputPath(path string) string {
+	dir, base := filepath.Split(path)
+	ext := filepath.Ext(base)
+	return filepath.Join(dir, "."+strings.TrimSuffix(base, ext)+".partial"+ext)
+}
+
+// CommitTempOutput geçici çıktıyı hedef yola taşır.
+func CommitTempOutput(tmp, path string) error {
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("çıktı yerine taşınamadı: %w", err)
+	}
+	return nil
+}
+
+// ConvertAtomic dönüşümü geçici dosyaya yapar ve başarılıysa hedefe taşır. İşlem
+// yarıda kesilirse hedef yolda yarım yazılmış dosya kalmaz.
+func ConvertAtomic(conv Converter, input, output string, opts Options) error {
+	return ConvertAtomicVerified(conv, input, output, opts, nil)
+}
+
+// ConvertAtomicVerified ConvertAtomic gibi çalışır; check nil değilse geçici çıktı
+// hedefe taşınmadan önce doğrulanır ve doğrulanamayan çıktı silinir.
+func ConvertAtomicVerified(conv Converter, input, output string, opts Options, check func(tmp string) error) error {
+	tmp := TempOutputPath(output)
+	// Önceki kesintiden kalan geçici dosya üzerine yazılır.
+	os.Remove(tmp)
+	if err := conv.Convert(input, tmp, opts); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if check != nil {
+		if err := check(tmp); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+	}
+	return CommitTempOutput(tmp, output)
+}
@@ -86,3 +86,53 @@ func TestResolveOutputPathConflictInvalidPolicy(t *testing.T) {
 		t.Fatalf("expected error for invalid policy")
 	}
 }
+
+type writeConverter struct {
+	seen string
+	err  error
+}
+
+func (w *writeConverter) Convert(input, output string, opts Options) error {
+	w.seen = output
+	if err := os.WriteFile(output, []byte("partial"), 0644); err != nil {
+		return err
+	}
+	return w.err
+}
+
+func (w *writeConverter) SupportsConversion(from, to string) bool { return true }
+func (w *writeConverter) Name() string                            { return "write" }
+func (w *writeConverter) SupportedConversions() []ConversionPair  { return nil }
+
+func TestTempOutputPath(t *testing.T) {
+	got := TempOutputPath(filepath.Join("out", "video.mp4"))
+	if want := filepath.Join("out", ".video.partial.mp4"); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestConvertAtomic(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "out.txt")
+
+	conv := &writeConverter{}
+	if err := ConvertAtomic(conv, "in.md", output, Options{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if conv.seen != TempOutputPath(output) {
+		t.Fatalf("converter should write to temp path, wrote %s", conv.seen)
+	}
+	if data, err := os.ReadFile(output); err != nil || string(data) != "partial" {
+		t.Fatalf("output not committed: %q %v", data, err)
+	}
+
+	failed := filepath.Join(dir, "failed.txt")
+	if err := ConvertAtomic(&writeConverter{err: os.ErrInvalid}, "in.md", failed, Options{}); err == nil {
+		t.Fatalf("expected error")
+	}
+	for _, p := range []string{failed, TempOutputPath(failed)} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be absent after failure", p)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package converter
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Alt süreç öncelik seviyeleri.
+const (
+	PriorityNormal = "normal"
+	PriorityLow    = "low"
+	PriorityIdle   = "idle"
+)
+
+var (
+	priorityMu     sync.RWMutex
+	priorityPrefix []string
+)
+
+// priorityLookPath testlerde değiştirilebilir.
+var priorityLookPath = exec.LookPath
+
+// NormalizePriority öncelik değerini normalize eder; geçersizse boş döner.
+func NormalizePriority(level string) string {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "", PriorityNormal:
+		return PriorityNormal
+	case PriorityLow:
+		return PriorityLow
+	case PriorityIdle:
+		return PriorityIdle
+	default:
+		return ""
+	}
+}
+
+// SetChildPriority FFmpeg, LibreOffice ve Pandoc gibi ağır alt süreçlerin CPU ve
+// disk önceliğini ayarlar. Unix sistemlerde komutlar nice (Linux'ta ek olarak ionice)
+// ile başlatılır; bu araçların bulunmadığı sistemlerde ayar yok sayılır.
+func SetChildPriority(level string) error {
+	normalized := NormalizePriority(level)
+	if normalized == "" {
+		return fmt.Errorf("gecersiz oncelik: %s (normal, low, idle)", level)
+	}
+	prefix := buildPriorityPrefix(normalized)
+	priorityMu.Lock()
+	priorityPrefix = prefix
+	priorityMu.Unlock()
+	return nil
+}
+
+func buildPriorityPrefix(level string) []string {
+	if level == PriorityNormal || runtime.GOOS == "windows" {
+		return nil
+	}
+	var prefix []string
+	if runtime.GOOS == "linux" {
+		if ionice, err := priorityLookPath("ionice"); err == nil {
+			if level == PriorityIdle {
+				prefix = append(prefix, ionice, "-c", "3")
+			} else {
+				prefix = append(prefix, ionice, "-c", "2", "-n", "7")
+			}
+		}
+	}
+	if nice, err := priorityLookPath("nice"); err == nil {
+		n := "10"
+		if level == PriorityIdle {
+			n = "19"
+		}
+		prefix = append(prefix, nice, "-n", n)
+	}
+	return prefix
+}
+
+// Command ağır bir harici aracı ayarlanmış alt süreç önceliğiyle çalıştıracak komutu döner.
+func Command(name string, args ...string) *exec.Cmd {
+	priorityMu.RLock()
+	prefix := priorityPrefix
+	priorityMu.RUnlock()
+	if len(prefix) == 0 {
+		return exec.Command(name, args...)
+	}
+	full := make([]string, 0, len(prefix)+len(args))
+	full = append(full, prefix[1:]...)
+	full = append(full, name)
+	full = append(full, args...)
+	return exec.Command(prefix[0], full...)
+}
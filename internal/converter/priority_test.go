@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestNormalizePriority(t *testing.T) {
+	if got := NormalizePriority(""); got != PriorityNormal {
+		t.Fatalf("expected default normal, got %s", got)
+	}
+	if got := NormalizePriority("IDLE"); got != PriorityIdle {
+		t.Fatalf("expected idle, got %s", got)
+	}
+	if got := NormalizePriority("realtime"); got != "" {
+		t.Fatalf("expected empty for invalid priority, got %s", got)
+	}
+}
+
+func TestCommandPriorityPrefix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("nice/ionice unix sistemlerde kullanılır")
+	}
+	orig := priorityLookPath
+	priorityLookPath = func(name string) (string, error) { return "/usr/bin/" + name, nil }
+	defer func() {
+		priorityLookPath = orig
+		SetChildPriority(PriorityNormal)
+	}()
+
+	if err := SetChildPriority(PriorityIdle); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cmd := Command("/opt/ffmpeg", "-i", "in.mp4")
+	args := cmd.Args
+	if args[len(args)-3] != "/opt/ffmpeg" || args[len(args)-1] != "in.mp4" {
+		t.Fatalf("tool should follow priority prefix: %v", args)
+	}
+	foundNice := false
+	for i, a := range args {
+		if a == "/usr/bin/nice" && args[i+2] == "19" {
+			foundNice = true
+		}
+	}
+	if !foundNice {
+		t.Fatalf("expected nice -n 19 prefix: %v", args)
+	}
+	if runtime.GOOS == "linux" && (cmd.Path != "/usr/bin/ionice" || args[2] != "3") {
+		t.Fatalf("expected ionice idle class on linux: %v", args)
+	}
+
+	if err := SetChildPriority(PriorityNormal); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cmd := Command("/opt/ffmpeg", "-version"); len(cmd.Args) != 2 {
+		t.Fatalf("normal priority should not wrap command: %v", cmd.Args)
+	}
+	if err := SetChildPriority("high"); err == nil {
+		t.Fatalf("expected error for invalid priority")
+	}
+}
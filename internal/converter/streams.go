@@ -0,0 +1,269 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// StreamInfo medya dosyasındaki tek bir akışın (video, ses, altyazı, ek) ayrıntıları.
+type StreamInfo struct {
+	Index          int               `json:"index"`      // dosyadaki mutlak akış sırası
+	Type           string            `json:"type"`       // video, audio, subtitle, attachment, data
+	TypeIndex      int               `json:"type_index"` // aynı türdeki akışlar arasındaki sıra (ffmpeg a:N)
+	Codec          string            `json:"codec,omitempty"`
+	Profile        string            `json:"profile,omitempty"`
+	Level          int               `json:"level,omitempty"`
+	Language       string            `json:"language,omitempty"`
+	Title          string            `json:"title,omitempty"`
+	BitRate        int64             `json:"bit_rate,omitempty"`
+	Width          int               `json:"width,omitempty"`
+	Height         int               `json:"height,omitempty"`
+	PixFmt         string            `json:"pix_fmt,omitempty"`
+	FPS            float64           `json:"fps,omitempty"`
+	SampleRate     int               `json:"sample_rate,omitempty"`
+	Channels       int               `json:"channels,omitempty"`
+	ChannelLayout  string            `json:"channel_layout,omitempty"`
+	ColorSpace     string            `json:"color_space,omitempty"`
+	ColorPrimaries string            `json:"color_primaries,omitempty"`
+	ColorTransfer  string            `json:"color_transfer,omitempty"`
+	ColorRange     string            `json:"color_range,omitempty"`
+	HDR            string            `json:"hdr,omitempty"`
+	Rotation       int               `json:"rotation,omitempty"`
+	SideData       []string          `json:"side_data,omitempty"`
+	Default        bool              `json:"default"`
+	Forced         bool              `json:"forced"`
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+// MediaDetails kapsayıcı, akış ve bölüm bilgilerinin tamamı.
+type MediaDetails struct {
+	Container string            `json:"container,omitempty"`
+	Duration  float64           `json:"duration_seconds,omitempty"`
+	BitRate   int64             `json:"bit_rate,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Streams   []StreamInfo      `json:"streams"`
+	Chapters  []Chapter         `json:"chapters"`
+}
+
+// ffprobeMedia ffprobe -show_format -show_streams -show_chapters JSON çıktısının ilgili alanları
+type ffprobeMedia struct {
+	Format struct {
+		FormatName string            `json:"format_name"`
+		Duration   string            `json:"duration"`
+		BitRate    string            `json:"bit_rate"`
+		Tags       map[string]string `json:"tags"`
+	} `json:"format"`
+	Streams []struct {
+		Index          int                      `json:"index"`
+		CodecType      string                   `json:"codec_type"`
+		CodecName      string                   `json:"codec_name"`
+		Profile        string                   `json:"profile"`
+		Level          int                      `json:"level"`
+		BitRate        string                   `json:"bit_rate"`
+		Width          int                      `json:"width"`
+		Height         int                      `json:"height"`
+		PixFmt         string                   `json:"pix_fmt"`
+		AvgFrameRate   string                   `json:"avg_frame_rate"`
+		RFrameRate     string                   `json:"r_frame_rate"`
+		SampleRate     string                   `json:"sample_rate"`
+		Channels       int                      `json:"channels"`
+		ChannelLayout  string                   `json:"channel_layout"`
+		ColorSpace     string                   `json:"color_space"`
+		ColorPrimaries string                   `json:"color_primaries"`
+		ColorTransfer  string                   `json:"color_transfer"`
+		ColorRange     string                   `json:"color_range"`
+		Tags           map[string]string        `json:"tags"`
+		Disposition    map[string]int           `json:"disposition"`
+		SideDataList   []map[string]interface{} `json:"side_data_list"`
+	} `json:"streams"`
+	Chapters []struct {
+		StartTime string            `json:"start_time"`
+		EndTime   string            `json:"end_time"`
+		Tags      map[string]string `json:"tags"`
+	} `json:"chapters"`
+}
+
+// ProbeMediaDetails FFprobe ile kapsayıcı etiketlerini, tüm akışları ve bölümleri okur.
+func ProbeMediaDetails(path string) (MediaDetails, error) {
+	output, err := runFFprobeJSON(path, "-show_format", "-show_streams", "-show_chapters")
+	if err != nil {
+		return MediaDetails{}, err
+	}
+	return ParseMediaDetails(output)
+}
+
+// ProbeStreams FFprobe ile dosyadaki akışları okur.
+func ProbeStreams(path string) ([]StreamInfo, error) {
+	output, err := runFFprobeJSON(path, "-show_streams")
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreams(output)
+}
+
+// ParseStreams ffprobe -show_streams JSON çıktısını akış listesine çevirir.
+func ParseStreams(data []byte) ([]StreamInfo, error) {
+	details, err := ParseMediaDetails(data)
+	if err != nil {
+		return nil, err
+	}
+	return details.Streams, nil
+}
+
+// ParseMediaDetails ffprobe JSON çıktısını ayrıştırır. Etiket anahtarları küçük harfe
+// çevrilir, böylece kapsayıcıdan bağımsız olarak JSON çıktısı kararlı kalır.
+func ParseMediaDetails(data []byte) (MediaDetails, error) {
+	var result ffprobeMedia
+	if err := json.Unmarshal(data, &result); err != nil {
+		return MediaDetails{}, fmt.Errorf("ffprobe çıktısı çözümlenemedi: %w", err)
+	}
+
+	details := MediaDetails{
+		Container: result.Format.FormatName,
+		Tags:      normalizeTags(result.Format.Tags),
+		Streams:   make([]StreamInfo, 0, len(result.Streams)),
+		Chapters:  make([]Chapter, 0, len(result.Chapters)),
+	}
+	if d, err := strconv.ParseFloat(result.Format.Duration, 64); err == nil {
+		details.Duration = d
+	}
+	if br, err := strconv.ParseInt(result.Format.BitRate, 10, 64); err == nil {
+		details.BitRate = br
+	}
+
+	counts := map[string]int{}
+	for _, s := range result.Streams {
+		tags := normalizeTags(s.Tags)
+		info := StreamInfo{
+			Index:          s.Index,
+			Type:           s.CodecType,
+			TypeIndex:      counts[s.CodecType],
+			Codec:          s.CodecName,
+			Profile:        s.Profile,
+			Width:          s.Width,
+			Height:         s.Height,
+			PixFmt:         s.PixFmt,
+			Channels:       s.Channels,
+			ChannelLayout:  s.ChannelLayout,
+			ColorSpace:     s.ColorSpace,
+			ColorPrimaries: s.ColorPrimaries,
+			ColorTransfer:  s.ColorTransfer,
+			ColorRange:     s.ColorRange,
+			Default:        s.Disposition["default"] == 1,
+			Forced:         s.Disposition["forced"] == 1,
+			Language:       strings.ToLower(tags["language"]),
+			Title:          tags["title"],
+			Tags:           tags,
+		}
+		counts[s.CodecType]++
+		if s.Level > 0 {
+			info.Level = s.Level
+		}
+		if br, err := strconv.ParseInt(s.BitRate, 10, 64); err == nil {
+			info.BitRate = br
+		} else if br, err := strconv.ParseInt(tags["bps"], 10, 64); err == nil {
+			// MKV akış bitrate'ini sadece BPS etiketinde taşır.
+			info.BitRate = br
+		}
+		if sr, err := strconv.Atoi(s.SampleRate); err == nil {
+			info.SampleRate = sr
+		}
+		if s.CodecType == "video" {
+			rate := s.AvgFrameRate
+			if parseFrameRate(rate) <= 0 {
+				rate = s.RFrameRate
+			}
+			info.FPS = math.Round(parseFrameRate(rate)*1000) / 1000
+		}
+		for _, sd := range s.SideDataList {
+			if name, ok := sd["side_data_type"].(string); ok && name != "" {
+				info.SideData = append(info.SideData, name)
+			}
+			if rot, ok := sd["rotation"].(float64); ok && rot != 0 {
+				info.Rotation = int(rot)
+			}
+		}
+		if info.Rotation == 0 {
+			if rot, err := strconv.Atoi(tags["rotate"]); err == nil {
+				info.Rotation = rot
+			}
+		}
+		info.HDR = detectHDR(info.ColorTransfer, info.SideData)
+		details.Streams = append(details.Streams, info)
+	}
+
+	for _, c := range result.Chapters {
+		start, err := strconv.ParseFloat(strings.TrimSpace(c.StartTime), 64)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.ParseFloat(strings.TrimSpace(c.EndTime), 64)
+		if err != nil || end <= start {
+			continue
+		}
+		title := normalizeTags(c.Tags)["title"]
+		details.Chapters = append(details.Chapters, Chapter{Index: len(details.Chapters) + 1, Start: start, End: end, Title: title})
+	}
+	return details, nil
+}
+
+// StreamsOfType verilen türdeki akışları sırasıyla döner.
+func StreamsOfType(streams []StreamInfo, streamType string) []StreamInfo {
+	var filtered []StreamInfo
+	for _, s := range streams {
+		if s.Type == streamType {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// detectHDR transfer karakteristiği ve yan verilerden HDR türünü çıkarır.
+func detectHDR(transfer string, sideData []string) string {
+	for _, sd := range sideData {
+		if strings.Contains(strings.ToLower(sd), "dovi") || strings.Contains(strings.ToLower(sd), "dolby vision") {
+			return "Dolby Vision"
+		}
+	}
+	switch transfer {
+	case "smpte2084":
+		for _, sd := range sideData {
+			if strings.Contains(sd, "2094-40") || strings.Contains(strings.ToLower(sd), "hdr10+") {
+				return "HDR10+"
+			}
+		}
+		return "HDR10"
+	case "arib-std-b67":
+		return "HLG"
+	}
+	return ""
+}
+
+func normalizeTags(tags map[string]string) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	normalized := make(map[string]string, len(tags))
+	for k, v := range tags {
+		normalized[strings.ToLower(strings.TrimSpace(k))] = strings.TrimSpace(v)
+	}
+	return normalized
+}
+
+func runFFprobeJSON(path string, sections ...string) ([]byte, error) {
+	ffprobePath := findFFprobe()
+	if ffprobePath == "" {
+		return nil, fmt.Errorf("medya bilgisi için ffprobe gerekli")
+	}
+	args := append([]string{"-v", "quiet", "-print_format", "json"}, sections...)
+	args = append(args, path)
+	output, err := exec.Command(ffprobePath, args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("ffprobe çalıştırılamadı: %w", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,97 @@
+package converter
+
+import "testing"
+
+func TestParseStreams(t *testing.T) {
+	data := []byte(`{"streams":[
+		{"index":0,"codec_type":"video","codec_name":"h264","disposition":{"default":1}},
+		{"index":1,"codec_type":"audio","codec_name":"aac","channels":2,"tags":{"language":"TUR","title":"Türkçe"},"disposition":{"default":1}},
+		{"index":2,"codec_type":"audio","codec_name":"ac3","channels":6,"tags":{"LANGUAGE":"eng"},"disposition":{"default":0}},
+		{"index":3,"codec_type":"subtitle","codec_name":"subrip","tags":{"language":"tur"}}
+	]}`)
+	streams, err := ParseStreams(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(streams) != 4 {
+		t.Fatalf("expected 4 streams, got %d", len(streams))
+	}
+	audio := StreamsOfType(streams, "audio")
+	if len(audio) != 2 {
+		t.Fatalf("expected 2 audio streams, got %+v", audio)
+	}
+	if audio[0].TypeIndex != 0 || audio[0].Language != "tur" || audio[0].Title != "Türkçe" || !audio[0].Default {
+		t.Fatalf("unexpected first audio stream: %+v", audio[0])
+	}
+	if audio[1].TypeIndex != 1 || audio[1].Index != 2 || audio[1].Language != "eng" || audio[1].Channels != 6 || audio[1].Default {
+		t.Fatalf("unexpected second audio stream: %+v", audio[1])
+	}
+	if streams[3].TypeIndex != 0 || streams[3].Type != "subtitle" {
+		t.Fatalf("unexpected subtitle stream: %+v", streams[3])
+	}
+	if _, err := ParseStreams([]byte("{")); err == nil {
+		t.Fatalf("expected error for invalid json")
+	}
+}
+
+func TestParseMediaDetails(t *testing.T) {
+	data := []byte(`{
+		"format":{"format_name":"matroska,webm","duration":"125.500000","bit_rate":"8000000","tags":{"TITLE":"Film","ENCODER":"libebml"}},
+		"streams":[
+			{"index":0,"codec_type":"video","codec_name":"hevc","profile":"Main 10","level":153,"width":3840,"height":2160,"pix_fmt":"yuv420p10le",
+			 "avg_frame_rate":"24000/1001","color_primaries":"bt2020","color_transfer":"smpte2084","color_range":"tv",
+			 "side_data_list":[{"side_data_type":"Display Matrix","rotation":-90},{"side_data_type":"Mastering display metadata"}],
+			 "tags":{"BPS":"7000000"},"disposition":{"default":1,"forced":0}},
+			{"index":1,"codec_type":"audio","codec_name":"eac3","profile":"-","level":-99,"bit_rate":"640000","sample_rate":"48000","channels":6,"channel_layout":"5.1(side)","tags":{"language":"eng"}},
+			{"index":2,"codec_type":"subtitle","codec_name":"subrip","tags":{"language":"tur"},"disposition":{"forced":1}},
+			{"index":3,"codec_type":"attachment","codec_name":"ttf","tags":{"filename":"font.ttf","mimetype":"font/ttf"}}
+		],
+		"chapters":[{"start_time":"0.0","end_time":"60.0","tags":{"title":"Açılış"}}]
+	}`)
+	details, err := ParseMediaDetails(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if details.Container != "matroska,webm" || details.Duration != 125.5 || details.BitRate != 8000000 {
+		t.Fatalf("unexpected format details: %+v", details)
+	}
+	if details.Tags["title"] != "Film" || details.Tags["encoder"] != "libebml" {
+		t.Fatalf("container tags should be lower-cased: %+v", details.Tags)
+	}
+	video := details.Streams[0]
+	if video.HDR != "HDR10" || video.Rotation != -90 || video.BitRate != 7000000 || video.Level != 153 {
+		t.Fatalf("unexpected video stream: %+v", video)
+	}
+	if video.FPS != 23.976 || len(video.SideData) != 2 || !video.Default {
+		t.Fatalf("unexpected video stream: %+v", video)
+	}
+	audio := details.Streams[1]
+	if audio.Level != 0 || audio.BitRate != 640000 || audio.SampleRate != 48000 || audio.ChannelLayout != "5.1(side)" {
+		t.Fatalf("unexpected audio stream: %+v", audio)
+	}
+	if !details.Streams[2].Forced || details.Streams[3].Tags["filename"] != "font.ttf" {
+		t.Fatalf("unexpected subtitle/attachment streams: %+v", details.Streams[2:])
+	}
+	if len(details.Chapters) != 1 || details.Chapters[0].Title != "Açılış" {
+		t.Fatalf("unexpected chapters: %+v", details.Chapters)
+	}
+}
+
+func TestDetectHDR(t *testing.T) {
+	cases := []struct {
+		transfer string
+		sideData []string
+		want     string
+	}{
+		{"smpte2084", nil, "HDR10"},
+		{"smpte2084", []string{"HDR Dynamic Metadata SMPTE2094-40 (HDR10+)"}, "HDR10+"},
+		{"arib-std-b67", nil, "HLG"},
+		{"bt709", []string{"DOVI configuration record"}, "Dolby Vision"},
+		{"bt709", nil, ""},
+	}
+	for _, tc := range cases {
+		if got := detectHDR(tc.transfer, tc.sideData); got != tc.want {
+			t.Fatalf("detectHDR(%q, %v) = %q, want %q", tc.transfer, tc.sideData, got, tc.want)
+		}
+	}
+}
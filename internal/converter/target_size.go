@@ -0,0 +1,390 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// targetSizeSafety konteyner yükü ve encoder sapması için bırakılan pay.
+	targetSizeSafety = 0.96
+	// targetSizeMaxAttempts hedefi aşan çıktılar için toplam deneme sayısı.
+	targetSizeMaxAttempts  = 3
+	minTargetVideoKbps     = 50
+	minTargetAudioKbps     = 8
+	defaultTargetAudioKbps = 128
+)
+
+// mp3CBRBitrates LAME'in CBR modunda kabul ettiği bitrate değerleri.
+var mp3CBRBitrates = []int{8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+
+// twoPassEncoders -pass/-passlogfile ile iki geçişli kodlamayı destekleyen encoder'lar.
+var twoPassEncoders = []string{"libx264", "libvpx-vp9", "libaom-av1", "mpeg4", "wmv2", "flv"}
+
+// ParseSize "500kb", "2mb", "1.5gb" gibi insan okunabilir boyutları byte'a çevirir.
+func ParseSize(s string) (int64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return 0, fmt.Errorf("boyut değeri boş")
+	}
+
+	// Uzun son ekler önce denenir; aksi halde "2mb" "b" ile eşleşir.
+	suffixes := []struct {
+		suffix string
+		mult   int64
+	}{
+		{"gb", 1024 * 1024 * 1024},
+		{"mb", 1024 * 1024},
+		{"kb", 1024},
+		{"b", 1},
+	}
+
+	numStr := s
+	mult := int64(1)
+	for _, item := range suffixes {
+		if strings.HasSuffix(s, item.suffix) {
+			numStr = strings.TrimSpace(strings.TrimSuffix(s, item.suffix))
+			mult = item.mult
+			break
+		}
+	}
+
+	val, err := strconv.ParseFloat(numStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("geçersiz boyut değeri: %s", s)
+	}
+	if val <= 0 {
+		return 0, fmt.Errorf("boyut sıfırdan büyük olmalı")
+	}
+	return int64(val * float64(mult)), nil
+}
+
+// ValidateTargetSizeOptions hedef boyut modunun diğer encode ayarlarıyla çakışmadığını kontrol eder.
+func ValidateTargetSizeOptions(targetFormat string, targetSize int64, audio *AudioSpec, video *VideoSpec) error {
+	if targetSize <= 0 {
+		return nil
+	}
+	to := NormalizeFormat(targetFormat)
+
+	switch {
+	case IsVideoFormat(to):
+		if video.IsZero() {
+			return nil
+		}
+		if video.Codec == VideoCodecCopy || video.AudioCodec == "copy" {
+			return fmt.Errorf("--target-size codec copy ile kullanılamaz")
+		}
+		if video.CRF != nil || video.Bitrate != "" {
+			return fmt.Errorf("--target-size ile --crf veya --bitrate birlikte kullanılamaz")
+		}
+	case IsAudioFormat(to):
+		if !slices.Contains(lossyAudioBitrateFormats, to) {
+			return fmt.Errorf("%s kayıpsız bir formattır, --target-size kullanılamaz", to)
+		}
+		if audio.IsZero() {
+			return nil
+		}
+		if audio.Codec == "copy" || isLosslessAudioCodec(audio.Codec) {
+			return fmt.Errorf("--target-size kayıpsız codec veya copy ile kullanılamaz")
+		}
+		if audio.Bitrate != "" || audio.BitrateMode == AudioBitrateModeVBR {
+			return fmt.Errorf("--target-size ile --bitrate veya --vbr birlikte kullanılamaz")
+		}
+	}
+	return nil
+}
+
+// PlanTargetBitrates hedef boyut ve süreden video/ses bitrate bütçesini (kbps) hesaplar.
+// audioKbps 0 ise çıktıda ses izi olmadığı kabul edilir.
+func PlanTargetBitrates(targetBytes int64, durationSec float64, audioKbps int) (videoKbps int, plannedAudioKbps int, err error) {
+	if targetBytes <= 0 || durationSec <= 0 {
+		return 0, 0, fmt.Errorf("hedef boyut ve süre sıfırdan büyük olmalı")
+	}
+	totalKbps := int(float64(targetBytes) * 8 / 1000 / durationSec * targetSizeSafety)
+
+	if audioKbps > 0 {
+		// Ses bütçesi toplamın yarısını geçmesin; gerekirse kısılır.
+		for audioKbps > 32 && totalKbps-audioKbps < max(minTargetVideoKbps, totalKbps/2) {
+			audioKbps = audioKbps * 3 / 4
+		}
+		audioKbps = max(audioKbps, 32)
+	}
+	videoKbps = totalKbps - audioKbps
+	if videoKbps < minTargetVideoKbps {
+		return 0, 0, fmt.Errorf("hedef boyut %.1fs süre için çok küçük (en az ~%s gerekir)", durationSec, formatInfoSize(minimumTargetBytes(durationSec, audioKbps)))
+	}
+	return videoKbps, audioKbps, nil
+}
+
+func minimumTargetBytes(durationSec float64, audioKbps int) int64 {
+	return int64(float64(minTargetVideoKbps+audioKbps) * 1000 / 8 * durationSec / targetSizeSafety)
+}
+
+// fitToTargetSize encode fonksiyonunu verilen bitrate ile geçici bir dosyaya çalıştırır;
+// çıktı hedefi aşarsa bitrate'i ölçülen sapmaya göre düşürüp yeniden dener. Çıktı yalnızca
+// hedefe sığdığında output'a taşınır; başarısız denemelerde output'taki dosyaya dokunulmaz.
+func fitToTargetSize(output string, targetBytes int64, kbps int, minKbps int, encode func(kbps int, path string) error) error {
+	tmp := TempOutputPath(output)
+	os.Remove(tmp)
+	for attempt := 1; attempt <= targetSizeMaxAttempts; attempt++ {
+		if err := encode(kbps, tmp); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+		info, err := os.Stat(tmp)
+		if err != nil {
+			os.Remove(tmp)
+			return err
+		}
+		size := info.Size()
+		if size <= targetBytes {
+			return CommitTempOutput(tmp, output)
+		}
+		if attempt == targetSizeMaxAttempts {
+			os.Remove(tmp)
+			return fmt.Errorf("hedef boyuta ulaşılamadı: %s > %s (%d deneme)", formatInfoSize(size), formatInfoSize(targetBytes), attempt)
+		}
+		corrected := int(float64(kbps) * float64(targetBytes) / float64(size) * 0.97)
+		if corrected >= kbps {
+			corrected = kbps - 1
+		}
+		if corrected < minKbps {
+			os.Remove(tmp)
+			return fmt.Errorf("hedef boyuta ulaşılamadı: gereken bitrate çok düşük (%dk)", corrected)
+		}
+		kbps = corrected
+	}
+	return nil
+}
+
+// probeMediaDuration ffprobe ile medya süresini saniye olarak döner.
+func probeMediaDuration(path string) (float64, error) {
+	ffprobePath := findFFprobe()
+	if ffprobePath == "" {
+		return 0, fmt.Errorf("hedef boyut hesabı için ffprobe gerekli")
+	}
+	out, err := exec.Command(ffprobePath,
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		path,
+	).Output()
+	if err != nil {
+		return 0, fmt.Errorf("medya süresi okunamadı: %w", err)
+	}
+	sec, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil || sec <= 0 {
+		return 0, fmt.Errorf("medya süresi okunamadı: %s", path)
+	}
+	return sec, nil
+}
+
+// probeHasAudioStream dosyada en az bir ses izi olup olmadığını döner.
+func probeHasAudioStream(path string) bool {
+	ffprobePath := findFFprobe()
+	if ffprobePath == "" {
+		return true
+	}
+	out, err := exec.Command(ffprobePath,
+		"-v", "error",
+		"-select_streams", "a",
+		"-show_entries", "stream=index",
+		"-of", "json",
+		path,
+	).Output()
+	if err != nil {
+		return true
+	}
+	var result struct {
+		Streams []struct{} `json:"streams"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return true
+	}
+	return len(result.Streams) > 0
+}
+
+// convertVideoToTargetSize videoyu iki geçişli kodlama ile hedef boyutun altına sığdırır.
+func (v *VideoConverter) convertVideoToTargetSize(ffmpegPath string, input string, output string, to string, filterArgs []string, videoMap string, opts Options) error {
+	duration, err := probeMediaDuration(input)
+	if err != nil {
+		return err
+	}
+
+	spec := VideoSpec{}
+	if opts.Video != nil {
+		spec = *opts.Video
+	}
+	audioKbps := 0
+	if spec.AudioCodec != "none" && probeHasAudioStream(input) {
+		audioKbps = defaultTargetAudioKbps
+		if spec.AudioBitrate != "" {
+			if kbps, err := parseBitrateKbps(spec.AudioBitrate); err == nil {
+				audioKbps = kbps
+			}
+		}
+	}
+
+	videoKbps, audioKbps, err := PlanTargetBitrates(opts.TargetSize, duration, audioKbps)
+	if err != nil {
+		return err
+	}
+	if audioKbps > 0 {
+		spec.AudioBitrate = fmt.Sprintf("%dk", audioKbps)
+	}
+
+	tempDir, err := os.MkdirTemp("", "fileconverter-2pass-*")
+	if err != nil {
+		return fmt.Errorf("geçici klasör oluşturulamadı: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	passLog := filepath.Join(tempDir, "pass")
+
+	encode := func(kbps int, path string) error {
+		videoArgs, encoder := targetSizeVideoCodecArgs(to, &spec, kbps)
+		base := []string{}
+		if !opts.Verbose {
+			base = append(base, "-loglevel", "error")
+		}
+		base = append(base, "-i", input, "-y")
+		base = append(base, filterArgs...)
+
+		if passArgs := twoPassArgs(encoder, passLog, 1); passArgs != nil {
+			pass1 := append(slices.Clone(base), "-map", videoMap)
+			pass1 = append(pass1, videoArgs...)
+			pass1 = append(pass1, passArgs...)
+			pass1 = append(pass1, "-an", "-f", "null", os.DevNull)
+			if out, err := Command(ffmpegPath, pass1...).CombinedOutput(); err != nil {
+				return fmt.Errorf("FFmpeg 1. geçiş hatası: %s\n%s", err.Error(), string(out))
+			}
+		}
+
+		pass2 := append(slices.Clone(base), "-map", videoMap, "-map", "0:a?")
+		pass2 = append(pass2, videoArgs...)
+		pass2 = append(pass2, twoPassArgs(encoder, passLog, 2)...)
+		if to == "mp4" || to == "m4v" || to == "mov" {
+			pass2 = append(pass2, "-movflags", "+faststart")
+		}
+		pass2 = append(pass2, videoTrackAudioArgs(to, &spec)...)
+		pass2 = append(pass2, MetadataFFmpegArgs(opts.MetadataMode)...)
+		pass2 = append(pass2, path)
+		if out, err := Command(ffmpegPath, pass2...).CombinedOutput(); err != nil {
+			return fmt.Errorf("FFmpeg hatası: %s\n%s", err.Error(), string(out))
+		}
+		return nil
+	}
+
+	return fitToTargetSize(output, opts.TargetSize, videoKbps, minTargetVideoKbps, encode)
+}
+
+// targetSizeVideoCodecArgs belirli bir video bitrate'i için codec argümanlarını ve encoder adını döner.
+func targetSizeVideoCodecArgs(to string, spec *VideoSpec, kbps int) ([]string, string) {
+	bitrate := fmt.Sprintf("%dk", kbps)
+	codec := spec.Codec
+	if codec == "" {
+		codec = defaultVideoCodec(to)
+	}
+	if _, ok := videoCodecEncoders[codec]; ok {
+		s := *spec
+		s.Codec = codec
+		s.CRF = nil
+		s.Bitrate = bitrate
+		encoder := s.Encoder
+		if encoder == "" {
+			encoder = videoCodecEncoders[codec][0]
+		}
+		return videoCodecArgs(codec, 0, &s), encoder
+	}
+
+	// Codec seçimi olmayan eski kapsayıcılar (avi, wmv, flv)
+	encoder := "mpeg4"
+	switch to {
+	case "wmv":
+		encoder = "wmv2"
+	case "flv":
+		encoder = "flv"
+	}
+	return []string{"-c:v", encoder, "-b:v", bitrate}, encoder
+}
+
+// twoPassArgs encoder'ın iki geçişli kodlama argümanlarını döner; desteklenmiyorsa nil.
+func twoPassArgs(encoder string, passLog string, pass int) []string {
+	switch {
+	case encoder == "libx265":
+		return []string{"-x265-params", fmt.Sprintf("pass=%d:stats=%s.log", pass, passLog)}
+	case slices.Contains(twoPassEncoders, encoder):
+		return []string{"-pass", strconv.Itoa(pass), "-passlogfile", passLog}
+	default:
+		return nil
+	}
+}
+
+// convertAudioToTargetSize sesi sabit bitrate ile hedef boyutun altına sığdırır.
+func (a *AudioConverter) convertAudioToTargetSize(ffmpegPath string, input string, output string, to string, opts Options) error {
+	duration, err := probeMediaDuration(input)
+	if err != nil {
+		return err
+	}
+	kbps := int(float64(opts.TargetSize) * 8 / 1000 / duration * targetSizeSafety)
+	kbps = min(kbps, maxAudioBitrateKbps(to))
+	if to == "mp3" {
+		kbps = floorMP3Bitrate(kbps)
+	}
+	if kbps < minTargetAudioKbps {
+		return fmt.Errorf("hedef boyut %.1fs süre için çok küçük", duration)
+	}
+
+	spec := AudioSpec{}
+	if opts.Audio != nil {
+		spec = *opts.Audio
+	}
+	spec.BitrateMode = AudioBitrateModeCBR
+
+	encode := func(kbps int, path string) error {
+		if to == "mp3" {
+			kbps = floorMP3Bitrate(kbps)
+		}
+		spec.Bitrate = fmt.Sprintf("%dk", kbps)
+		args := []string{}
+		if !opts.Verbose {
+			args = append(args, "-loglevel", "error")
+		}
+		args = append(args, "-i", input, "-y")
+		args = append(args, a.getCodecArgs(to, opts.Quality, &spec)...)
+		args = append(args, MetadataFFmpegArgs(opts.MetadataMode)...)
+		args = append(args, path)
+		if out, err := Command(ffmpegPath, args...).CombinedOutput(); err != nil {
+			return fmt.Errorf("FFmpeg hatası: %s\n%s", err.Error(), string(out))
+		}
+		return nil
+	}
+
+	return fitToTargetSize(output, opts.TargetSize, kbps, minTargetAudioKbps, encode)
+}
+
+func maxAudioBitrateKbps(to string) int {
+	switch to {
+	case "mp3":
+		return 320
+	case "wma":
+		return 192
+	default:
+		return 512
+	}
+}
+
+func floorMP3Bitrate(kbps int) int {
+	best := mp3CBRBitrates[0]
+	for _, rate := range mp3CBRBitrates {
+		if rate <= kbps {
+			best = rate
+		}
+	}
+	return best
+}
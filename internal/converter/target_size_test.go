@@ -0,0 +1,153 @@
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{in: "2mb", want: 2 * 1024 * 1024},
+		{in: "500KB", want: 500 * 1024},
+		{in: "1.5gb", want: int64(1.5 * 1024 * 1024 * 1024)},
+		{in: "800b", want: 800},
+		{in: "1024", want: 1024},
+	}
+	for _, tt := range tests {
+		got, err := ParseSize(tt.in)
+		if err != nil || got != tt.want {
+			t.Fatalf("ParseSize(%q) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"", "mb", "-5mb", "abc"} {
+		if _, err := ParseSize(in); err == nil {
+			t.Fatalf("expected error for %q", in)
+		}
+	}
+}
+
+func TestPlanTargetBitrates(t *testing.T) {
+	// 60 saniye, 10 MB: toplam ~1342 kbps
+	video, audio, err := PlanTargetBitrates(10*1024*1024, 60, 128)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if audio != 128 || video < 1100 || video > 1300 {
+		t.Fatalf("unexpected plan: video=%d audio=%d", video, audio)
+	}
+
+	// Dar bütçede ses bitrate'i kısılmalı.
+	video, audio, err = PlanTargetBitrates(1024*1024, 60, 128)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if audio >= 128 || video < minTargetVideoKbps {
+		t.Fatalf("expected audio to shrink, got video=%d audio=%d", video, audio)
+	}
+
+	if _, _, err := PlanTargetBitrates(100*1024, 600, 128); err == nil || !strings.Contains(err.Error(), "çok küçük") {
+		t.Fatalf("expected too-small error, got %v", err)
+	}
+}
+
+// writeSizedOutput encode yerine belirtilen boyutta bir dosya yazar.
+func writeSizedOutput(path string, size int) error {
+	return os.WriteFile(path, make([]byte, size), 0644)
+}
+
+func TestFitToTargetSizeRetriesWithLowerBitrate(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "out.mp4")
+	var attempts []int
+	err := fitToTargetSize(output, 1000, 500, 50, func(kbps int, path string) error {
+		attempts = append(attempts, kbps)
+		if kbps >= 500 {
+			return writeSizedOutput(path, 1200)
+		}
+		return writeSizedOutput(path, 950)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(attempts) != 2 || attempts[1] >= 500 {
+		t.Fatalf("expected a corrected second attempt, got %v", attempts)
+	}
+	if info, err := os.Stat(output); err != nil || info.Size() != 950 {
+		t.Fatalf("expected fitting output to be committed, got %v (%v)", info, err)
+	}
+}
+
+func TestFitToTargetSizeKeepsExistingOutputWhenTargetMissed(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "out.mp4")
+	if err := os.WriteFile(output, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		minKbps int
+	}{
+		{name: "max attempts", minKbps: 50},
+		// İlk düzeltmede bitrate alt sınırın altına iner
+		{name: "minimum bitrate", minKbps: 400},
+	}
+	for _, tt := range cases {
+		err := fitToTargetSize(output, 1000, 500, tt.minKbps, func(_ int, path string) error {
+			return writeSizedOutput(path, 2000)
+		})
+		if err == nil || !strings.Contains(err.Error(), "ulaşılamadı") {
+			t.Fatalf("%s: expected target size failure, got %v", tt.name, err)
+		}
+		if data, _ := os.ReadFile(output); string(data) != "old" {
+			t.Fatalf("%s: oversized encode replaced the existing output (%d bytes)", tt.name, len(data))
+		}
+		if _, err := os.Stat(TempOutputPath(output)); !os.IsNotExist(err) {
+			t.Fatalf("%s: expected temp output to be removed, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestValidateTargetSizeOptions(t *testing.T) {
+	crf := 20
+	tests := []struct {
+		name    string
+		target  string
+		audio   *AudioSpec
+		video   *VideoSpec
+		wantErr bool
+	}{
+		{name: "mp4 plain", target: "mp4"},
+		{name: "mp3 plain", target: "mp3"},
+		{name: "video crf", target: "mp4", video: &VideoSpec{CRF: &crf}, wantErr: true},
+		{name: "video copy", target: "mkv", video: &VideoSpec{Codec: VideoCodecCopy}, wantErr: true},
+		{name: "flac lossless", target: "flac", wantErr: true},
+		{name: "audio vbr", target: "mp3", audio: &AudioSpec{BitrateMode: "vbr"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		err := ValidateTargetSizeOptions(tt.target, 1024*1024, tt.audio, tt.video)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: unexpected result: %v", tt.name, err)
+		}
+	}
+}
+
+func TestTwoPassArgs(t *testing.T) {
+	if got := twoPassArgs("libx264", "/tmp/log", 1); !slices.Equal(got, []string{"-pass", "1", "-passlogfile", "/tmp/log"}) {
+		t.Fatalf("unexpected x264 args: %v", got)
+	}
+	if got := twoPassArgs("libx265", "/tmp/log", 2); !slices.Equal(got, []string{"-x265-params", "pass=2:stats=/tmp/log.log"}) {
+		t.Fatalf("unexpected x265 args: %v", got)
+	}
+	if got := twoPassArgs("h264_videotoolbox", "/tmp/log", 1); got != nil {
+		t.Fatalf("expected no pass args for hardware encoder, got %v", got)
+	}
+	if got := floorMP3Bitrate(150); got != 128 {
+		t.Fatalf("expected mp3 bitrate to floor to 128, got %d", got)
+	}
+}
@@ -0,0 +1,80 @@
+package converter
+
+import (
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+var (
+	toolVersionMu    sync.Mutex
+	toolVersionCache = map[string]string{}
+)
+
+// toolVersionLookup aracın sürüm satırını okur; testlerde değiştirilebilir.
+var toolVersionLookup = readToolVersion
+
+// ToolVersions from -> to dönüşümünün sonucunu etkileyebilecek harici araçların
+// sürümlerini döner. Bulunamayan araçlar "missing" olarak işaretlenir; sürümler
+// çalışma boyunca bir kez okunur.
+func ToolVersions(from, to string) map[string]string {
+	from, to = NormalizeFormat(from), NormalizeFormat(to)
+	var tools []string
+	fromCat, toCat := FormatCategory(from), FormatCategory(to)
+	switch {
+	case fromCat == "audio" || fromCat == "video" || toCat == "audio" || toCat == "video":
+		tools = append(tools, "ffmpeg")
+	case from == "heic" || from == "heif":
+		tools = append(tools, "ffmpeg")
+	case fromCat == "document" || toCat == "document":
+		tools = append(tools, "libreoffice", "pandoc")
+	}
+
+	versions := make(map[string]string, len(tools))
+	for _, tool := range tools {
+		versions[tool] = cachedToolVersion(tool)
+	}
+	return versions
+}
+
+func cachedToolVersion(tool string) string {
+	toolVersionMu.Lock()
+	defer toolVersionMu.Unlock()
+	if v, ok := toolVersionCache[tool]; ok {
+		return v
+	}
+	v := toolVersionLookup(tool)
+	if v == "" {
+		v = "missing"
+	}
+	toolVersionCache[tool] = v
+	return v
+}
+
+func readToolVersion(tool string) string {
+	var (
+		path string
+		err  error
+		args = []string{"--version"}
+	)
+	switch tool {
+	case "ffmpeg":
+		path, err = (&AudioConverter{}).findFFmpeg()
+		args = []string{"-version"}
+	case "libreoffice":
+		path, err = findLibreOffice()
+	case "pandoc":
+		path, err = findPandoc()
+	default:
+		return ""
+	}
+	if err != nil {
+		return ""
+	}
+	out, err := exec.Command(path, args...).Output()
+	if err != nil {
+		return ""
+	}
+	line, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,463 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Flip yönleri.
+const (
+	FlipHorizontal = "horizontal"
+	FlipVertical   = "vertical"
+	FlipBoth       = "both"
+)
+
+// Kırpma hizalamaları (gravity): en-boy oranı kırpmasında ve ofsetsiz kırpmada hangi bölgenin korunacağını belirler.
+var cropGravities = []string{
+	"center", "top", "bottom", "left", "right",
+	"top-left", "top-right", "bottom-left", "bottom-right",
+}
+
+// autoCropSamples cropdetect için video boyunca örneklenen nokta sayısıdır.
+const autoCropSamples = 5
+
+// CropRect piksel cinsinden kırpma dikdörtgenidir.
+// X veya Y negatifse ofset gravity'ye göre hesaplanır.
+type CropRect struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+}
+
+// TransformSpec video kırpma, döndürme ve çevirme ayarlarını tutar.
+// Filtreler sırasıyla uygulanır: kırpma → oran kırpması → döndürme → çevirme → boyutlandırma.
+type TransformSpec struct {
+	Crop    *CropRect `json:"crop,omitempty"`
+	Aspect  string    `json:"aspect,omitempty"`
+	Gravity string    `json:"gravity,omitempty"`
+	// Rotate saat yönünde derece; 90'ın katları transpose ile kayıpsız, diğerleri rotate filtresiyle uygulanır.
+	Rotate int    `json:"rotate,omitempty"`
+	Flip   string `json:"flip,omitempty"`
+	// AutoCrop siyah bantları cropdetect ile bulur; tespit edilen dikdörtgen Crop alanına yazılır.
+	AutoCrop bool `json:"auto_crop,omitempty"`
+}
+
+// IsZero spec'in hiçbir dönüşüm içermediğini döner.
+func (s *TransformSpec) IsZero() bool {
+	return s == nil || (s.Crop == nil && s.Aspect == "" && s.Rotate == 0 && s.Flip == "" && !s.AutoCrop)
+}
+
+// ParseCropRect "W:H:X:Y", "WxH+X+Y" veya ofsetsiz "WxH" / "W:H" biçimlerini çözer.
+// Ofset verilmezse X ve Y -1 olur (gravity'ye göre hizalanır).
+func ParseCropRect(value string) (*CropRect, error) {
+	raw := strings.ToLower(strings.TrimSpace(value))
+	if raw == "" {
+		return nil, nil
+	}
+
+	var parts []string
+	if strings.Contains(raw, "x") {
+		size, offset, _ := strings.Cut(raw, "+")
+		w, h, ok := strings.Cut(size, "x")
+		if !ok {
+			return nil, fmt.Errorf("geçersiz kırpma: %s", value)
+		}
+		parts = []string{w, h}
+		if offset != "" {
+			x, y, ok := strings.Cut(offset, "+")
+			if !ok {
+				return nil, fmt.Errorf("geçersiz kırpma ofseti: %s (ör: 1280x720+0+140)", value)
+			}
+			parts = append(parts, x, y)
+		}
+	} else {
+		parts = strings.Split(raw, ":")
+	}
+	if len(parts) != 2 && len(parts) != 4 {
+		return nil, fmt.Errorf("geçersiz kırpma: %s (ör: 1280:720:0:140 veya 1280x720+0+140)", value)
+	}
+
+	nums := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("geçersiz kırpma değeri: %s", p)
+		}
+		nums[i] = n
+	}
+	rect := &CropRect{Width: nums[0], Height: nums[1], X: -1, Y: -1}
+	if len(nums) == 4 {
+		rect.X, rect.Y = nums[2], nums[3]
+	}
+	if rect.Width == 0 || rect.Height == 0 {
+		return nil, fmt.Errorf("kırpma genişliği ve yüksekliği sıfırdan büyük olmalı")
+	}
+	return rect, nil
+}
+
+// String kırpmayı FFmpeg'in W:H:X:Y biçiminde döner.
+func (r CropRect) String() string {
+	if r.X < 0 || r.Y < 0 {
+		return fmt.Sprintf("%dx%d", r.Width, r.Height)
+	}
+	return fmt.Sprintf("%d:%d:%d:%d", r.Width, r.Height, r.X, r.Y)
+}
+
+// ParseAspectRatio "9:16", "16/9" veya "1.91" biçimindeki oranı float'a çevirir.
+func ParseAspectRatio(value string) (float64, error) {
+	raw := strings.TrimSpace(value)
+	if raw == "" {
+		return 0, nil
+	}
+	sep := ""
+	switch {
+	case strings.Contains(raw, ":"):
+		sep = ":"
+	case strings.Contains(raw, "/"):
+		sep = "/"
+	}
+	if sep == "" {
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || v <= 0 {
+			return 0, fmt.Errorf("geçersiz en-boy oranı: %s (ör: 9:16, 1:1, 1.91)", value)
+		}
+		return v, nil
+	}
+	a, b, _ := strings.Cut(raw, sep)
+	num, err1 := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	den, err2 := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if err1 != nil || err2 != nil || num <= 0 || den <= 0 {
+		return 0, fmt.Errorf("geçersiz en-boy oranı: %s (ör: 9:16, 1:1, 1.91)", value)
+	}
+	return num / den, nil
+}
+
+// NormalizeCropGravity gravity adını kanonik forma çevirir; geçersizse boş döner.
+func NormalizeCropGravity(gravity string) string {
+	g := strings.ToLower(strings.TrimSpace(gravity))
+	g = strings.ReplaceAll(g, "_", "-")
+	switch g {
+	case "", "middle", "centre":
+		return "center"
+	case "north":
+		return "top"
+	case "south":
+		return "bottom"
+	case "west":
+		return "left"
+	case "east":
+		return "right"
+	}
+	for _, known := range cropGravities {
+		if g == known {
+			return g
+		}
+	}
+	return ""
+}
+
+// NormalizeFlip flip değerini kanonik forma çevirir.
+func NormalizeFlip(flip string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(flip)) {
+	case "", "none":
+		return "", nil
+	case "h", "horizontal", "hflip", "yatay":
+		return FlipHorizontal, nil
+	case "v", "vertical", "vflip", "dikey":
+		return FlipVertical, nil
+	case "both", "hv", "vh":
+		return FlipBoth, nil
+	default:
+		return "", fmt.Errorf("geçersiz flip: %s (horizontal, vertical, both)", flip)
+	}
+}
+
+// BuildTransformSpec flag/spec değerlerinden doğrulanmış TransformSpec üretir; dönüşüm yoksa nil döner.
+func BuildTransformSpec(crop string, aspect string, gravity string, rotate int, flip string, autoCrop bool) (*TransformSpec, error) {
+	rect, err := ParseCropRect(crop)
+	if err != nil {
+		return nil, err
+	}
+	if rect != nil && autoCrop {
+		return nil, fmt.Errorf("--crop ve --auto-crop birlikte kullanılamaz")
+	}
+	if _, err := ParseAspectRatio(aspect); err != nil {
+		return nil, err
+	}
+	g := ""
+	if strings.TrimSpace(gravity) != "" {
+		g = NormalizeCropGravity(gravity)
+		if g == "" {
+			return nil, fmt.Errorf("geçersiz gravity: %s (desteklenen: %s)", gravity, strings.Join(cropGravities, ", "))
+		}
+	}
+	f, err := NormalizeFlip(flip)
+	if err != nil {
+		return nil, err
+	}
+	spec := &TransformSpec{
+		Crop:     rect,
+		Aspect:   strings.TrimSpace(aspect),
+		Gravity:  g,
+		Rotate:   normalizeRotation(rotate),
+		Flip:     f,
+		AutoCrop: autoCrop,
+	}
+	if spec.IsZero() {
+		return nil, nil
+	}
+	return spec, nil
+}
+
+// ValidateTransformSpec spec'i JSON gibi dış kaynaklardan geldiğinde doğrular ve normalize eder.
+func ValidateTransformSpec(spec *TransformSpec) error {
+	if spec.IsZero() {
+		return nil
+	}
+	if spec.Crop != nil && (spec.Crop.Width <= 0 || spec.Crop.Height <= 0) {
+		return fmt.Errorf("kırpma genişliği ve yüksekliği sıfırdan büyük olmalı")
+	}
+	if _, err := ParseAspectRatio(spec.Aspect); err != nil {
+		return err
+	}
+	if spec.Gravity != "" {
+		g := NormalizeCropGravity(spec.Gravity)
+		if g == "" {
+			return fmt.Errorf("geçersiz gravity: %s (desteklenen: %s)", spec.Gravity, strings.Join(cropGravities, ", "))
+		}
+		spec.Gravity = g
+	}
+	f, err := NormalizeFlip(spec.Flip)
+	if err != nil {
+		return err
+	}
+	spec.Flip = f
+	spec.Rotate = normalizeRotation(spec.Rotate)
+	return nil
+}
+
+func normalizeRotation(degrees int) int {
+	r := degrees % 360
+	if r < 0 {
+		r += 360
+	}
+	return r
+}
+
+// BuildTransformFilters spec'i FFmpeg filtre listesine çevirir.
+// AutoCrop açıksa önce ResolveAutoCrop ile Crop doldurulmalıdır.
+func BuildTransformFilters(spec *TransformSpec) ([]string, error) {
+	if spec.IsZero() {
+		return nil, nil
+	}
+	if spec.AutoCrop && spec.Crop == nil {
+		return nil, fmt.Errorf("otomatik kırpma henüz tespit edilmedi")
+	}
+	gravity := NormalizeCropGravity(spec.Gravity)
+	if gravity == "" {
+		gravity = "center"
+	}
+
+	filters := make([]string, 0, 4)
+	if spec.Crop != nil {
+		x, y := strconv.Itoa(spec.Crop.X), strconv.Itoa(spec.Crop.Y)
+		if spec.Crop.X < 0 || spec.Crop.Y < 0 {
+			x, y = cropGravityOffsets(gravity)
+		}
+		filters = append(filters, fmt.Sprintf("crop=%d:%d:%s:%s", spec.Crop.Width, spec.Crop.Height, x, y))
+	}
+	if spec.Aspect != "" {
+		ratio, err := ParseAspectRatio(spec.Aspect)
+		if err != nil {
+			return nil, err
+		}
+		r := strconv.FormatFloat(ratio, 'f', 6, 64)
+		x, y := cropGravityOffsets(gravity)
+		// Kaynak hedef orandan genişse yanlardan, darsa üstten/alttan kırpılır; boyutlar çift sayıya yuvarlanır.
+		filters = append(filters, fmt.Sprintf(
+			"crop=w='trunc(if(gt(iw/ih,%[1]s),ih*%[1]s,iw)/2)*2':h='trunc(if(gt(iw/ih,%[1]s),ih,iw/%[1]s)/2)*2':x=%[2]s:y=%[3]s",
+			r, x, y,
+		))
+	}
+	switch spec.Rotate {
+	case 0:
+	case 90:
+		filters = append(filters, "transpose=clock")
+	case 180:
+		filters = append(filters, "hflip", "vflip")
+	case 270:
+		filters = append(filters, "transpose=cclock")
+	default:
+		rad := fmt.Sprintf("%d*PI/180", spec.Rotate)
+		filters = append(filters, fmt.Sprintf("rotate=%[1]s:ow='rotw(%[1]s)':oh='roth(%[1]s)':fillcolor=black", rad))
+	}
+	switch spec.Flip {
+	case FlipHorizontal:
+		filters = append(filters, "hflip")
+	case FlipVertical:
+		filters = append(filters, "vflip")
+	case FlipBoth:
+		filters = append(filters, "hflip", "vflip")
+	}
+	return filters, nil
+}
+
+// cropGravityOffsets gravity için crop filtresinin x/y ifadelerini döner.
+func cropGravityOffsets(gravity string) (string, string) {
+	x, y := "(iw-ow)/2", "(ih-oh)/2"
+	if strings.Contains(gravity, "left") {
+		x = "0"
+	} else if strings.Contains(gravity, "right") {
+		x = "iw-ow"
+	}
+	if strings.HasPrefix(gravity, "top") {
+		y = "0"
+	} else if strings.HasPrefix(gravity, "bottom") {
+		y = "ih-oh"
+	}
+	return x, y
+}
+
+// DescribeTransformSpec dönüşümü kullanıcıya gösterilecek kısa metne çevirir.
+func DescribeTransformSpec(spec *TransformSpec) string {
+	if spec.IsZero() {
+		return ""
+	}
+	parts := make([]string, 0, 5)
+	if spec.Crop != nil {
+		label := "kırp=" + spec.Crop.String()
+		if spec.AutoCrop {
+			label = "otomatik kırp=" + spec.Crop.String()
+		}
+		parts = append(parts, label)
+	} else if spec.AutoCrop {
+		parts = append(parts, "otomatik kırp")
+	}
+	if spec.Aspect != "" {
+		g := spec.Gravity
+		if g == "" {
+			g = "center"
+		}
+		parts = append(parts, fmt.Sprintf("oran=%s (%s)", spec.Aspect, g))
+	}
+	if spec.Rotate != 0 {
+		parts = append(parts, fmt.Sprintf("döndür=%d°", spec.Rotate))
+	}
+	if spec.Flip != "" {
+		parts = append(parts, "çevir="+spec.Flip)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ResolveAutoCrop AutoCrop açık ve kırpma henüz tespit edilmemişse cropdetect çalıştırır
+// ve tespit edilen dikdörtgeni içeren bir kopya döner.
+func ResolveAutoCrop(input string, spec *TransformSpec) (*TransformSpec, error) {
+	if spec == nil || !spec.AutoCrop || spec.Crop != nil {
+		return spec, nil
+	}
+	rect, err := DetectAutoCrop(input)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *spec
+	resolved.Crop = &rect
+	return &resolved, nil
+}
+
+// DetectAutoCrop video boyunca örneklenen karelerde cropdetect çalıştırır ve en kararlı kırpmayı seçer.
+func DetectAutoCrop(input string) (CropRect, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return CropRect{}, fmt.Errorf("otomatik kırpma için ffmpeg gerekli")
+	}
+	duration, err := probeMediaDuration(input)
+	if err != nil {
+		return CropRect{}, err
+	}
+
+	var rects []CropRect
+	for _, at := range autoCropSampleTimes(duration, autoCropSamples) {
+		args := []string{
+			"-hide_banner", "-ss", formatSeconds(at), "-i", input,
+			"-vf", "cropdetect=limit=24:round=2:reset=0",
+			"-frames:v", "12", "-an", "-f", "null", "-",
+		}
+		out, err := Command(ffmpegPath, args...).CombinedOutput()
+		if err != nil {
+			continue
+		}
+		rects = append(rects, ParseCropdetectOutput(string(out))...)
+	}
+	rect, ok := PickStableCrop(rects)
+	if !ok {
+		return CropRect{}, fmt.Errorf("siyah bant tespit edilemedi: %s", input)
+	}
+	return rect, nil
+}
+
+// autoCropSampleTimes videonun %10-%90 aralığında eşit aralıklı örnek zamanları üretir.
+func autoCropSampleTimes(duration float64, samples int) []float64 {
+	if duration <= 0 || samples <= 0 {
+		return []float64{0}
+	}
+	times := make([]float64, samples)
+	for i := range times {
+		frac := 0.1 + 0.8*float64(i)/math.Max(float64(samples-1), 1)
+		times[i] = math.Round(duration*frac*1000) / 1000
+	}
+	return times
+}
+
+var cropdetectPattern = regexp.MustCompile(`crop=(\d+):(\d+):(\d+):(\d+)`)
+
+// ParseCropdetectOutput FFmpeg cropdetect log çıktısındaki crop=W:H:X:Y değerlerini toplar.
+func ParseCropdetectOutput(output string) []CropRect {
+	matches := cropdetectPattern.FindAllStringSubmatch(output, -1)
+	rects := make([]CropRect, 0, len(matches))
+	for _, m := range matches {
+		w, _ := strconv.Atoi(m[1])
+		h, _ := strconv.Atoi(m[2])
+		x, _ := strconv.Atoi(m[3])
+		y, _ := strconv.Atoi(m[4])
+		if w <= 0 || h <= 0 {
+			continue
+		}
+		rects = append(rects, CropRect{Width: w, Height: h, X: x, Y: y})
+	}
+	return rects
+}
+
+// PickStableCrop en sık görülen kırpmayı seçer; eşitlikte içeriği kesmemek için en büyük alanı tercih eder.
+func PickStableCrop(rects []CropRect) (CropRect, bool) {
+	if len(rects) == 0 {
+		return CropRect{}, false
+	}
+	counts := make(map[CropRect]int, len(rects))
+	for _, r := range rects {
+		counts[r]++
+	}
+	candidates := make([]CropRect, 0, len(counts))
+	for r := range counts {
+		candidates = append(candidates, r)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		if a.Width*a.Height != b.Width*b.Height {
+			return a.Width*a.Height > b.Width*b.Height
+		}
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+		return a.X < b.X
+	})
+	return candidates[0], true
+}
@@ -0,0 +1,143 @@
+package converter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCropRect(t *testing.T) {
+	cases := map[string]CropRect{
+		"1280:720:0:140": {Width: 1280, Height: 720, X: 0, Y: 140},
+		"1280x720+10+20": {Width: 1280, Height: 720, X: 10, Y: 20},
+		"1080x1080":      {Width: 1080, Height: 1080, X: -1, Y: -1},
+		" 640:360 ":      {Width: 640, Height: 360, X: -1, Y: -1},
+	}
+	for in, want := range cases {
+		got, err := ParseCropRect(in)
+		if err != nil {
+			t.Fatalf("ParseCropRect(%q) failed: %v", in, err)
+		}
+		if *got != want {
+			t.Fatalf("ParseCropRect(%q) = %+v, want %+v", in, *got, want)
+		}
+	}
+	for _, bad := range []string{"1280", "1280x", "0:720", "1280x720+10", "a:b:c:d", "1:2:3"} {
+		if _, err := ParseCropRect(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseAspectRatio(t *testing.T) {
+	for in, want := range map[string]float64{"9:16": 0.5625, "16/9": 16.0 / 9.0, "1.91": 1.91} {
+		got, err := ParseAspectRatio(in)
+		if err != nil || got != want {
+			t.Fatalf("ParseAspectRatio(%q) = %v, %v", in, got, err)
+		}
+	}
+	if _, err := ParseAspectRatio("9:0"); err == nil {
+		t.Fatalf("expected error for zero denominator")
+	}
+}
+
+func TestBuildTransformSpec(t *testing.T) {
+	spec, err := BuildTransformSpec("", "", "", 0, "", false)
+	if err != nil || spec != nil {
+		t.Fatalf("expected nil spec without transforms, got %+v %v", spec, err)
+	}
+	spec, err = BuildTransformSpec("", "9:16", "north", -90, "h", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Gravity != "top" || spec.Rotate != 270 || spec.Flip != FlipHorizontal {
+		t.Fatalf("spec not normalized: %+v", spec)
+	}
+	if _, err := BuildTransformSpec("100:100", "", "", 0, "", true); err == nil {
+		t.Fatalf("expected error for crop with auto-crop")
+	}
+	if _, err := BuildTransformSpec("", "9:16", "sideways", 0, "", false); err == nil {
+		t.Fatalf("expected error for invalid gravity")
+	}
+	if _, err := BuildTransformSpec("", "", "", 0, "diagonal", false); err == nil {
+		t.Fatalf("expected error for invalid flip")
+	}
+}
+
+func TestBuildTransformFilters(t *testing.T) {
+	filters, err := BuildTransformFilters(&TransformSpec{
+		Crop:    &CropRect{Width: 1080, Height: 1080, X: -1, Y: -1},
+		Gravity: "bottom-right",
+		Rotate:  90,
+		Flip:    FlipVertical,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"crop=1080:1080:iw-ow:ih-oh", "transpose=clock", "vflip"}
+	if strings.Join(filters, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected filters: %v", filters)
+	}
+
+	filters, err = BuildTransformFilters(&TransformSpec{Aspect: "9:16", Rotate: 45})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(filters[0], "gt(iw/ih,0.562500)") || !strings.Contains(filters[0], "x=(iw-ow)/2:y=(ih-oh)/2") {
+		t.Fatalf("unexpected aspect crop: %s", filters[0])
+	}
+	if !strings.HasPrefix(filters[1], "rotate=45*PI/180") {
+		t.Fatalf("unexpected rotate filter: %s", filters[1])
+	}
+
+	if _, err := BuildTransformFilters(&TransformSpec{AutoCrop: true}); err == nil {
+		t.Fatalf("expected error for unresolved auto-crop")
+	}
+}
+
+func TestParseCropdetectOutputAndPickStable(t *testing.T) {
+	out := `[Parsed_cropdetect_0 @ 0x1] x1:0 x2:1919 y1:140 y2:939 w:1920 h:800 x:0 y:140 pts:1 t:0.04 crop=1920:800:0:140
+[Parsed_cropdetect_0 @ 0x1] x1:0 x2:1919 y1:140 y2:939 w:1920 h:800 x:0 y:140 pts:2 t:0.08 crop=1920:800:0:140
+[Parsed_cropdetect_0 @ 0x1] x1:0 x2:1919 y1:200 y2:879 w:1920 h:672 x:0 y:204 pts:3 t:0.12 crop=1920:672:0:204`
+	rects := ParseCropdetectOutput(out)
+	if len(rects) != 3 {
+		t.Fatalf("expected 3 rects, got %d", len(rects))
+	}
+	got, ok := PickStableCrop(rects)
+	if !ok || got != (CropRect{Width: 1920, Height: 800, X: 0, Y: 140}) {
+		t.Fatalf("unexpected stable crop: %+v", got)
+	}
+
+	// Eşitlikte daha büyük alan seçilir.
+	got, _ = PickStableCrop([]CropRect{{Width: 100, Height: 50}, {Width: 100, Height: 80}})
+	if got.Height != 80 {
+		t.Fatalf("expected larger crop on tie, got %+v", got)
+	}
+	if _, ok := PickStableCrop(nil); ok {
+		t.Fatalf("expected no crop for empty input")
+	}
+}
+
+func TestAutoCropSampleTimes(t *testing.T) {
+	times := autoCropSampleTimes(100, 5)
+	want := []float64{10, 30, 50, 70, 90}
+	for i := range want {
+		if times[i] != want[i] {
+			t.Fatalf("unexpected sample times: %v", times)
+		}
+	}
+}
+
+func TestVideoFilterArgsAppliesTransformBeforeResize(t *testing.T) {
+	opts := Options{
+		Transform: &TransformSpec{Flip: FlipHorizontal},
+		Resize:    &ResizeSpec{Width: 1280, Height: 720, Mode: ResizeModeStretch},
+	}
+	args, _, err := videoFilterArgs("in.mp4", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	vf := args[len(args)-1]
+	if !strings.HasPrefix(vf, "hflip,scale=1280:720") {
+		t.Fatalf("expected flip before resize, got %s", vf)
+	}
+}
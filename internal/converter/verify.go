@@ -0,0 +1,264 @@
+package converter
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image/gif"
+	"io"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// DefaultVerifyTolerance medya çıktısının süresinin kaynaktan sapabileceği varsayılan değerdir.
+const DefaultVerifyTolerance = time.Second
+
+// VerifyOptions dönüşüm sonrası çıktı doğrulamasının ayarlarıdır.
+type VerifyOptions struct {
+	// DurationTolerance medya çıktısı ile kaynak süresi arasındaki izin verilen farktır.
+	DurationTolerance time.Duration
+}
+
+// VerifyOutput üretilen çıktının gerçekten okunabilir olduğunu doğrular: görseller
+// yeniden decode edilir, ses/video çıktıları ffprobe ile okunup süresi kaynakla
+// karşılaştırılır, PDF ve Office belgeleri yeniden ayrıştırılır. Diğer formatlarda
+// çıktının boş olmaması yeterlidir. output geçici bir yol olabilir; format to'dan alınır.
+func VerifyOutput(input, output, from, to string, v VerifyOptions) error {
+	if err := verifyOutput(input, output, from, to, v); err != nil {
+		return fmt.Errorf("çıktı doğrulanamadı: %w", err)
+	}
+	return nil
+}
+
+func verifyOutput(input, output, from, to string, v VerifyOptions) error {
+	info, err := os.Stat(output)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("normal dosya değil")
+	}
+	if info.Size() == 0 {
+		return fmt.Errorf("dosya boş")
+	}
+
+	from, to = NormalizeFormat(from), NormalizeFormat(to)
+	if IsAnimatedTarget(from, to) {
+		return verifyAnimation(output, to)
+	}
+	switch FormatCategory(to) {
+	case "image":
+		if to == "svg" {
+			return verifyXML(output)
+		}
+		_, err := (&ImageConverter{}).decodeImage(output, to)
+		return err
+	case "audio", "video":
+		return verifyMedia(input, output, from, v)
+	}
+	switch to {
+	case "pdf":
+		return verifyPDF(output)
+	case "docx", "xlsx", "pptx", "odt", "ods", "odp":
+		return verifyOfficeDocument(output, to)
+	}
+	return nil
+}
+
+// verifyAnimation videodan üretilen animasyonları doğrular. GIF'in tüm kareleri
+// decode edilir; animasyonlu WebP için Go decoder'ı olmadığından RIFF yapısı kontrol edilir.
+func verifyAnimation(path, to string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if to == "gif" {
+		g, err := gif.DecodeAll(f)
+		if err != nil {
+			return fmt.Errorf("gif decode hatası: %w", err)
+		}
+		if len(g.Image) == 0 {
+			return fmt.Errorf("gif karesi yok")
+		}
+		return nil
+	}
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(f, header); err != nil {
+		return fmt.Errorf("webp başlığı okunamadı: %w", err)
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WEBP" {
+		return fmt.Errorf("geçersiz webp başlığı")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	// RIFF boyutu başlıktan sonraki tüm veriyi kapsar; eksikse dosya kesilmiştir
+	if riffSize := int64(header[4]) | int64(header[5])<<8 | int64(header[6])<<16 | int64(header[7])<<24; riffSize+8 > info.Size() {
+		return fmt.Errorf("webp dosyası eksik (%d/%d byte)", info.Size(), riffSize+8)
+	}
+	return nil
+}
+
+// mediaProbe ffprobe ile okunan süre ve akış bilgisidir.
+type mediaProbe struct {
+	Duration float64
+	Streams  int
+}
+
+func probeMedia(path string) (mediaProbe, error) {
+	ffprobePath := findFFprobe()
+	if ffprobePath == "" {
+		return mediaProbe{}, fmt.Errorf("medya doğrulaması için ffprobe gerekli")
+	}
+	out, err := exec.Command(ffprobePath,
+		"-v", "error",
+		"-show_entries", "format=duration:stream=codec_type",
+		"-of", "json",
+		path,
+	).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(bytes.TrimSpace(exitErr.Stderr)) > 0 {
+			return mediaProbe{}, fmt.Errorf("ffprobe okuyamadı: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return mediaProbe{}, fmt.Errorf("ffprobe okuyamadı: %w", err)
+	}
+	var result struct {
+		Streams []struct {
+			CodecType string `json:"codec_type"`
+		} `json:"streams"`
+		Format struct {
+			Duration string `json:"duration"`
+		} `json:"format"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return mediaProbe{}, fmt.Errorf("ffprobe çıktısı çözümlenemedi: %w", err)
+	}
+	probe := mediaProbe{Streams: len(result.Streams)}
+	probe.Duration, _ = strconv.ParseFloat(strings.TrimSpace(result.Format.Duration), 64)
+	return probe, nil
+}
+
+// verifyMedia çıktıyı ffprobe ile okur ve kaynak da medya ise sürelerini karşılaştırır.
+func verifyMedia(input, output, from string, v VerifyOptions) error {
+	out, err := probeMedia(output)
+	if err != nil {
+		return err
+	}
+	if out.Streams == 0 {
+		return fmt.Errorf("medya akışı bulunamadı")
+	}
+	if out.Duration <= 0 {
+		return fmt.Errorf("süre okunamadı")
+	}
+	if cat := FormatCategory(from); cat != "audio" && cat != "video" {
+		return nil
+	}
+	src, err := probeMedia(input)
+	if err != nil || src.Duration <= 0 {
+		// Kaynak süresi bilinmiyorsa karşılaştırma yapılamaz; çıktı okunabilir olduğu yeterlidir
+		return nil
+	}
+	tolerance := v.DurationTolerance
+	if tolerance <= 0 {
+		tolerance = DefaultVerifyTolerance
+	}
+	if diff := math.Abs(out.Duration - src.Duration); diff > tolerance.Seconds() {
+		return fmt.Errorf("süre uyuşmuyor: çıktı %.2fs, kaynak %.2fs (tolerans %s)", out.Duration, src.Duration, tolerance)
+	}
+	return nil
+}
+
+// verifyPDF PDF'i yeniden açar ve tüm sayfa nesnelerinin okunabildiğini kontrol eder.
+func verifyPDF(path string) (err error) {
+	// Bozuk çapraz referans tablolarında kütüphane panic edebilir
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("PDF ayrıştırılamadı: %v", r)
+		}
+	}()
+	f, r, err := pdf.Open(path)
+	if err != nil {
+		return fmt.Errorf("PDF ayrıştırılamadı: %w", err)
+	}
+	defer f.Close()
+	pages := r.NumPage()
+	if pages == 0 {
+		return fmt.Errorf("PDF sayfası yok")
+	}
+	for i := 1; i <= pages; i++ {
+		if r.Page(i).V.IsNull() {
+			return fmt.Errorf("PDF sayfası okunamadı: %d", i)
+		}
+	}
+	return nil
+}
+
+// officeMainParts Office/ODF paketlerinde bulunması gereken ana XML parçasıdır.
+var officeMainParts = map[string]string{
+	"docx": "word/document.xml",
+	"xlsx": "xl/workbook.xml",
+	"pptx": "ppt/presentation.xml",
+	"odt":  "content.xml",
+	"ods":  "content.xml",
+	"odp":  "content.xml",
+}
+
+// verifyOfficeDocument ZIP paketini açar, ana belge parçasını bulur ve XML olarak ayrıştırır.
+func verifyOfficeDocument(path, format string) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("%s paketi açılamadı: %w", strings.ToUpper(format), err)
+	}
+	defer zr.Close()
+	part := officeMainParts[format]
+	for _, f := range zr.File {
+		if f.Name != part {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("%s okunamadı: %w", part, err)
+		}
+		defer rc.Close()
+		if err := decodeXML(rc); err != nil {
+			return fmt.Errorf("%s ayrıştırılamadı: %w", part, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s paketinde %s yok", strings.ToUpper(format), part)
+}
+
+func verifyXML(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return decodeXML(f)
+}
+
+// decodeXML belgenin sonuna kadar tüm XML token'larını okur.
+func decodeXML(r io.Reader) error {
+	dec := xml.NewDecoder(r)
+	dec.Strict = true
+	for {
+		if _, err := dec.Token(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package converter
+
+import (
+	"archive/zip"
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+func writeVerifyFile(t *testing.T, path string, data []byte) string {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestVerifyOutputImage(t *testing.T) {
+	dir := t.TempDir()
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := writeVerifyFile(t, filepath.Join(dir, "ok.png"), buf.Bytes())
+	if err := VerifyOutput("in.jpg", valid, "jpg", "png", VerifyOptions{}); err != nil {
+		t.Fatalf("expected valid png, got %v", err)
+	}
+
+	truncated := writeVerifyFile(t, filepath.Join(dir, "bad.png"), buf.Bytes()[:buf.Len()/2])
+	if err := VerifyOutput("in.jpg", truncated, "jpg", "png", VerifyOptions{}); err == nil {
+		t.Fatalf("expected truncated png to fail")
+	}
+
+	empty := writeVerifyFile(t, filepath.Join(dir, "empty.txt"), nil)
+	if err := VerifyOutput("in.md", empty, "md", "txt", VerifyOptions{}); err == nil || !strings.Contains(err.Error(), "boş") {
+		t.Fatalf("expected empty output to fail, got %v", err)
+	}
+}
+
+func TestVerifyOutputPDF(t *testing.T) {
+	dir := t.TempDir()
+	doc := gofpdf.New("P", "mm", "A4", "")
+	doc.AddPage()
+	doc.SetFont("Helvetica", "", 12)
+	doc.Cell(40, 10, "verify")
+	var buf bytes.Buffer
+	if err := doc.Output(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := writeVerifyFile(t, filepath.Join(dir, "ok.pdf"), buf.Bytes())
+	if err := VerifyOutput("in.md", valid, "md", "pdf", VerifyOptions{}); err != nil {
+		t.Fatalf("expected valid pdf, got %v", err)
+	}
+
+	truncated := writeVerifyFile(t, filepath.Join(dir, "bad.pdf"), buf.Bytes()[:buf.Len()/2])
+	if err := VerifyOutput("in.md", truncated, "md", "pdf", VerifyOptions{}); err == nil {
+		t.Fatalf("expected truncated pdf to fail")
+	}
+}
+
+func writeZip(t *testing.T, path string, files map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return writeVerifyFile(t, path, buf.Bytes())
+}
+
+func TestVerifyOutputDOCX(t *testing.T) {
+	dir := t.TempDir()
+	valid := writeZip(t, filepath.Join(dir, "ok.docx"), map[string]string{
+		"[Content_Types].xml": `<Types/>`,
+		"word/document.xml":   `<w:document xmlns:w="urn:w"><w:body><w:p/></w:body></w:document>`,
+	})
+	if err := VerifyOutput("in.md", valid, "md", "docx", VerifyOptions{}); err != nil {
+		t.Fatalf("expected valid docx, got %v", err)
+	}
+
+	broken := writeZip(t, filepath.Join(dir, "broken.docx"), map[string]string{
+		"word/document.xml": `<w:document><w:body>`,
+	})
+	if err := VerifyOutput("in.md", broken, "md", "docx", VerifyOptions{}); err == nil {
+		t.Fatalf("expected unterminated document.xml to fail")
+	}
+
+	missing := writeZip(t, filepath.Join(dir, "missing.docx"), map[string]string{"other.xml": `<a/>`})
+	if err := VerifyOutput("in.md", missing, "md", "docx", VerifyOptions{}); err == nil || !strings.Contains(err.Error(), "word/document.xml") {
+		t.Fatalf("expected missing document part to fail, got %v", err)
+	}
+
+	notZip := writeVerifyFile(t, filepath.Join(dir, "plain.docx"), []byte("not a zip"))
+	if err := VerifyOutput("in.md", notZip, "md", "docx", VerifyOptions{}); err == nil {
+		t.Fatalf("expected non-zip docx to fail")
+	}
+}
+
+func TestVerifyOutputAnimatedWebP(t *testing.T) {
+	dir := t.TempDir()
+	// 4 byte gövdeli RIFF: toplam 12 byte başlık + 4 byte veri
+	valid := writeVerifyFile(t, filepath.Join(dir, "ok.webp"), []byte("RIFF\x08\x00\x00\x00WEBPVP8X"))
+	if err := VerifyOutput("in.mp4", valid, "mp4", "webp", VerifyOptions{}); err != nil {
+		t.Fatalf("expected valid riff, got %v", err)
+	}
+	truncated := writeVerifyFile(t, filepath.Join(dir, "bad.webp"), []byte("RIFF\xff\x00\x00\x00WEBPVP8X"))
+	if err := VerifyOutput("in.mp4", truncated, "mp4", "webp", VerifyOptions{}); err == nil {
+		t.Fatalf("expected truncated riff to fail")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
-	"strconv"
 	"strings"
 )
 
@@ -25,8 +24,8 @@ func (v *VideoConverter) Name() string {
 // videoInputFormats kaynak olarak desteklenen video formatları.
 var videoInputFormats = []string{"mp4", "mov", "mkv", "avi", "webm", "m4v", "wmv", "flv"}
 
-// videoOutputFormats hedef olarak desteklenen video/gif formatları.
-var videoOutputFormats = []string{"mp4", "mov", "mkv", "avi", "webm", "m4v", "wmv", "flv", "gif"}
+// videoOutputFormats hedef olarak desteklenen video/gif/animasyonlu webp formatları.
+var videoOutputFormats = []string{"mp4", "mov", "mkv", "avi", "webm", "m4v", "wmv", "flv", "gif", "webp"}
 
 func (v *VideoConverter) SupportedConversions() []ConversionPair {
 	var pairs []ConversionPair
@@ -75,47 +74,70 @@ func (v *VideoConverter) Convert(input string, output string, opts Options) erro
 	}
 
 	to := DetectFormat(output)
-	args := []string{}
+	if !opts.Video.IsZero() {
+		if err := ValidateVideoSpec(opts.Video, to); err != nil {
+			return err
+		}
+		if opts.Video.Codec == VideoCodecCopy && opts.Resize != nil {
+			return fmt.Errorf("video codec copy modunda boyutlandırma yapılamaz")
+		}
+		if opts.Video.Codec == VideoCodecCopy && !opts.Watermark.IsZero() {
+			return fmt.Errorf("video codec copy modunda watermark uygulanamaz")
+		}
+		if opts.Video.Codec == VideoCodecCopy && !opts.Transform.IsZero() {
+			return fmt.Errorf("video codec copy modunda kırpma/döndürme yapılamaz")
+		}
+		if opts.Video.Encoder == "" && opts.Video.AudioEncoder == "" {
+			if _, err := ResolveVideoSpecEncoders(opts.Video, to); err != nil {
+				return err
+			}
+		}
+	}
 
-	if !opts.Verbose {
-		args = append(args, "-loglevel", "error")
+	// Otomatik kırpma dönüşümden önce cropdetect ile çözülür.
+	if opts.Transform != nil && opts.Transform.AutoCrop && opts.Transform.Crop == nil {
+		resolved, err := ResolveAutoCrop(input, opts.Transform)
+		if err != nil {
+			return err
+		}
+		opts.Transform = resolved
 	}
 
-	args = append(args, "-i", input, "-y")
+	// GIF ve animasyonlu WebP palet/kalite odaklı ayrı bir akıştan geçer.
+	if to == "gif" || to == "webp" {
+		return v.convertToAnimation(ffmpegPath, input, output, to, opts)
+	}
 
-	filters := make([]string, 0, 2)
-	if to == "gif" {
-		fps, width := gifProfile(opts.Quality)
-		if opts.Resize == nil {
-			filters = append(filters, fmt.Sprintf("fps=%d,scale=%d:-1:flags=lanczos", fps, width))
-		} else {
-			filters = append(filters, fmt.Sprintf("fps=%d", fps))
-		}
+	filterArgs, videoMap, err := videoFilterArgs(input, opts)
+	if err != nil {
+		return err
 	}
 
-	if opts.Resize != nil {
-		resizeFilter, err := buildVideoResizeFilter(*opts.Resize)
-		if err != nil {
+	// Hedef boyut: süreden bitrate hesaplanır, iki geçişli kodlama ile sığdırılır.
+	if opts.TargetSize > 0 {
+		if err := ValidateTargetSizeOptions(to, opts.TargetSize, nil, opts.Video); err != nil {
 			return err
 		}
-		filters = append(filters, resizeFilter)
-		args = append(args, "-sws_flags", "lanczos+accurate_rnd")
+		return v.convertVideoToTargetSize(ffmpegPath, input, output, to, filterArgs, videoMap, opts)
 	}
 
-	if len(filters) > 0 {
-		args = append(args, "-vf", strings.Join(filters, ","))
+	args := []string{}
+
+	if !opts.Verbose {
+		args = append(args, "-loglevel", "error")
 	}
 
+	args = append(args, "-i", input, "-y")
+	args = append(args, filterArgs...)
+
 	// Video çıktılarında varsa sesi koru, yoksa sessiz devam et.
-	if to != "gif" {
-		args = append(args, "-map", "0:v:0", "-map", "0:a?")
-	}
+	args = append(args, "-map", videoMap, "-map", "0:a?")
 
-	args = append(args, v.getCodecArgs(to, opts.Quality)...)
+	args = append(args, v.getCodecArgs(to, opts.Quality, opts.Video)...)
 	args = append(args, MetadataFFmpegArgs(opts.MetadataMode)...)
 	args = append(args, output)
 
-	cmd := exec.Command(ffmpegPath, args...)
+	cmd := Command(ffmpegPath, args...)
 	if outputBytes, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("FFmpeg hatası: %s\n%s", err.Error(), string(outputBytes))
 	}
@@ -123,67 +145,41 @@ func (v *VideoConverter) Convert(input string, output string, opts Options) erro
 	return nil
 }
 
-// getCodecArgs hedef format ve kaliteye göre FFmpeg parametrelerini döner.
-func (v *VideoConverter) getCodecArgs(to string, quality int) []string {
-	crf := videoCRF(quality)
-
-	switch to {
-	case "gif":
-		return []string{"-loop", "0", "-an"}
-	case "webm":
-		webmCRF := crf + 6
-		if webmCRF > 40 {
-			webmCRF = 40
-		}
-		return []string{
-			"-c:v", "libvpx-vp9",
-			"-crf", strconv.Itoa(webmCRF),
-			"-b:v", "0",
-			"-row-mt", "1",
-			"-c:a", "libopus",
-			"-b:a", "128k",
-		}
-	case "avi":
-		return []string{
-			"-c:v", "mpeg4",
-			"-q:v", strconv.Itoa(videoQScale(quality)),
-			"-c:a", "mp3",
-			"-b:a", "192k",
-		}
-	case "wmv":
-		return []string{
-			"-c:v", "wmv2",
-			"-c:a", "wmav2",
-		}
-	case "flv":
-		return []string{
-			"-c:v", "flv",
-			"-c:a", "mp3",
-			"-ar", "44100",
-		}
-	case "mp4", "m4v", "mov":
-		return []string{
-			"-c:v", "libx264",
-			"-crf", strconv.Itoa(crf),
-			"-preset", "medium",
-			"-pix_fmt", "yuv420p",
-			"-movflags", "+faststart",
-			"-c:a", "aac",
-			"-b:a", "128k",
-		}
-	default: // mkv ve gelecekteki h264 uyumlu kapsayıcılar
-		return []string{
-			"-c:v", "libx264",
-			"-crf", strconv.Itoa(crf),
-			"-preset", "medium",
-			"-pix_fmt", "yuv420p",
-			"-c:a", "aac",
-			"-b:a", "128k",
+// videoFilterArgs kırpma/döndürme, boyutlandırma ve watermark için filtre argümanlarını ve
+// eşlenecek video akışını (0:v:0 veya [vout]) üretir. Logo girdisi de bu argümanlara eklenir.
+func videoFilterArgs(input string, opts Options) ([]string, string, error) {
+	var args []string
+	filters, err := BuildTransformFilters(opts.Transform)
+	if err != nil {
+		return nil, "", err
+	}
+	if opts.Resize != nil {
+		resizeFilter, err := BuildVideoResizeFilter(*opts.Resize)
+		if err != nil {
+			return nil, "", err
 		}
+		filters = append(filters, resizeFilter)
+		args = append(args, "-sws_flags", "lanczos+accurate_rnd")
+	}
+
+	if !opts.Watermark.IsZero() {
+		args = append(args, watermarkInputArgs(opts.Watermark)...)
+		args = append(args, "-filter_complex", BuildWatermarkFilterGraph(*opts.Watermark, input, strings.Join(filters, ",")))
+		return args, "[vout]", nil
+	}
+	if len(filters) > 0 {
+		args = append(args, "-vf", strings.Join(filters, ","))
 	}
+	return args, "0:v:0", nil
+}
+
+// getCodecArgs hedef format, kalite ve video ayarlarına göre FFmpeg parametrelerini döner.
+func (v *VideoConverter) getCodecArgs(to string, quality int, spec *VideoSpec) []string {
+	return VideoEncodeArgs(to, quality, spec)
 }
 
-func buildVideoResizeFilter(spec ResizeSpec) (string, error) {
+// BuildVideoResizeFilter ResizeSpec'i FFmpeg scale/pad/crop filtre zincirine çevirir.
+func BuildVideoResizeFilter(spec ResizeSpec) (string, error) {
 	width := normalizeVideoDimension(spec.Width)
 	height := normalizeVideoDimension(spec.Height)
 	if width <= 0 || height <= 0 {
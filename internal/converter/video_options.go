@@ -0,0 +1,690 @@
+package converter
+
+import (
+	"fmt"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	VideoCodecH264 = "h264"
+	VideoCodecH265 = "h265"
+	VideoCodecAV1  = "av1"
+	VideoCodecVP9  = "vp9"
+	VideoCodecCopy = "copy"
+)
+
+// VideoSpec video çıktısı için codec ve hız kontrol ayarlarını tutar.
+// Sıfır değerli alanlar "hedef formatın varsayılanını kullan" anlamına gelir.
+type VideoSpec struct {
+	Codec        string // h264, h265, av1, vp9, copy
+	CRF          *int   // sabit kalite değeri (codec aralığına göre)
+	Bitrate      string // hedef video bitrate (ör: 2500k)
+	Preset       string // encoder hız/kalite preset'i (ör: medium, slow)
+	PixFmt       string // piksel formatı (ör: yuv420p)
+	AudioCodec   string // ses izi codec'i: aac, opus, mp3, vorbis, ac3, flac, copy, none
+	AudioBitrate string // ses izi bitrate (ör: 128k)
+
+	// ResolveVideoSpecEncoders tarafından yerel FFmpeg'e göre doldurulur.
+	Encoder      string
+	AudioEncoder string
+}
+
+// videoCodecEncoders codec başına denenecek encoder'lar; öncelik sırasına göre.
+var videoCodecEncoders = map[string][]string{
+	VideoCodecH264: {"libx264", "libopenh264", "h264_videotoolbox"},
+	VideoCodecH265: {"libx265", "hevc_videotoolbox"},
+	VideoCodecAV1:  {"libsvtav1", "libaom-av1", "librav1e"},
+	VideoCodecVP9:  {"libvpx-vp9"},
+}
+
+// videoTrackAudioEncoders video içindeki ses izi için encoder adayları.
+var videoTrackAudioEncoders = map[string][]string{
+	"aac":    {"aac", "libfdk_aac"},
+	"opus":   {"libopus", "opus"},
+	"mp3":    {"libmp3lame", "libshine"},
+	"vorbis": {"libvorbis", "vorbis"},
+	"ac3":    {"ac3"},
+	"flac":   {"flac"},
+}
+
+// videoContainerCodecs kapsayıcı başına izin verilen video codec'leri.
+var videoContainerCodecs = map[string][]string{
+	"mp4":  {VideoCodecH264, VideoCodecH265, VideoCodecAV1, VideoCodecVP9},
+	"m4v":  {VideoCodecH264, VideoCodecH265, VideoCodecAV1},
+	"mov":  {VideoCodecH264, VideoCodecH265, VideoCodecAV1},
+	"mkv":  {VideoCodecH264, VideoCodecH265, VideoCodecAV1, VideoCodecVP9},
+	"webm": {VideoCodecVP9, VideoCodecAV1},
+	"avi":  {VideoCodecH264},
+	"flv":  {VideoCodecH264},
+}
+
+// videoContainerAudioCodecs kapsayıcı başına izin verilen ses izi codec'leri.
+var videoContainerAudioCodecs = map[string][]string{
+	"mp4":  {"aac", "mp3", "opus", "ac3", "flac"},
+	"m4v":  {"aac", "mp3", "ac3"},
+	"mov":  {"aac", "mp3", "ac3"},
+	"mkv":  {"aac", "mp3", "opus", "vorbis", "ac3", "flac"},
+	"webm": {"opus", "vorbis"},
+	"avi":  {"mp3", "ac3", "aac"},
+	"flv":  {"aac", "mp3"},
+	"wmv":  {"mp3"},
+}
+
+var x264Presets = []string{"ultrafast", "superfast", "veryfast", "faster", "fast", "medium", "slow", "slower", "veryslow", "placebo"}
+
+var videoPixFormats = []string{
+	"yuv420p", "yuv422p", "yuv444p",
+	"yuv420p10le", "yuv422p10le", "yuv444p10le",
+	"yuvj420p", "nv12", "p010le",
+}
+
+// IsVideoFormat formatın video kapsayıcı çıktı formatı olup olmadığını döner (gif ve animasyonlu webp hariç).
+func IsVideoFormat(format string) bool {
+	to := NormalizeFormat(format)
+	return to != "gif" && to != "webp" && slices.Contains(videoOutputFormats, to)
+}
+
+// IsZero spec'te hiçbir ayar verilmemişse true döner.
+func (s *VideoSpec) IsZero() bool {
+	return s == nil || (s.Codec == "" && s.CRF == nil && s.Bitrate == "" && s.Preset == "" &&
+		s.PixFmt == "" && s.AudioCodec == "" && s.AudioBitrate == "")
+}
+
+// NormalizeVideoCodec kullanıcı girişini h264, h265, av1, vp9 veya copy olarak döner.
+// Geçersiz girişte boş string döner.
+func NormalizeVideoCodec(raw string) string {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "h264", "avc", "x264", "libx264":
+		return VideoCodecH264
+	case "h265", "hevc", "x265", "libx265":
+		return VideoCodecH265
+	case "av1", "libsvtav1", "svt-av1", "svtav1", "libaom-av1", "aom":
+		return VideoCodecAV1
+	case "vp9", "libvpx-vp9", "vpx":
+		return VideoCodecVP9
+	case "copy":
+		return VideoCodecCopy
+	default:
+		return ""
+	}
+}
+
+// NormalizeVideoAudioCodec video içindeki ses izi codec'ini normalize eder.
+// Geçersiz girişte boş string döner.
+func NormalizeVideoAudioCodec(raw string) string {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "aac", "libfdk_aac":
+		return "aac"
+	case "opus", "libopus":
+		return "opus"
+	case "mp3", "libmp3lame":
+		return "mp3"
+	case "vorbis", "libvorbis":
+		return "vorbis"
+	case "ac3":
+		return "ac3"
+	case "flac":
+		return "flac"
+	case "copy":
+		return "copy"
+	case "none", "an":
+		return "none"
+	default:
+		return ""
+	}
+}
+
+// NormalizeVideoBitrate "2500k", "4M", "4mbps" gibi değerleri "2500k" biçimine çevirir.
+func NormalizeVideoBitrate(raw string) (string, error) {
+	kbps, err := parseBitrateKbps(raw)
+	if err != nil {
+		return "", err
+	}
+	if kbps < 50 || kbps > 200000 {
+		return "", fmt.Errorf("video bitrate 50k-200M aralığında olmalı: %s", raw)
+	}
+	return fmt.Sprintf("%dk", kbps), nil
+}
+
+// BuildVideoSpec bayraklardan ortak video ayarını üretir.
+// crf < 0 ayarlanmamış kabul edilir. Hiçbir ayar verilmemişse nil döner.
+func BuildVideoSpec(codec string, crf int, bitrate string, preset string, pixFmt string, audioCodec string, audioBitrate string) (*VideoSpec, error) {
+	spec := &VideoSpec{
+		Preset: strings.ToLower(strings.TrimSpace(preset)),
+		PixFmt: strings.ToLower(strings.TrimSpace(pixFmt)),
+	}
+
+	if strings.TrimSpace(codec) != "" {
+		spec.Codec = NormalizeVideoCodec(codec)
+		if spec.Codec == "" {
+			return nil, fmt.Errorf("geçersiz video codec: %s (geçerli: h264, h265, av1, vp9, copy)", codec)
+		}
+	}
+	if crf >= 0 {
+		value := crf
+		spec.CRF = &value
+	}
+	if strings.TrimSpace(bitrate) != "" {
+		normalized, err := NormalizeVideoBitrate(bitrate)
+		if err != nil {
+			return nil, err
+		}
+		spec.Bitrate = normalized
+	}
+	if strings.TrimSpace(audioCodec) != "" {
+		spec.AudioCodec = NormalizeVideoAudioCodec(audioCodec)
+		if spec.AudioCodec == "" {
+			return nil, fmt.Errorf("geçersiz ses codec: %s (geçerli: aac, opus, mp3, vorbis, ac3, flac, copy, none)", audioCodec)
+		}
+	}
+	if strings.TrimSpace(audioBitrate) != "" {
+		normalized, err := NormalizeAudioBitrate(audioBitrate)
+		if err != nil {
+			return nil, err
+		}
+		spec.AudioBitrate = normalized
+	}
+
+	if spec.IsZero() {
+		return nil, nil
+	}
+	return spec, nil
+}
+
+// ValidateVideoSpec video ayarlarının hedef kapsayıcıyla uyumunu FFmpeg çağrılmadan önce kontrol eder.
+func ValidateVideoSpec(spec *VideoSpec, targetFormat string) error {
+	if spec.IsZero() {
+		return nil
+	}
+	to := NormalizeFormat(targetFormat)
+	if !IsVideoFormat(to) {
+		return fmt.Errorf("video codec ayarları sadece video çıktılarında kullanılabilir (hedef: %s)", to)
+	}
+
+	codec := spec.Codec
+	if codec == VideoCodecCopy {
+		if spec.CRF != nil || spec.Bitrate != "" || spec.Preset != "" || spec.PixFmt != "" {
+			return fmt.Errorf("video codec copy modunda --crf, --bitrate, --encode-preset ve --pix-fmt kullanılamaz")
+		}
+	} else if codec != "" {
+		if !slices.Contains(videoContainerCodecs[to], codec) {
+			allowed := videoContainerCodecs[to]
+			if len(allowed) == 0 {
+				return fmt.Errorf("%s kapsayıcısı için video codec seçimi desteklenmiyor", to)
+			}
+			return fmt.Errorf("%s kapsayıcısı %s codec'ini desteklemez (geçerli: %s)", to, codec, strings.Join(allowed, ", "))
+		}
+	}
+
+	if codec == "" && (spec.CRF != nil || spec.Preset != "" || spec.Bitrate != "" || spec.PixFmt != "") {
+		codec = defaultVideoCodec(to)
+		if codec == "" {
+			return fmt.Errorf("%s için --crf, --bitrate, --encode-preset ve --pix-fmt desteklenmiyor; --video-codec belirtin", to)
+		}
+	}
+
+	if spec.CRF != nil {
+		maxCRF := 63
+		if codec == VideoCodecH264 || codec == VideoCodecH265 {
+			maxCRF = 51
+		}
+		if *spec.CRF < 0 || *spec.CRF > maxCRF {
+			return fmt.Errorf("%s için crf 0-%d aralığında olmalı: %d", codec, maxCRF, *spec.CRF)
+		}
+	}
+
+	if spec.Preset != "" && !validVideoPreset(codec, spec.Preset) {
+		return fmt.Errorf("%s için geçersiz preset: %s (geçerli: %s)", codec, spec.Preset, strings.Join(videoPresetChoices(codec), ", "))
+	}
+
+	if spec.PixFmt != "" && !slices.Contains(videoPixFormats, spec.PixFmt) {
+		return fmt.Errorf("geçersiz piksel formatı: %s (geçerli: %s)", spec.PixFmt, strings.Join(videoPixFormats, ", "))
+	}
+
+	switch spec.AudioCodec {
+	case "", "copy":
+	case "none":
+		if spec.AudioBitrate != "" {
+			return fmt.Errorf("ses izi kaldırılırken --audio-bitrate kullanılamaz")
+		}
+	default:
+		if !slices.Contains(videoContainerAudioCodecs[to], spec.AudioCodec) {
+			return fmt.Errorf("%s kapsayıcısı %s ses codec'ini desteklemez (geçerli: %s)", to, spec.AudioCodec, strings.Join(videoContainerAudioCodecs[to], ", "))
+		}
+		if spec.AudioCodec == "flac" && spec.AudioBitrate != "" {
+			return fmt.Errorf("flac kayıpsız bir codec'tir, --audio-bitrate kullanılamaz")
+		}
+	}
+	if spec.AudioCodec == "copy" && spec.AudioBitrate != "" {
+		return fmt.Errorf("ses codec copy modunda --audio-bitrate kullanılamaz")
+	}
+
+	return nil
+}
+
+// ResolveVideoSpecEncoders spec'teki codec'leri yerel FFmpeg'in desteklediği encoder'lara eşler.
+// Tercih edilen encoder yoksa aynı codec ailesinden bir yedeğe geçer ve bunu not olarak döner.
+func ResolveVideoSpecEncoders(spec *VideoSpec, targetFormat string) ([]string, error) {
+	if spec.IsZero() {
+		return nil, nil
+	}
+	available, err := AvailableFFmpegEncoders()
+	if err != nil {
+		return nil, err
+	}
+	return resolveVideoSpecEncoders(spec, targetFormat, available)
+}
+
+func resolveVideoSpecEncoders(spec *VideoSpec, targetFormat string, available map[string]bool) ([]string, error) {
+	var notes []string
+
+	codec := spec.Codec
+	if codec == "" {
+		codec = defaultVideoCodec(NormalizeFormat(targetFormat))
+	}
+	if candidates, ok := videoCodecEncoders[codec]; ok && spec.Codec != VideoCodecCopy {
+		encoder, note, err := pickEncoder(codec, candidates, available, "video")
+		if err != nil {
+			return nil, err
+		}
+		spec.Encoder = encoder
+		if note != "" {
+			notes = append(notes, note)
+		}
+	}
+
+	if candidates, ok := videoTrackAudioEncoders[spec.AudioCodec]; ok {
+		encoder, note, err := pickEncoder(spec.AudioCodec, candidates, available, "ses")
+		if err != nil {
+			return nil, err
+		}
+		spec.AudioEncoder = encoder
+		if note != "" {
+			notes = append(notes, note)
+		}
+	}
+	return notes, nil
+}
+
+func pickEncoder(codec string, candidates []string, available map[string]bool, kind string) (string, string, error) {
+	for i, candidate := range candidates {
+		if !available[candidate] {
+			continue
+		}
+		if i == 0 {
+			return candidate, "", nil
+		}
+		return candidate, fmt.Sprintf("%s encoder'ı bulunamadı, %s kullanılacak", candidates[0], candidate), nil
+	}
+
+	var alternatives []string
+	families := videoCodecEncoders
+	if kind == "ses" {
+		families = videoTrackAudioEncoders
+	}
+	for name, encoders := range families {
+		if name == codec {
+			continue
+		}
+		for _, encoder := range encoders {
+			if available[encoder] {
+				alternatives = append(alternatives, name)
+				break
+			}
+		}
+	}
+	slices.Sort(alternatives)
+	msg := fmt.Sprintf("yerel FFmpeg %s %s encoder'ı içermiyor (denenen: %s)", codec, kind, strings.Join(candidates, ", "))
+	if len(alternatives) > 0 {
+		msg += fmt.Sprintf("; kullanılabilir: %s", strings.Join(alternatives, ", "))
+	}
+	return "", "", fmt.Errorf("%s", msg)
+}
+
+var (
+	ffmpegEncodersOnce sync.Once
+	ffmpegEncoders     map[string]bool
+	ffmpegEncodersErr  error
+)
+
+// AvailableFFmpegEncoders yerel FFmpeg'in `-encoders` çıktısındaki encoder adlarını döner.
+// Sonuç süreç boyunca önbelleklenir.
+func AvailableFFmpegEncoders() (map[string]bool, error) {
+	ffmpegEncodersOnce.Do(func() {
+		ac := &AudioConverter{}
+		ffmpegPath, err := ac.findFFmpeg()
+		if err != nil {
+			ffmpegEncodersErr = err
+			return
+		}
+		out, err := exec.Command(ffmpegPath, "-hide_banner", "-encoders").Output()
+		if err != nil {
+			ffmpegEncodersErr = fmt.Errorf("FFmpeg encoder listesi alınamadı: %w", err)
+			return
+		}
+		ffmpegEncoders = parseFFmpegEncoders(string(out))
+	})
+	return ffmpegEncoders, ffmpegEncodersErr
+}
+
+// parseFFmpegEncoders `ffmpeg -encoders` çıktısını encoder adı kümesine çevirir.
+func parseFFmpegEncoders(output string) map[string]bool {
+	encoders := make(map[string]bool)
+	started := false
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !started {
+			if strings.HasPrefix(trimmed, "------") {
+				started = true
+			}
+			continue
+		}
+		fields := strings.Fields(trimmed)
+		if len(fields) < 2 || len(fields[0]) != 6 {
+			continue
+		}
+		encoders[fields[1]] = true
+	}
+	return encoders
+}
+
+// VideoEncodeArgs hedef format, kalite ve video ayarlarına göre FFmpeg codec argümanlarını döner.
+// spec nil ise tarihsel varsayılanlar (libx264/libvpx-vp9) korunur.
+func VideoEncodeArgs(to string, quality int, spec *VideoSpec) []string {
+	to = NormalizeFormat(to)
+	if to == "gif" {
+		return []string{"-loop", "0", "-an"}
+	}
+	if spec.IsZero() {
+		return legacyVideoCodecArgs(to, quality)
+	}
+
+	codec := spec.Codec
+	if codec == "" {
+		codec = defaultVideoCodec(to)
+	}
+
+	var args []string
+	switch codec {
+	case "":
+		args = append(args, legacyVideoOnlyArgs(to, quality)...)
+	case VideoCodecCopy:
+		args = append(args, "-c:v", "copy")
+	default:
+		args = append(args, videoCodecArgs(codec, quality, spec)...)
+	}
+
+	if to == "mp4" || to == "m4v" || to == "mov" {
+		args = append(args, "-movflags", "+faststart")
+	}
+	args = append(args, videoTrackAudioArgs(to, spec)...)
+	return args
+}
+
+func videoCodecArgs(codec string, quality int, spec *VideoSpec) []string {
+	encoder := spec.Encoder
+	if encoder == "" {
+		encoder = videoCodecEncoders[codec][0]
+	}
+
+	crf := videoCRF(quality)
+	switch codec {
+	case VideoCodecH265:
+		crf += 5
+	case VideoCodecVP9, VideoCodecAV1:
+		crf += 8
+	}
+	if spec.CRF != nil {
+		crf = *spec.CRF
+	}
+
+	args := []string{"-c:v", encoder}
+	switch {
+	case strings.HasSuffix(encoder, "_videotoolbox"):
+		// Donanım encoder'ları CRF desteklemez; kaliteyi bitrate ile yönet.
+		bitrate := spec.Bitrate
+		if bitrate == "" {
+			bitrate = "6000k"
+		}
+		args = append(args, "-b:v", bitrate)
+	case encoder == "libopenh264":
+		if spec.Bitrate != "" {
+			args = append(args, "-b:v", spec.Bitrate)
+		}
+	case spec.CRF == nil && spec.Bitrate != "":
+		args = append(args, "-b:v", spec.Bitrate)
+	case spec.Bitrate != "":
+		if codec == VideoCodecH264 || codec == VideoCodecH265 {
+			// CRF + üst sınır (capped CRF)
+			args = append(args, "-crf", strconv.Itoa(crf), "-maxrate", spec.Bitrate, "-bufsize", doubleBitrate(spec.Bitrate))
+		} else {
+			// VP9/AV1 constrained quality
+			args = append(args, "-crf", strconv.Itoa(crf), "-b:v", spec.Bitrate)
+		}
+	default:
+		args = append(args, "-crf", strconv.Itoa(crf))
+		if codec == VideoCodecVP9 || encoder == "libaom-av1" {
+			args = append(args, "-b:v", "0")
+		}
+	}
+
+	args = append(args, videoPresetArgs(codec, encoder, spec.Preset)...)
+	if codec == VideoCodecVP9 {
+		args = append(args, "-row-mt", "1")
+	}
+	if codec == VideoCodecH265 && (encoder == "libx265" || encoder == "hevc_videotoolbox") {
+		// Apple oynatıcılarıyla uyum için hvc1 etiketi
+		args = append(args, "-tag:v", "hvc1")
+	}
+
+	pixFmt := spec.PixFmt
+	if pixFmt == "" {
+		pixFmt = "yuv420p"
+	}
+	args = append(args, "-pix_fmt", pixFmt)
+	return args
+}
+
+func videoPresetArgs(codec string, encoder string, preset string) []string {
+	switch encoder {
+	case "libx264", "libx265":
+		if preset == "" {
+			preset = "medium"
+		}
+		return []string{"-preset", preset}
+	case "libsvtav1":
+		if preset == "" {
+			return []string{"-preset", "8"}
+		}
+		if n, err := strconv.Atoi(preset); err == nil {
+			return []string{"-preset", strconv.Itoa(n)}
+		}
+		return []string{"-preset", strconv.Itoa(svtAV1PresetFromName(preset))}
+	case "libaom-av1":
+		if preset == "" {
+			return []string{"-cpu-used", "6"}
+		}
+		if n, err := strconv.Atoi(preset); err == nil {
+			return []string{"-cpu-used", strconv.Itoa(min(n, 8))}
+		}
+		return []string{"-cpu-used", strconv.Itoa(min(svtAV1PresetFromName(preset), 8))}
+	case "libvpx-vp9":
+		switch preset {
+		case "":
+			return nil
+		case "realtime", "good", "best":
+			return []string{"-deadline", preset}
+		}
+		return []string{"-deadline", "good", "-cpu-used", strconv.Itoa(vp9CPUUsedFromName(preset))}
+	default:
+		return nil
+	}
+}
+
+func svtAV1PresetFromName(name string) int {
+	mapping := map[string]int{
+		"ultrafast": 12, "superfast": 11, "veryfast": 10, "faster": 9, "fast": 8,
+		"medium": 6, "slow": 4, "slower": 3, "veryslow": 2, "placebo": 1,
+	}
+	if v, ok := mapping[name]; ok {
+		return v
+	}
+	return 8
+}
+
+func vp9CPUUsedFromName(name string) int {
+	mapping := map[string]int{
+		"ultrafast": 5, "superfast": 5, "veryfast": 4, "faster": 4, "fast": 3,
+		"medium": 2, "slow": 1, "slower": 0, "veryslow": 0, "placebo": 0,
+	}
+	if v, ok := mapping[name]; ok {
+		return v
+	}
+	return 2
+}
+
+func validVideoPreset(codec string, preset string) bool {
+	if slices.Contains(x264Presets, preset) {
+		return true
+	}
+	switch codec {
+	case VideoCodecAV1:
+		n, err := strconv.Atoi(preset)
+		return err == nil && n >= 0 && n <= 13
+	case VideoCodecVP9:
+		return preset == "realtime" || preset == "good" || preset == "best"
+	}
+	return false
+}
+
+func videoPresetChoices(codec string) []string {
+	choices := slices.Clone(x264Presets)
+	switch codec {
+	case VideoCodecAV1:
+		choices = append(choices, "0-13")
+	case VideoCodecVP9:
+		choices = append(choices, "realtime", "good", "best")
+	}
+	return choices
+}
+
+// VideoAudioTrackArgs video kapsayıcısındaki ses izi için FFmpeg codec argümanlarını döner.
+// Video izine dokunmadan sadece sesi yeniden encode eden komutlar için kullanılır.
+func VideoAudioTrackArgs(to string, spec *VideoSpec) []string {
+	to = NormalizeFormat(to)
+	if spec.IsZero() {
+		return legacyVideoAudioArgs(to)
+	}
+	return videoTrackAudioArgs(to, spec)
+}
+
+func videoTrackAudioArgs(to string, spec *VideoSpec) []string {
+	switch spec.AudioCodec {
+	case "none":
+		return []string{"-an"}
+	case "copy":
+		return []string{"-c:a", "copy"}
+	case "":
+		// Ses codec'i verilmediyse kapsayıcının varsayılanı, yalnızca bitrate değişebilir.
+		args := legacyVideoAudioArgs(to)
+		if spec.AudioBitrate != "" {
+			for i := range args {
+				if args[i] == "-b:a" && i+1 < len(args) {
+					args[i+1] = spec.AudioBitrate
+				}
+			}
+			if !slices.Contains(args, "-b:a") {
+				args = append(args, "-b:a", spec.AudioBitrate)
+			}
+		}
+		return args
+	}
+
+	encoder := spec.AudioEncoder
+	if encoder == "" {
+		encoder = videoTrackAudioEncoders[spec.AudioCodec][0]
+	}
+	args := []string{"-c:a", encoder}
+	if encoder == "opus" || encoder == "vorbis" {
+		// FFmpeg'in yerleşik opus/vorbis encoder'ları deneyseldir.
+		args = append(args, "-strict", "-2")
+	}
+	if spec.AudioCodec == "flac" {
+		return args
+	}
+	bitrate := spec.AudioBitrate
+	if bitrate == "" {
+		bitrate = "128k"
+		if spec.AudioCodec == "mp3" || spec.AudioCodec == "ac3" {
+			bitrate = "192k"
+		}
+	}
+	return append(args, "-b:a", bitrate)
+}
+
+func defaultVideoCodec(to string) string {
+	switch to {
+	case "mp4", "m4v", "mov", "mkv":
+		return VideoCodecH264
+	case "webm":
+		return VideoCodecVP9
+	default:
+		return ""
+	}
+}
+
+func doubleBitrate(bitrate string) string {
+	kbps, err := parseBitrateKbps(bitrate)
+	if err != nil {
+		return bitrate
+	}
+	return fmt.Sprintf("%dk", kbps*2)
+}
+
+// legacyVideoCodecArgs codec ayarı verilmediğinde kullanılan tarihsel FFmpeg argümanları.
+func legacyVideoCodecArgs(to string, quality int) []string {
+	args := legacyVideoOnlyArgs(to, quality)
+	if to == "mp4" || to == "m4v" || to == "mov" {
+		args = append(args, "-movflags", "+faststart")
+	}
+	return append(args, legacyVideoAudioArgs(to)...)
+}
+
+func legacyVideoOnlyArgs(to string, quality int) []string {
+	crf := videoCRF(quality)
+	switch to {
+	case "webm":
+		webmCRF := crf + 6
+		if webmCRF > 40 {
+			webmCRF = 40
+		}
+		return []string{"-c:v", "libvpx-vp9", "-crf", strconv.Itoa(webmCRF), "-b:v", "0", "-row-mt", "1"}
+	case "avi":
+		return []string{"-c:v", "mpeg4", "-q:v", strconv.Itoa(videoQScale(quality))}
+	case "wmv":
+		return []string{"-c:v", "wmv2"}
+	case "flv":
+		return []string{"-c:v", "flv"}
+	default: // mp4, m4v, mov, mkv ve h264 uyumlu kapsayıcılar
+		return []string{"-c:v", "libx264", "-crf", strconv.Itoa(crf), "-preset", "medium", "-pix_fmt", "yuv420p"}
+	}
+}
+
+func legacyVideoAudioArgs(to string) []string {
+	switch to {
+	case "webm":
+		return []string{"-c:a", "libopus", "-b:a", "128k"}
+	case "avi":
+		return []string{"-c:a", "mp3", "-b:a", "192k"}
+	case "wmv":
+		return []string{"-c:a", "wmav2"}
+	case "flv":
+		return []string{"-c:a", "mp3", "-ar", "44100"}
+	default:
+		return []string{"-c:a", "aac", "-b:a", "128k"}
+	}
+}
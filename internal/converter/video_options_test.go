@@ -0,0 +1,147 @@
+package converter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBuildVideoSpec(t *testing.T) {
+	spec, err := BuildVideoSpec("", -1, "", "", "", "", "")
+	if err != nil || spec != nil {
+		t.Fatalf("expected nil spec without options, got %#v err=%v", spec, err)
+	}
+
+	spec, err = BuildVideoSpec("HEVC", 24, "4M", "Slow", "", "libopus", "96000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Codec != VideoCodecH265 || spec.CRF == nil || *spec.CRF != 24 {
+		t.Fatalf("unexpected codec/crf: %#v", spec)
+	}
+	if spec.Bitrate != "4000k" || spec.Preset != "slow" || spec.AudioCodec != "opus" || spec.AudioBitrate != "96k" {
+		t.Fatalf("unexpected normalized values: %#v", spec)
+	}
+
+	if _, err := BuildVideoSpec("mpeg2", -1, "", "", "", "", ""); err == nil {
+		t.Fatalf("expected error for unknown video codec")
+	}
+	if _, err := BuildVideoSpec("", -1, "", "", "", "dts", ""); err == nil {
+		t.Fatalf("expected error for unknown audio codec")
+	}
+}
+
+func TestValidateVideoSpec(t *testing.T) {
+	crf := func(v int) *int { return &v }
+	tests := []struct {
+		name    string
+		spec    VideoSpec
+		target  string
+		wantErr string
+	}{
+		{name: "h265 mp4", spec: VideoSpec{Codec: VideoCodecH265, CRF: crf(28)}, target: "mp4"},
+		{name: "av1 webm", spec: VideoSpec{Codec: VideoCodecAV1, CRF: crf(40), AudioCodec: "opus"}, target: "webm"},
+		{name: "h264 webm", spec: VideoSpec{Codec: VideoCodecH264}, target: "webm", wantErr: "desteklemez"},
+		{name: "crf range", spec: VideoSpec{Codec: VideoCodecH264, CRF: crf(60)}, target: "mp4", wantErr: "0-51"},
+		{name: "copy with crf", spec: VideoSpec{Codec: VideoCodecCopy, CRF: crf(20)}, target: "mkv", wantErr: "copy"},
+		{name: "bad preset", spec: VideoSpec{Codec: VideoCodecH264, Preset: "turbo"}, target: "mp4", wantErr: "preset"},
+		{name: "svt numeric preset", spec: VideoSpec{Codec: VideoCodecAV1, Preset: "6"}, target: "mkv"},
+		{name: "aac in webm", spec: VideoSpec{AudioCodec: "aac"}, target: "webm", wantErr: "ses codec"},
+		{name: "bad pix fmt", spec: VideoSpec{PixFmt: "rgb565"}, target: "mp4", wantErr: "piksel"},
+		{name: "wmv crf", spec: VideoSpec{CRF: crf(20)}, target: "wmv", wantErr: "--video-codec"},
+		{name: "gif target", spec: VideoSpec{Codec: VideoCodecH264}, target: "gif", wantErr: "video çıktılarında"},
+	}
+
+	for _, tt := range tests {
+		spec := tt.spec
+		err := ValidateVideoSpec(&spec, tt.target)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("%s: expected error containing %q, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestParseFFmpegEncoders(t *testing.T) {
+	output := `Encoders:
+ V..... = Video
+ A..... = Audio
+ ------
+ V....D libx264              libx264 H.264 / AVC / MPEG-4 AVC (codec h264)
+ V....D libvpx-vp9           libvpx VP9 (codec vp9)
+ A....D aac                  AAC (Advanced Audio Coding)
+`
+	encoders := parseFFmpegEncoders(output)
+	for _, name := range []string{"libx264", "libvpx-vp9", "aac"} {
+		if !encoders[name] {
+			t.Fatalf("expected %s to be parsed, got %v", name, encoders)
+		}
+	}
+	if encoders["="] || encoders["Video"] {
+		t.Fatalf("legend lines must not be parsed as encoders: %v", encoders)
+	}
+}
+
+func TestResolveVideoSpecEncodersFallback(t *testing.T) {
+	available := map[string]bool{"libaom-av1": true, "libx264": true, "opus": true}
+
+	spec := &VideoSpec{Codec: VideoCodecAV1, AudioCodec: "opus"}
+	notes, err := resolveVideoSpecEncoders(spec, "mkv", available)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Encoder != "libaom-av1" || spec.AudioEncoder != "opus" {
+		t.Fatalf("expected fallback encoders, got %s / %s", spec.Encoder, spec.AudioEncoder)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("expected fallback notes, got %v", notes)
+	}
+
+	_, err = resolveVideoSpecEncoders(&VideoSpec{Codec: VideoCodecH265}, "mp4", available)
+	if err == nil || !strings.Contains(err.Error(), "kullanılabilir: av1, h264") {
+		t.Fatalf("expected missing encoder error with alternatives, got %v", err)
+	}
+}
+
+func TestVideoEncodeArgsLegacyDefaults(t *testing.T) {
+	got := VideoEncodeArgs("mp4", 0, nil)
+	want := []string{
+		"-c:v", "libx264", "-crf", "23", "-preset", "medium", "-pix_fmt", "yuv420p",
+		"-movflags", "+faststart", "-c:a", "aac", "-b:a", "128k",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected default mp4 args: %v", got)
+	}
+}
+
+func TestVideoEncodeArgsWithSpec(t *testing.T) {
+	crf := 26
+	got := VideoEncodeArgs("mp4", 0, &VideoSpec{Codec: VideoCodecH265, CRF: &crf, Preset: "slow", Bitrate: "3000k"})
+	want := []string{
+		"-c:v", "libx265", "-crf", "26", "-maxrate", "3000k", "-bufsize", "6000k",
+		"-preset", "slow", "-tag:v", "hvc1", "-pix_fmt", "yuv420p",
+		"-movflags", "+faststart", "-c:a", "aac", "-b:a", "128k",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected h265 args: %v", got)
+	}
+
+	got = VideoEncodeArgs("webm", 0, &VideoSpec{Codec: VideoCodecAV1, Encoder: "libsvtav1", Preset: "fast", AudioCodec: "opus", AudioBitrate: "96k"})
+	want = []string{
+		"-c:v", "libsvtav1", "-crf", "31", "-preset", "8", "-pix_fmt", "yuv420p",
+		"-c:a", "libopus", "-b:a", "96k",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected av1 args: %v", got)
+	}
+
+	got = VideoEncodeArgs("mkv", 0, &VideoSpec{Codec: VideoCodecCopy, AudioCodec: "none"})
+	if !slices.Equal(got, []string{"-c:v", "copy", "-an"}) {
+		t.Fatalf("unexpected copy args: %v", got)
+	}
+}
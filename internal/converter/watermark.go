@@ -0,0 +1,334 @@
+package converter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Watermark burn modları: inceleme kopyalarına zaman kodu ve/veya dosya adı yakılır.
+const (
+	WatermarkBurnTimecode = "timecode"
+	WatermarkBurnFilename = "filename"
+	WatermarkBurnBoth     = "both"
+)
+
+const (
+	defaultWatermarkPosition     = "bottom-right"
+	defaultWatermarkBurnPosition = "top-left"
+	defaultWatermarkMargin       = 24
+	defaultWatermarkScale        = 0.15
+	defaultWatermarkFontColor    = "white"
+)
+
+// watermarkPositions desteklenen konum anchor'ları.
+var watermarkPositions = []string{
+	"top-left", "top", "top-right",
+	"left", "center", "right",
+	"bottom-left", "bottom", "bottom-right",
+}
+
+// WatermarkSpec video üzerine logo, yazı ve zaman kodu/dosya adı yakma ayarlarını tutar.
+// Sıfır değerler varsayılanı ifade eder (sağ alt, 24px kenar boşluğu, tam opak, genişliğin %15'i).
+type WatermarkSpec struct {
+	// Image bindirilecek logo dosyası (PNG önerilir, alfa kanalı korunur)
+	Image string `json:"image,omitempty"`
+	// Text bindirilecek sabit yazı
+	Text string `json:"text,omitempty"`
+	// Burn: timecode, filename veya both
+	Burn string `json:"burn,omitempty"`
+	// Position logo/yazı anchor'ı: top-left, top, top-right, left, center, right, bottom-left, bottom, bottom-right
+	Position string `json:"position,omitempty"`
+	// BurnPosition zaman kodu/dosya adı anchor'ı (varsayılan: top-left)
+	BurnPosition string `json:"burn_position,omitempty"`
+	// Margin kenardan piksel cinsinden boşluk
+	Margin int `json:"margin,omitempty"`
+	// Opacity 0-1 arası saydamlık (0 = varsayılan, tam opak)
+	Opacity float64 `json:"opacity,omitempty"`
+	// Scale logo genişliğinin video genişliğine oranı (0-1)
+	Scale float64 `json:"scale,omitempty"`
+	// FontSize yazı boyutu (0 = video yüksekliğine göre otomatik)
+	FontSize  int    `json:"font_size,omitempty"`
+	FontColor string `json:"font_color,omitempty"`
+	// Start/End saniye cinsinden görünme aralığı (End 0 ise video sonuna kadar)
+	Start float64 `json:"start,omitempty"`
+	End   float64 `json:"end,omitempty"`
+}
+
+// IsZero spec'in hiçbir bindirme içermediğini döner.
+func (s *WatermarkSpec) IsZero() bool {
+	return s == nil || (strings.TrimSpace(s.Image) == "" && strings.TrimSpace(s.Text) == "" && strings.TrimSpace(s.Burn) == "")
+}
+
+// NormalizeWatermarkPosition anchor adını kanonik forma çevirir; geçersizse boş döner.
+func NormalizeWatermarkPosition(position string) string {
+	p := strings.ToLower(strings.TrimSpace(position))
+	p = strings.ReplaceAll(p, "_", "-")
+	switch p {
+	case "tl":
+		return "top-left"
+	case "tr":
+		return "top-right"
+	case "bl":
+		return "bottom-left"
+	case "br":
+		return "bottom-right"
+	case "top-center":
+		return "top"
+	case "bottom-center":
+		return "bottom"
+	case "middle":
+		return "center"
+	}
+	for _, known := range watermarkPositions {
+		if p == known {
+			return p
+		}
+	}
+	return ""
+}
+
+// NormalizeWatermarkBurn burn modunu doğrular; boş değer burn yok anlamına gelir.
+func NormalizeWatermarkBurn(burn string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(burn)) {
+	case "", "none", "off":
+		return "", nil
+	case "timecode", "tc", "time":
+		return WatermarkBurnTimecode, nil
+	case "filename", "name", "file":
+		return WatermarkBurnFilename, nil
+	case "both", "all":
+		return WatermarkBurnBoth, nil
+	default:
+		return "", fmt.Errorf("geçersiz burn modu: %s (timecode, filename, both)", burn)
+	}
+}
+
+// ValidateWatermarkSpec değerleri doğrular, anchor ve burn adlarını kanonik forma çeker.
+func ValidateWatermarkSpec(spec *WatermarkSpec) error {
+	if spec.IsZero() {
+		return fmt.Errorf("watermark için logo, yazı veya burn modu gerekli")
+	}
+	if image := strings.TrimSpace(spec.Image); image != "" {
+		if _, err := os.Stat(image); err != nil {
+			return fmt.Errorf("watermark logosu bulunamadı: %s", image)
+		}
+	}
+	if spec.Position != "" {
+		p := NormalizeWatermarkPosition(spec.Position)
+		if p == "" {
+			return fmt.Errorf("geçersiz konum: %s (desteklenen: %s)", spec.Position, strings.Join(watermarkPositions, ", "))
+		}
+		spec.Position = p
+	}
+	if spec.BurnPosition != "" {
+		p := NormalizeWatermarkPosition(spec.BurnPosition)
+		if p == "" {
+			return fmt.Errorf("geçersiz burn konumu: %s (desteklenen: %s)", spec.BurnPosition, strings.Join(watermarkPositions, ", "))
+		}
+		spec.BurnPosition = p
+	}
+	burn, err := NormalizeWatermarkBurn(spec.Burn)
+	if err != nil {
+		return err
+	}
+	spec.Burn = burn
+	if spec.Margin < 0 {
+		return fmt.Errorf("kenar boşluğu negatif olamaz")
+	}
+	if spec.Opacity < 0 || spec.Opacity > 1 {
+		return fmt.Errorf("opaklık 0 ile 1 arasında olmalı")
+	}
+	if spec.Scale < 0 || spec.Scale > 1 {
+		return fmt.Errorf("ölçek 0 ile 1 arasında olmalı (video genişliğine oran)")
+	}
+	if spec.FontSize < 0 {
+		return fmt.Errorf("yazı boyutu negatif olamaz")
+	}
+	if spec.Start < 0 || spec.End < 0 {
+		return fmt.Errorf("görünme aralığı negatif olamaz")
+	}
+	if spec.End > 0 && spec.End <= spec.Start {
+		return fmt.Errorf("görünme bitişi başlangıçtan büyük olmalı")
+	}
+	return nil
+}
+
+// BuildWatermarkFilterGraph watermark'ı [0:v:0] üzerine uygulayan filter_complex metnini üretir.
+// baseFilter (ör. resize) bindirmeden önce uygulanır. Logo varsa ikinci girdi ([1:v]) olarak beklenir.
+// Çıkış etiketi [vout] olur.
+func BuildWatermarkFilterGraph(spec WatermarkSpec, inputName string, baseFilter string) string {
+	margin := spec.Margin
+	if margin == 0 {
+		margin = defaultWatermarkMargin
+	}
+	opacity := spec.Opacity
+	if opacity == 0 {
+		opacity = 1
+	}
+	enable := watermarkEnableExpr(spec.Start, spec.End)
+
+	if baseFilter == "" {
+		baseFilter = "null"
+	}
+	chains := []string{fmt.Sprintf("[0:v:0]%s[base]", baseFilter)}
+	current := "base"
+
+	if strings.TrimSpace(spec.Image) != "" {
+		scale := spec.Scale
+		if scale == 0 {
+			scale = defaultWatermarkScale
+		}
+		chains = append(chains,
+			fmt.Sprintf("[1:v]format=rgba,colorchannelmixer=aa=%s[wmsrc]", formatWatermarkFloat(opacity)),
+			fmt.Sprintf("[wmsrc][%s]scale2ref=w=main_w*%s:h=-1[wm][wmbase]", current, formatWatermarkFloat(scale)),
+		)
+		x, y := watermarkPositionExpr(watermarkPositionOrDefault(spec.Position, defaultWatermarkPosition), margin, "main_w", "main_h", "overlay_w", "overlay_h")
+		overlay := fmt.Sprintf("[wmbase][wm]overlay=x=%s:y=%s", x, y)
+		if enable != "" {
+			overlay += ":enable='" + enable + "'"
+		}
+		chains = append(chains, overlay+"[wmout]")
+		current = "wmout"
+	}
+
+	drawtexts := make([]string, 0, 2)
+	if text := strings.TrimSpace(spec.Text); text != "" {
+		drawtexts = append(drawtexts, watermarkDrawtext(escapeDrawtextValue(text), spec, watermarkPositionOrDefault(spec.Position, defaultWatermarkPosition), margin, opacity, enable, false))
+	}
+	if burnText := watermarkBurnText(spec.Burn, inputName); burnText != "" {
+		// Burn inceleme kopyası içindir; tüm video boyunca ve kutulu görünür.
+		drawtexts = append(drawtexts, watermarkDrawtext(burnText, spec, watermarkPositionOrDefault(spec.BurnPosition, defaultWatermarkBurnPosition), margin, 1, "", true))
+	}
+	if len(drawtexts) > 0 {
+		chains = append(chains, fmt.Sprintf("[%s]%s[txtout]", current, strings.Join(drawtexts, ",")))
+		current = "txtout"
+	}
+
+	// Son etiketi [vout] olarak yeniden adlandır.
+	last := chains[len(chains)-1]
+	chains[len(chains)-1] = strings.TrimSuffix(last, "["+current+"]") + "[vout]"
+	return strings.Join(chains, ";")
+}
+
+func watermarkDrawtext(text string, spec WatermarkSpec, position string, margin int, opacity float64, enable string, boxed bool) string {
+	fontSize := "h/24"
+	if spec.FontSize > 0 {
+		fontSize = strconv.Itoa(spec.FontSize)
+	}
+	color := strings.TrimSpace(spec.FontColor)
+	if color == "" {
+		color = defaultWatermarkFontColor
+	}
+	x, y := watermarkPositionExpr(position, margin, "w", "h", "tw", "th")
+	parts := []string{
+		"drawtext=text='" + text + "'",
+		"fontsize=" + fontSize,
+		fmt.Sprintf("fontcolor=%s@%s", color, formatWatermarkFloat(opacity)),
+		"x=" + x,
+		"y=" + y,
+	}
+	if boxed {
+		parts = append(parts, "box=1", "boxcolor=black@0.6", "boxborderw=6")
+	} else {
+		parts = append(parts, "shadowcolor=black@0.5", "shadowx=2", "shadowy=2")
+	}
+	if enable != "" {
+		parts = append(parts, "enable='"+enable+"'")
+	}
+	return strings.Join(parts, ":")
+}
+
+// watermarkBurnText burn moduna göre drawtext metnini (kaçışlı) üretir.
+func watermarkBurnText(burn string, inputName string) string {
+	name := escapeDrawtextValue(filepath.Base(inputName))
+	timecode := `%{pts\:hms}`
+	switch burn {
+	case WatermarkBurnTimecode:
+		return timecode
+	case WatermarkBurnFilename:
+		return name
+	case WatermarkBurnBoth:
+		return name + "  " + timecode
+	default:
+		return ""
+	}
+}
+
+// watermarkPositionExpr anchor için x/y ifadelerini üretir.
+// outer* bindirilen alanın, inner* bindirilen öğenin boyut değişkenleridir.
+func watermarkPositionExpr(position string, margin int, outerW string, outerH string, innerW string, innerH string) (string, string) {
+	m := strconv.Itoa(margin)
+	left := m
+	centerX := fmt.Sprintf("(%s-%s)/2", outerW, innerW)
+	right := fmt.Sprintf("%s-%s-%s", outerW, innerW, m)
+	top := m
+	centerY := fmt.Sprintf("(%s-%s)/2", outerH, innerH)
+	bottom := fmt.Sprintf("%s-%s-%s", outerH, innerH, m)
+
+	switch position {
+	case "top-left":
+		return left, top
+	case "top":
+		return centerX, top
+	case "top-right":
+		return right, top
+	case "left":
+		return left, centerY
+	case "center":
+		return centerX, centerY
+	case "right":
+		return right, centerY
+	case "bottom-left":
+		return left, bottom
+	case "bottom":
+		return centerX, bottom
+	default:
+		return right, bottom
+	}
+}
+
+func watermarkPositionOrDefault(position string, fallback string) string {
+	if p := NormalizeWatermarkPosition(position); p != "" {
+		return p
+	}
+	return fallback
+}
+
+// watermarkEnableExpr görünme aralığı için enable ifadesini üretir; aralık yoksa boş döner.
+func watermarkEnableExpr(start float64, end float64) string {
+	switch {
+	case end > 0:
+		return fmt.Sprintf("between(t,%s,%s)", formatWatermarkFloat(start), formatWatermarkFloat(end))
+	case start > 0:
+		return fmt.Sprintf("gte(t,%s)", formatWatermarkFloat(start))
+	default:
+		return ""
+	}
+}
+
+// escapeDrawtextValue drawtext text değerindeki özel karakterleri kaçışlar.
+// Tek tırnak filtre seviyesinde kaçışlanamadığı için tipografik kesme işaretine çevrilir.
+func escapeDrawtextValue(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`'`, "’",
+		`:`, `\:`,
+		`%`, `\%`,
+	)
+	return replacer.Replace(value)
+}
+
+func formatWatermarkFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// watermarkInputArgs logo varsa ikinci girdi argümanlarını döner.
+func watermarkInputArgs(spec *WatermarkSpec) []string {
+	if spec.IsZero() || strings.TrimSpace(spec.Image) == "" {
+		return nil
+	}
+	return []string{"-i", spec.Image}
+}
@@ -0,0 +1,120 @@
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeWatermarkPosition(t *testing.T) {
+	cases := map[string]string{
+		"bottom-right":  "bottom-right",
+		"TL":            "top-left",
+		"top_center":    "top",
+		"bottom-center": "bottom",
+		"middle":        "center",
+		"nowhere":       "",
+	}
+	for in, want := range cases {
+		if got := NormalizeWatermarkPosition(in); got != want {
+			t.Fatalf("NormalizeWatermarkPosition(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestValidateWatermarkSpec(t *testing.T) {
+	logo := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logo, []byte("png"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	spec := &WatermarkSpec{Image: logo, Position: "tr", Burn: "tc", Opacity: 0.5}
+	if err := ValidateWatermarkSpec(spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spec.Position != "top-right" || spec.Burn != WatermarkBurnTimecode {
+		t.Fatalf("spec not normalized: %+v", spec)
+	}
+
+	invalid := []*WatermarkSpec{
+		{},
+		{Image: filepath.Join(t.TempDir(), "missing.png")},
+		{Text: "x", Position: "nowhere"},
+		{Text: "x", Opacity: 1.5},
+		{Text: "x", Scale: 2},
+		{Text: "x", Start: 10, End: 5},
+		{Burn: "subtitles"},
+	}
+	for _, s := range invalid {
+		if err := ValidateWatermarkSpec(s); err == nil {
+			t.Fatalf("expected error for %+v", s)
+		}
+	}
+}
+
+func TestBuildWatermarkFilterGraphLogo(t *testing.T) {
+	spec := WatermarkSpec{Image: "logo.png", Position: "top-right", Margin: 10, Opacity: 0.6, Scale: 0.2, Start: 2, End: 8}
+	graph := BuildWatermarkFilterGraph(spec, "clip.mp4", "scale=1280:720")
+	for _, want := range []string{
+		"[0:v:0]scale=1280:720[base]",
+		"[1:v]format=rgba,colorchannelmixer=aa=0.6[wmsrc]",
+		"scale2ref=w=main_w*0.2:h=-1",
+		"overlay=x=main_w-overlay_w-10:y=10:enable='between(t,2,8)'[vout]",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Fatalf("graph missing %q: %s", want, graph)
+		}
+	}
+}
+
+func TestBuildWatermarkFilterGraphTextAndBurn(t *testing.T) {
+	spec := WatermarkSpec{Text: "Stüdyo: 100%", Position: "bottom-left", Burn: WatermarkBurnBoth, FontSize: 32}
+	graph := BuildWatermarkFilterGraph(spec, "/tmp/review.mp4", "")
+	for _, want := range []string{
+		"[0:v:0]null[base]",
+		`drawtext=text='Stüdyo\: 100\%'`,
+		"fontsize=32",
+		"x=24:y=h-th-24",
+		`text='review.mp4  %{pts\:hms}'`,
+		"box=1",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Fatalf("graph missing %q: %s", want, graph)
+		}
+	}
+	if !strings.HasSuffix(graph, "[vout]") {
+		t.Fatalf("graph should end with [vout]: %s", graph)
+	}
+	if strings.Contains(graph, "[1:v]") {
+		t.Fatalf("text-only graph should not reference a logo input: %s", graph)
+	}
+}
+
+func TestWatermarkEnableExpr(t *testing.T) {
+	if got := watermarkEnableExpr(0, 0); got != "" {
+		t.Fatalf("expected empty enable, got %q", got)
+	}
+	if got := watermarkEnableExpr(3.5, 0); got != "gte(t,3.5)" {
+		t.Fatalf("unexpected enable: %q", got)
+	}
+}
+
+func TestVideoFilterArgsWithWatermark(t *testing.T) {
+	args, videoMap, err := videoFilterArgs("in.mp4", Options{Watermark: &WatermarkSpec{Image: "logo.png"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if videoMap != "[vout]" {
+		t.Fatalf("expected [vout] map, got %s", videoMap)
+	}
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "-i logo.png -filter_complex") {
+		t.Fatalf("expected logo input before filter_complex, got %v", args)
+	}
+
+	_, videoMap, err = videoFilterArgs("in.mp4", Options{})
+	if err != nil || videoMap != "0:v:0" {
+		t.Fatalf("expected default map without watermark, got %s %v", videoMap, err)
+	}
+}
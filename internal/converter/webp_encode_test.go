@@ -114,3 +114,51 @@ func TestWebPInSupportedWriteFormats(t *testing.T) {
 		t.Fatal("webp should be in supported target formats")
 	}
 }
+
+func TestImageConvertMultiSharesDecode(t *testing.T) {
+	dir := t.TempDir()
+	inputPath := createTestPNG(t, dir, 40, 20)
+	jpgPath := filepath.Join(dir, "out.jpg")
+	webpPath := filepath.Join(dir, "out.webp")
+
+	ic := &ImageConverter{}
+	errs := ic.ConvertMulti(inputPath, []OutputTarget{
+		{Path: jpgPath, Options: Options{Quality: 70}},
+		{Path: webpPath, Options: Options{Resize: &ResizeSpec{Width: 10, Height: 10, Mode: ResizeModeStretch}}},
+	})
+	if len(errs) != 2 || errs[0] != nil || errs[1] != nil {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	for _, p := range []string{jpgPath, webpPath} {
+		if info, err := os.Stat(p); err != nil || info.Size() == 0 {
+			t.Fatalf("expected non-empty output %s: %v", p, err)
+		}
+	}
+
+	f, err := os.Open(webpPath)
+	if err != nil {
+		t.Fatalf("open webp failed: %v", err)
+	}
+	defer f.Close()
+	cfg, _, err := image.DecodeConfig(f)
+	if err == nil && (cfg.Width != 10 || cfg.Height != 10) {
+		t.Fatalf("expected resized webp 10x10, got %dx%d", cfg.Width, cfg.Height)
+	}
+}
+
+func TestImageConvertMultiDecodeErrorFailsAll(t *testing.T) {
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "broken.png")
+	if err := os.WriteFile(inputPath, []byte("not a png"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	ic := &ImageConverter{}
+	errs := ic.ConvertMulti(inputPath, []OutputTarget{
+		{Path: filepath.Join(dir, "a.jpg")},
+		{Path: filepath.Join(dir, "a.webp")},
+	})
+	if len(errs) != 2 || errs[0] == nil || errs[1] == nil {
+		t.Fatalf("expected decode error for every output, got %v", errs)
+	}
+}
@@ -0,0 +1,550 @@
+// Package filter batch, watch, interaktif mod ve pipeline'ların ortak kullandığı
+// dosya seçim kurallarını (glob, regex, boyut, tarih, medya özellikleri, gizli dosya,
+// .fileconverterignore ve sembolik bağlantı politikası) uygular.
+package filter
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// Sembolik bağlantı politikaları.
+const (
+	// SymlinksSkip tüm sembolik bağlantıları yok sayar.
+	SymlinksSkip = "skip"
+	// SymlinksFiles dosyaya işaret eden bağlantıları alır, bağlı dizinlere girmez (varsayılan).
+	SymlinksFiles = "files"
+	// SymlinksFollow bağlı dizinlere de girer; döngüler bir kez ziyaret edilerek engellenir.
+	SymlinksFollow = "follow"
+)
+
+// Spec dosya seçim kurallarının ham (kullanıcıdan gelen) halidir.
+// Boş alanlar kısıt uygulamaz.
+type Spec struct {
+	Include        []string `json:"include,omitempty"`
+	Exclude        []string `json:"exclude,omitempty"`
+	Regex          string   `json:"regex,omitempty"`
+	ExcludeRegex   string   `json:"exclude_regex,omitempty"`
+	MinSize        string   `json:"min_size,omitempty"`
+	MaxSize        string   `json:"max_size,omitempty"`
+	ModifiedSince  string   `json:"modified_since,omitempty"`
+	ModifiedBefore string   `json:"modified_before,omitempty"`
+	MinWidth       int      `json:"min_width,omitempty"`
+	MaxWidth       int      `json:"max_width,omitempty"`
+	MinHeight      int      `json:"min_height,omitempty"`
+	MaxHeight      int      `json:"max_height,omitempty"`
+	MinDuration    string   `json:"min_duration,omitempty"`
+	MaxDuration    string   `json:"max_duration,omitempty"`
+	Hidden         bool     `json:"hidden,omitempty"`
+	NoIgnore       bool     `json:"no_ignore,omitempty"`
+	Symlinks       string   `json:"symlinks,omitempty"`
+}
+
+// MediaInfo boyut ve süre filtrelerinin ihtiyaç duyduğu probe sonucu.
+type MediaInfo struct {
+	Width    int
+	Height   int
+	Duration float64
+}
+
+// ProbeFunc bir dosyanın görüntü boyutunu ve medya süresini okur.
+type ProbeFunc func(path string) (MediaInfo, error)
+
+// WalkFunc seçilen her dosya için çağrılır; rel tarama köküne göre slash ile ayrılmış yoldur.
+type WalkFunc func(path, rel string, info os.FileInfo) error
+
+// Filter derlenmiş dosya seçim kurallarıdır. nil Filter varsayılan kuralları uygular:
+// gizli dosyalar atlanır, .fileconverterignore okunur, bağlı dizinlere girilmez.
+type Filter struct {
+	include        []string
+	exclude        []string
+	regex          *regexp.Regexp
+	excludeRegex   *regexp.Regexp
+	minSize        int64
+	maxSize        int64
+	modifiedSince  time.Time
+	modifiedBefore time.Time
+	minWidth       int
+	maxWidth       int
+	minHeight      int
+	maxHeight      int
+	minDuration    float64
+	maxDuration    float64
+	hidden         bool
+	noIgnore       bool
+	symlinks       string
+
+	probe ProbeFunc
+}
+
+// Compile kuralları doğrular ve derler. Göreli tarihler ("7d", "12h") şu ana göre çözülür.
+func Compile(spec Spec) (*Filter, error) {
+	return compile(spec, time.Now())
+}
+
+func compile(spec Spec, now time.Time) (*Filter, error) {
+	f := &Filter{
+		minWidth:  spec.MinWidth,
+		maxWidth:  spec.MaxWidth,
+		minHeight: spec.MinHeight,
+		maxHeight: spec.MaxHeight,
+		hidden:    spec.Hidden,
+		noIgnore:  spec.NoIgnore,
+		probe:     defaultProbe,
+	}
+
+	for _, pattern := range spec.Include {
+		if strings.TrimSpace(pattern) == "" {
+			continue
+		}
+		if err := ValidateGlob(pattern); err != nil {
+			return nil, err
+		}
+		f.include = append(f.include, strings.TrimSpace(pattern))
+	}
+	for _, pattern := range spec.Exclude {
+		if strings.TrimSpace(pattern) == "" {
+			continue
+		}
+		if err := ValidateGlob(pattern); err != nil {
+			return nil, err
+		}
+		f.exclude = append(f.exclude, strings.TrimSpace(pattern))
+	}
+
+	var err error
+	if strings.TrimSpace(spec.Regex) != "" {
+		if f.regex, err = regexp.Compile(spec.Regex); err != nil {
+			return nil, fmt.Errorf("geçersiz regex: %w", err)
+		}
+	}
+	if strings.TrimSpace(spec.ExcludeRegex) != "" {
+		if f.excludeRegex, err = regexp.Compile(spec.ExcludeRegex); err != nil {
+			return nil, fmt.Errorf("geçersiz dışlama regex'i: %w", err)
+		}
+	}
+
+	if f.minSize, err = parseOptionalSize(spec.MinSize, "minimum boyut"); err != nil {
+		return nil, err
+	}
+	if f.maxSize, err = parseOptionalSize(spec.MaxSize, "maksimum boyut"); err != nil {
+		return nil, err
+	}
+	if f.minSize > 0 && f.maxSize > 0 && f.minSize > f.maxSize {
+		return nil, fmt.Errorf("minimum boyut maksimum boyuttan büyük olamaz")
+	}
+
+	if f.modifiedSince, err = parseOptionalTime(spec.ModifiedSince, now); err != nil {
+		return nil, fmt.Errorf("geçersiz modified-since: %w", err)
+	}
+	if f.modifiedBefore, err = parseOptionalTime(spec.ModifiedBefore, now); err != nil {
+		return nil, fmt.Errorf("geçersiz modified-before: %w", err)
+	}
+	if !f.modifiedSince.IsZero() && !f.modifiedBefore.IsZero() && !f.modifiedSince.Before(f.modifiedBefore) {
+		return nil, fmt.Errorf("modified-since tarihi modified-before tarihinden önce olmalı")
+	}
+
+	for name, value := range map[string]int{"min-width": f.minWidth, "max-width": f.maxWidth, "min-height": f.minHeight, "max-height": f.maxHeight} {
+		if value < 0 {
+			return nil, fmt.Errorf("%s negatif olamaz", name)
+		}
+	}
+	if f.minWidth > 0 && f.maxWidth > 0 && f.minWidth > f.maxWidth {
+		return nil, fmt.Errorf("min-width max-width değerinden büyük olamaz")
+	}
+	if f.minHeight > 0 && f.maxHeight > 0 && f.minHeight > f.maxHeight {
+		return nil, fmt.Errorf("min-height max-height değerinden büyük olamaz")
+	}
+
+	if f.minDuration, err = parseOptionalSeconds(spec.MinDuration); err != nil {
+		return nil, fmt.Errorf("geçersiz minimum süre: %w", err)
+	}
+	if f.maxDuration, err = parseOptionalSeconds(spec.MaxDuration); err != nil {
+		return nil, fmt.Errorf("geçersiz maksimum süre: %w", err)
+	}
+	if f.minDuration > 0 && f.maxDuration > 0 && f.minDuration > f.maxDuration {
+		return nil, fmt.Errorf("minimum süre maksimum süreden büyük olamaz")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(spec.Symlinks)) {
+	case "", SymlinksFiles:
+		f.symlinks = SymlinksFiles
+	case SymlinksSkip:
+		f.symlinks = SymlinksSkip
+	case SymlinksFollow:
+		f.symlinks = SymlinksFollow
+	default:
+		return nil, fmt.Errorf("geçersiz symlink politikası: %s (geçerli: skip, files, follow)", spec.Symlinks)
+	}
+	return f, nil
+}
+
+// SetProbe boyut/süre filtrelerinde kullanılacak probe fonksiyonunu değiştirir.
+func (f *Filter) SetProbe(probe ProbeFunc) {
+	if f != nil && probe != nil {
+		f.probe = probe
+	}
+}
+
+// NeedsProbe dosya içeriğinin okunmasını gerektiren (boyut/süre) bir kural olup olmadığını döner.
+func (f *Filter) NeedsProbe() bool {
+	if f == nil {
+		return false
+	}
+	return f.minWidth > 0 || f.maxWidth > 0 || f.minHeight > 0 || f.maxHeight > 0 ||
+		f.minDuration > 0 || f.maxDuration > 0
+}
+
+// Walk root altındaki dosyaları kurallara göre gezer. Gizli girdiler, ignore dosyasıyla
+// dışlananlar ve exclude desenine uyan dizinler budanır; dosyalar MatchFile ile süzülür.
+// Boyut/süre kuralları burada uygulanmaz, gerekirse MatchMedia ayrıca çağrılır.
+func (f *Filter) Walk(root string, recursive bool, fn WalkFunc) error {
+	if f == nil {
+		f = Default()
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("dizin değil: %s", root)
+	}
+
+	visited := map[string]struct{}{}
+	if real, err := filepath.EvalSymlinks(root); err == nil {
+		visited[real] = struct{}{}
+	}
+	return f.walkDir(root, "", recursive, nil, visited, fn)
+}
+
+func (f *Filter) walkDir(dir, rel string, recursive bool, rules []ignoreRule, visited map[string]struct{}, fn WalkFunc) error {
+	if !f.noIgnore {
+		local, err := parseIgnoreFile(dir, rel)
+		if err == nil && len(local) > 0 {
+			rules = append(rules[:len(rules):len(rules)], local...)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if rel == "" {
+			return err
+		}
+		return nil // Erişilemeyen alt dizinleri atla
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if f.skipName(name) {
+			continue
+		}
+		entryPath := filepath.Join(dir, name)
+		entryRel := path.Join(rel, name)
+
+		isDir := entry.IsDir()
+		var info os.FileInfo
+		if entry.Type()&fs.ModeSymlink != 0 {
+			if f.symlinks == SymlinksSkip {
+				continue
+			}
+			target, err := os.Stat(entryPath)
+			if err != nil {
+				continue // Kırık bağlantı
+			}
+			info = target
+			isDir = target.IsDir()
+			if isDir && f.symlinks != SymlinksFollow {
+				continue
+			}
+		}
+
+		if ignored(rules, entryRel, isDir) {
+			continue
+		}
+
+		if isDir {
+			if !recursive || f.excluded(entryRel) {
+				continue
+			}
+			if real, err := filepath.EvalSymlinks(entryPath); err == nil {
+				if _, seen := visited[real]; seen {
+					continue
+				}
+				visited[real] = struct{}{}
+			}
+			if err := f.walkDir(entryPath, entryRel, recursive, rules, visited, fn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if info == nil {
+			if info, err = entry.Info(); err != nil {
+				continue
+			}
+		}
+		if !info.Mode().IsRegular() || !f.MatchFile(entryPath, entryRel, info) {
+			continue
+		}
+		if err := fn(entryPath, entryRel, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipName gizli girdileri ve ignore dosyasının kendisini eler.
+func (f *Filter) skipName(name string) bool {
+	return name == IgnoreFileName || (!f.hidden && strings.HasPrefix(name, "."))
+}
+
+// Ignored root altındaki rel yolunun Walk tarafından gizli olduğu ya da bir
+// .fileconverterignore kuralına uyduğu için atlanıp atlanmayacağını döner. Kök ile
+// rel'in dizinleri arasındaki ignore dosyaları Walk'taki sırayla uygulanır. Dizinleri
+// kendisi listeleyen yerler (ör. etkileşimli dosya tarayıcısı) aynı kuralları bununla uygular.
+func (f *Filter) Ignored(root, rel string, isDir bool) bool {
+	if f == nil {
+		f = Default()
+	}
+	segments := strings.Split(filepath.ToSlash(rel), "/")
+	var rules []ignoreRule
+	dir, base := root, ""
+	for i, name := range segments {
+		if f.skipName(name) {
+			return true
+		}
+		if !f.noIgnore {
+			if local, err := parseIgnoreFile(dir, base); err == nil {
+				rules = append(rules, local...)
+			}
+		}
+		entryRel := path.Join(base, name)
+		if ignored(rules, entryRel, isDir || i < len(segments)-1) {
+			return true
+		}
+		dir, base = filepath.Join(dir, name), entryRel
+	}
+	return false
+}
+
+// MatchFile include/exclude desenlerini, regex'leri, dosya boyutunu ve değişiklik
+// zamanını kontrol eder. rel desenlerin eşlendiği göreli yoldur.
+func (f *Filter) MatchFile(filePath, rel string, info os.FileInfo) bool {
+	if f == nil {
+		return true
+	}
+	rel = filepath.ToSlash(rel)
+	if len(f.include) > 0 {
+		matched := false
+		for _, pattern := range f.include {
+			if MatchGlob(pattern, rel) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.excluded(rel) {
+		return false
+	}
+	if f.regex != nil && !f.regex.MatchString(rel) {
+		return false
+	}
+	if f.excludeRegex != nil && f.excludeRegex.MatchString(rel) {
+		return false
+	}
+	if info != nil {
+		if f.minSize > 0 && info.Size() < f.minSize {
+			return false
+		}
+		if f.maxSize > 0 && info.Size() > f.maxSize {
+			return false
+		}
+		if !f.modifiedSince.IsZero() && info.ModTime().Before(f.modifiedSince) {
+			return false
+		}
+		if !f.modifiedBefore.IsZero() && !info.ModTime().Before(f.modifiedBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Filter) excluded(rel string) bool {
+	for _, pattern := range f.exclude {
+		if MatchGlob(pattern, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchMedia görüntü boyutu ve medya süresi kurallarını probe ile kontrol eder.
+// Probe edilemeyen veya ilgili özelliği olmayan dosyalar (ör. boyut kuralında ses dosyası) elenir.
+func (f *Filter) MatchMedia(filePath string) bool {
+	if !f.NeedsProbe() {
+		return true
+	}
+	media, err := f.probe(filePath)
+	if err != nil {
+		return false
+	}
+	if f.minWidth > 0 || f.maxWidth > 0 || f.minHeight > 0 || f.maxHeight > 0 {
+		if media.Width <= 0 || media.Height <= 0 {
+			return false
+		}
+		if (f.minWidth > 0 && media.Width < f.minWidth) || (f.maxWidth > 0 && media.Width > f.maxWidth) {
+			return false
+		}
+		if (f.minHeight > 0 && media.Height < f.minHeight) || (f.maxHeight > 0 && media.Height > f.maxHeight) {
+			return false
+		}
+	}
+	if f.minDuration > 0 || f.maxDuration > 0 {
+		if media.Duration <= 0 {
+			return false
+		}
+		if (f.minDuration > 0 && media.Duration < f.minDuration) || (f.maxDuration > 0 && media.Duration > f.maxDuration) {
+			return false
+		}
+	}
+	return true
+}
+
+// Collect root altında accept'i ve tüm kuralları geçen dosyaları toplar.
+// accept nil ise her dosya kabul edilir.
+func (f *Filter) Collect(root string, recursive bool, accept func(path string) bool) ([]string, error) {
+	var files []string
+	err := f.Walk(root, recursive, func(p, _ string, _ os.FileInfo) error {
+		if accept != nil && !accept(p) {
+			return nil
+		}
+		if !f.MatchMedia(p) {
+			return nil
+		}
+		files = append(files, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// Default varsayılan kuralları (gizli dosyaları atla, .fileconverterignore oku,
+// bağlı dizinlere girme) uygulayan filtreyi döner.
+func Default() *Filter {
+	f, _ := Compile(Spec{})
+	return f
+}
+
+func defaultProbe(path string) (MediaInfo, error) {
+	info, err := converter.GetFileInfo(path)
+	if err != nil {
+		return MediaInfo{}, err
+	}
+	if info.ProbeError != "" {
+		return MediaInfo{}, fmt.Errorf("%s", info.ProbeError)
+	}
+	return MediaInfo{Width: info.Width, Height: info.Height, Duration: info.DurationS}, nil
+}
+
+func parseOptionalSize(value, label string) (int64, error) {
+	if strings.TrimSpace(value) == "" {
+		return 0, nil
+	}
+	size, err := converter.ParseSize(value)
+	if err != nil {
+		return 0, fmt.Errorf("geçersiz %s: %w", label, err)
+	}
+	return size, nil
+}
+
+// parseOptionalTime "2006-01-02", "2006-01-02 15:04", RFC3339 veya şu andan geriye
+// göreli süre ("90m", "12h", "7d", "2w") kabul eder.
+func parseOptionalTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	if d, err := ParseRelativeDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("tarih (YYYY-AA-GG, RFC3339) veya göreli süre (7d, 12h) bekleniyor: %q", value)
+}
+
+// ParseRelativeDuration "90m", "12h" gibi Go sürelerinin yanında gün (7d) ve hafta (2w)
+// birimlerini de kabul eder.
+func ParseRelativeDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("süre boş olamaz")
+	}
+	unit := value[len(value)-1]
+	if unit == 'd' || unit == 'w' {
+		n, err := strconv.ParseFloat(value[:len(value)-1], 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("geçersiz süre: %q", value)
+		}
+		day := 24 * time.Hour
+		if unit == 'w' {
+			day *= 7
+		}
+		return time.Duration(n * float64(day)), nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("geçersiz süre: %q", value)
+	}
+	return d, nil
+}
+
+// parseOptionalSeconds saniye ("90", "12.5"), SS:DD:ss/DD:ss veya Go süresi ("1m30s") kabul eder.
+func parseOptionalSeconds(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("süre negatif olamaz: %q", value)
+		}
+		return n, nil
+	}
+	if strings.Contains(value, ":") {
+		parts := strings.Split(value, ":")
+		if len(parts) > 3 {
+			return 0, fmt.Errorf("süre SS:DD:ss biçiminde olmalı: %q", value)
+		}
+		total := 0.0
+		for _, part := range parts {
+			n, err := strconv.ParseFloat(part, 64)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("süre SS:DD:ss biçiminde olmalı: %q", value)
+			}
+			total = total*60 + n
+		}
+		return total, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("süre saniye, SS:DD:ss veya 1m30s biçiminde olmalı: %q", value)
+	}
+	return d.Seconds(), nil
+}
@@ -0,0 +1,312 @@
+package filter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("mkdir failed: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+}
+
+func collectRel(t *testing.T, f *Filter, root string, recursive bool) []string {
+	t.Helper()
+	var rels []string
+	err := f.Walk(root, recursive, func(_, rel string, _ os.FileInfo) error {
+		rels = append(rels, rel)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk failed: %v", err)
+	}
+	return rels
+}
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.png", "a.png", true},
+		{"*.png", "sub/deep/a.png", true},
+		{"*.png", "a.jpg", false},
+		{"raw/*.png", "raw/a.png", true},
+		{"raw/*.png", "raw/x/a.png", false},
+		{"raw/**/*.png", "raw/a.png", true},
+		{"raw/**/*.png", "raw/x/y/a.png", true},
+		{"**/thumbs/**", "a/thumbs/b/c.png", true},
+		{"**", "anything/at/all", true},
+		{"raw/**", "other/a.png", false},
+	}
+	for _, tc := range cases {
+		if got := MatchGlob(tc.pattern, tc.name); got != tc.want {
+			t.Errorf("MatchGlob(%q, %q) = %v, want %v", tc.pattern, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestWalkDefaultsSkipHiddenAndIgnored(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"a.png":                  "x",
+		".hidden.png":            "x",
+		".cache/b.png":           "x",
+		"tmp/c.png":              "x",
+		"keep/d.png":             "x",
+		"keep/d.psd":             "x",
+		"keep/important.psd":     "x",
+		IgnoreFileName:           "# yorum\ntmp/\n*.psd\n",
+		"keep/" + IgnoreFileName: "!important.psd\n",
+	})
+
+	got := collectRel(t, nil, root, true)
+	want := []string{"a.png", "keep/d.png", "keep/important.psd"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected files: %v, want %v", got, want)
+	}
+
+	f, err := Compile(Spec{Hidden: true, NoIgnore: true})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	got = collectRel(t, f, root, true)
+	if len(got) != 7 {
+		t.Fatalf("expected hidden and ignored files to be included, got %v", got)
+	}
+}
+
+func TestIgnoredMatchesWalkRules(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"tmp/c.png":              "x",
+		"keep/raw/e.png":         "x",
+		"keep/important/f.png":   "x",
+		IgnoreFileName:           "tmp/\nraw/\n",
+		"keep/" + IgnoreFileName: "!raw/\n",
+	})
+
+	cases := []struct {
+		rel   string
+		isDir bool
+		want  bool
+	}{
+		{"tmp", true, true},
+		{"tmp/c.png", false, true},
+		{".cache", true, true},
+		{"keep", true, false},
+		// Alt dizindeki ignore dosyası üst kuralı geri alır
+		{"keep/raw", true, false},
+		{"keep/important", true, false},
+		// dirOnly kural dosyalara uygulanmaz
+		{"tmp", false, false},
+	}
+	for _, tc := range cases {
+		if got := Default().Ignored(root, tc.rel, tc.isDir); got != tc.want {
+			t.Errorf("Ignored(%q, dir=%v) = %v, want %v", tc.rel, tc.isDir, got, tc.want)
+		}
+	}
+
+	f, err := Compile(Spec{Hidden: true, NoIgnore: true})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	if f.Ignored(root, "tmp", true) || f.Ignored(root, ".cache", true) {
+		t.Fatalf("hidden/no-ignore filter should not ignore anything")
+	}
+}
+
+func TestWalkIncludeExcludeAndRegex(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"IMG_001.jpg":        "x",
+		"IMG_002.jpg":        "x",
+		"notes.jpg":          "x",
+		"raw/IMG_003.jpg":    "x",
+		"raw/deep/IMG_4.jpg": "x",
+		"export/IMG_005.jpg": "x",
+	})
+
+	f, err := Compile(Spec{
+		Include:      []string{"IMG_*"},
+		Exclude:      []string{"export"},
+		ExcludeRegex: `_002`,
+	})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	got := collectRel(t, f, root, true)
+	want := []string{"IMG_001.jpg", "raw/IMG_003.jpg", "raw/deep/IMG_4.jpg"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected files: %v, want %v", got, want)
+	}
+
+	f, err = Compile(Spec{Include: []string{"raw/**/*.jpg"}, Regex: `\d{3}\.jpg$`})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	got = collectRel(t, f, root, true)
+	if !slices.Equal(got, []string{"raw/IMG_003.jpg"}) {
+		t.Fatalf("unexpected files: %v", got)
+	}
+}
+
+func TestWalkSizeAndModifiedFilters(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"small.bin": "1234",
+		"large.bin": string(make([]byte, 4096)),
+		"old.bin":   string(make([]byte, 2048)),
+	})
+	now := time.Now()
+	old := now.Add(-10 * 24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(root, "old.bin"), old, old); err != nil {
+		t.Fatalf("chtimes failed: %v", err)
+	}
+
+	f, err := compile(Spec{MinSize: "1kb", ModifiedSince: "7d"}, now)
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	if got := collectRel(t, f, root, false); !slices.Equal(got, []string{"large.bin"}) {
+		t.Fatalf("unexpected files: %v", got)
+	}
+
+	f, err = compile(Spec{MaxSize: "3kb", ModifiedBefore: "2d"}, now)
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	if got := collectRel(t, f, root, false); !slices.Equal(got, []string{"old.bin"}) {
+		t.Fatalf("unexpected files: %v", got)
+	}
+}
+
+func TestWalkSymlinkPolicies(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	writeTree(t, root, map[string]string{"a.png": "x"})
+	writeTree(t, outside, map[string]string{"b.png": "x", "dir/c.png": "x"})
+	if err := os.Symlink(filepath.Join(outside, "b.png"), filepath.Join(root, "link.png")); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "dir"), filepath.Join(root, "linkdir")); err != nil {
+		t.Fatalf("symlink failed: %v", err)
+	}
+	// Döngü: bağlı dizin kökü gösterir.
+	if err := os.Symlink(root, filepath.Join(outside, "dir", "loop")); err != nil {
+		t.Fatalf("symlink failed: %v", err)
+	}
+
+	cases := map[string][]string{
+		SymlinksSkip:   {"a.png"},
+		SymlinksFiles:  {"a.png", "link.png"},
+		SymlinksFollow: {"a.png", "link.png", "linkdir/c.png"},
+	}
+	for policy, want := range cases {
+		f, err := Compile(Spec{Symlinks: policy})
+		if err != nil {
+			t.Fatalf("compile failed: %v", err)
+		}
+		if got := collectRel(t, f, root, true); !slices.Equal(got, want) {
+			t.Fatalf("policy %s: unexpected files: %v, want %v", policy, got, want)
+		}
+	}
+}
+
+func TestMatchMediaUsesProbe(t *testing.T) {
+	probe := func(path string) (MediaInfo, error) {
+		switch filepath.Base(path) {
+		case "wide.png":
+			return MediaInfo{Width: 1920, Height: 1080}, nil
+		case "small.png":
+			return MediaInfo{Width: 320, Height: 240}, nil
+		case "clip.mp4":
+			return MediaInfo{Width: 1280, Height: 720, Duration: 95}, nil
+		case "song.mp3":
+			return MediaInfo{Duration: 30}, nil
+		}
+		return MediaInfo{}, errors.New("probe failed")
+	}
+
+	f, err := Compile(Spec{MinWidth: 1000})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	f.SetProbe(probe)
+	for name, want := range map[string]bool{"wide.png": true, "small.png": false, "clip.mp4": true, "song.mp3": false, "broken.png": false} {
+		if got := f.MatchMedia(name); got != want {
+			t.Errorf("min-width MatchMedia(%s) = %v, want %v", name, got, want)
+		}
+	}
+
+	f, err = Compile(Spec{MinDuration: "1:00", MaxDuration: "2m"})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	f.SetProbe(probe)
+	for name, want := range map[string]bool{"clip.mp4": true, "song.mp3": false, "wide.png": false} {
+		if got := f.MatchMedia(name); got != want {
+			t.Errorf("duration MatchMedia(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestCompileRejectsInvalidSpec(t *testing.T) {
+	cases := []Spec{
+		{Include: []string{"[bad"}},
+		{Regex: "("},
+		{MinSize: "abc"},
+		{MinSize: "2mb", MaxSize: "1mb"},
+		{ModifiedSince: "yesterday"},
+		{ModifiedSince: "2025-02-01", ModifiedBefore: "2025-01-01"},
+		{MinWidth: -1},
+		{MinHeight: 500, MaxHeight: 100},
+		{MinDuration: "1:xx"},
+		{Symlinks: "always"},
+	}
+	for i, spec := range cases {
+		if _, err := Compile(spec); err == nil {
+			t.Errorf("case %d: expected error for %+v", i, spec)
+		}
+	}
+}
+
+func TestExpandGlobDoubleStar(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"photos/a.jpg":        "x",
+		"photos/2024/b.jpg":   "x",
+		"photos/2024/c.png":   "x",
+		"photos/.trash/d.jpg": "x",
+	})
+
+	files, err := (*Filter)(nil).ExpandGlob(filepath.Join(root, "photos", "**", "*.jpg"))
+	if err != nil {
+		t.Fatalf("expand failed: %v", err)
+	}
+	want := []string{filepath.Join(root, "photos", "2024", "b.jpg"), filepath.Join(root, "photos", "a.jpg")}
+	if !slices.Equal(files, want) {
+		t.Fatalf("unexpected files: %v, want %v", files, want)
+	}
+
+	files, err = (*Filter)(nil).ExpandGlob(filepath.Join(root, "photos", "*.jpg"))
+	if err != nil {
+		t.Fatalf("expand failed: %v", err)
+	}
+	if !slices.Equal(files, []string{filepath.Join(root, "photos", "a.jpg")}) {
+		t.Fatalf("unexpected files: %v", files)
+	}
+}
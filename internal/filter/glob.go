@@ -0,0 +1,121 @@
+package filter
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// MatchGlob slash ile ayrılmış göreli yolu glob desenine göre eşler.
+// "**" sıfır veya daha fazla dizin seviyesini eşler. "/" içermeyen desenler
+// (ör. "*.png") yolun yalnızca dosya adıyla karşılaştırılır.
+func MatchGlob(pattern, name string) bool {
+	pattern = strings.Trim(filepath.ToSlash(strings.TrimSpace(pattern)), "/")
+	name = strings.Trim(filepath.ToSlash(name), "/")
+	if pattern == "" {
+		return false
+	}
+	if !strings.Contains(pattern, "/") && pattern != "**" {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// ValidateGlob desenin sözdizimini doğrular.
+func ValidateGlob(pattern string) error {
+	for _, segment := range strings.Split(filepath.ToSlash(pattern), "/") {
+		if segment == "**" {
+			continue
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("geçersiz glob deseni %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func hasGlobMeta(segment string) bool {
+	return strings.ContainsAny(segment, "*?[")
+}
+
+// ExpandGlob glob desenine uyan dosyaları filtre kurallarıyla birlikte toplar.
+// Desendeki joker karakter içermeyen baş kısım tarama kökü olur; "**" içeren veya
+// alt dizin seviyesinde joker bulunan desenlerde kök alt dizinleriyle taranır.
+func (f *Filter) ExpandGlob(pattern string) ([]string, error) {
+	if err := ValidateGlob(pattern); err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(filepath.ToSlash(pattern), "/")
+	split := len(segments)
+	for i, segment := range segments {
+		if hasGlobMeta(segment) {
+			split = i
+			break
+		}
+	}
+
+	if split == len(segments) {
+		// Joker içermeyen desen tek bir dosyayı gösterir.
+		info, err := os.Stat(pattern)
+		if err != nil || info.IsDir() {
+			return nil, nil
+		}
+		if !f.MatchFile(pattern, filepath.Base(pattern), info) || !f.MatchMedia(pattern) {
+			return nil, nil
+		}
+		return []string{pattern}, nil
+	}
+
+	root := strings.Join(segments[:split], "/")
+	switch {
+	case root == "" && strings.HasPrefix(pattern, "/"):
+		root = "/"
+	case root == "":
+		root = "."
+	}
+	rest := strings.Join(segments[split:], "/")
+	recursive := len(segments[split:]) > 1
+
+	var files []string
+	err := f.Walk(filepath.FromSlash(root), recursive, func(p, rel string, _ os.FileInfo) error {
+		if matchSegments(strings.Split(rest, "/"), strings.Split(rel, "/")) && f.MatchMedia(p) {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,101 @@
+package filter
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFileName taranan dizinlerde okunan, gitignore benzeri dışlama dosyasıdır.
+const IgnoreFileName = ".fileconverterignore"
+
+// ignoreRule .fileconverterignore dosyasındaki tek bir satır.
+type ignoreRule struct {
+	// base kuralın tanımlandığı dizinin tarama köküne göre göreli yolu ("" kök).
+	base     string
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// parseIgnoreFile dizindeki .fileconverterignore dosyasını okur; dosya yoksa boş döner.
+// Boş satırlar ve "#" ile başlayan satırlar yok sayılır, "!" deseni yeniden dahil eder,
+// sondaki "/" yalnızca dizinleri, baştaki "/" yalnızca o dizini hedefler.
+func parseIgnoreFile(dir, base string) ([]ignoreRule, error) {
+	file, err := os.Open(filepath.Join(dir, IgnoreFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var rules []ignoreRule
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if rule, ok := parseIgnoreLine(scanner.Text(), base); ok {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, scanner.Err()
+}
+
+func parseIgnoreLine(line, base string) (ignoreRule, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	rule := ignoreRule{base: base}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.HasPrefix(line, "/") {
+		rule.anchored = true
+		line = strings.TrimLeft(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+	}
+	if line == "" || ValidateGlob(line) != nil {
+		return ignoreRule{}, false
+	}
+	rule.pattern = line
+	return rule, true
+}
+
+// ignored kurallar sırayla uygulanır; son eşleşen kural sonucu belirler.
+func ignored(rules []ignoreRule, rel string, isDir bool) bool {
+	result := false
+	for _, rule := range rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		target := rel
+		if rule.base != "" {
+			if !strings.HasPrefix(rel, rule.base+"/") {
+				continue
+			}
+			target = strings.TrimPrefix(rel, rule.base+"/")
+		}
+		var ok bool
+		if rule.anchored {
+			ok = matchSegments(strings.Split(rule.pattern, "/"), strings.Split(target, "/"))
+		} else {
+			ok, _ = path.Match(rule.pattern, path.Base(target))
+		}
+		if ok {
+			result = !rule.negate
+		}
+	}
+	return result
+}
@@ -0,0 +1,236 @@
+// Package inventory dizin genelinde medya envanteri ve istatistik raporu üretir.
+package inventory
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+// LargestLimit istatistiklerde listelenen en büyük dosya sayısı.
+const LargestLimit = 10
+
+// Entry envanterdeki tek bir dosya satırı.
+type Entry struct {
+	Path       string  `json:"path"`
+	Format     string  `json:"format"`
+	Category   string  `json:"category"`
+	Size       int64   `json:"size_bytes"`
+	Width      int     `json:"width,omitempty"`
+	Height     int     `json:"height,omitempty"`
+	Duration   float64 `json:"duration_seconds,omitempty"`
+	VideoCodec string  `json:"video_codec,omitempty"`
+	AudioCodec string  `json:"audio_codec,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// Group kategori, format veya codec bazında toplamlar.
+type Group struct {
+	Key      string  `json:"key"`
+	Count    int     `json:"count"`
+	Size     int64   `json:"size_bytes"`
+	Duration float64 `json:"duration_seconds,omitempty"`
+}
+
+// Stats envanterin toplu istatistikleri.
+type Stats struct {
+	Files        int     `json:"files"`
+	Size         int64   `json:"size_bytes"`
+	Duration     float64 `json:"duration_seconds"`
+	Failed       int     `json:"failed"`
+	ByCategory   []Group `json:"by_category"`
+	ByFormat     []Group `json:"by_format"`
+	ByVideoCodec []Group `json:"by_video_codec"`
+	ByAudioCodec []Group `json:"by_audio_codec"`
+	Largest      []Entry `json:"largest"`
+	Failures     []Entry `json:"failures"`
+}
+
+// Report envanter çıktısının tamamı.
+type Report struct {
+	Root        string  `json:"root"`
+	GeneratedAt string  `json:"generated_at"`
+	Recursive   bool    `json:"recursive"`
+	Entries     []Entry `json:"entries"`
+	Stats       Stats   `json:"stats"`
+}
+
+// ProbeFunc tek bir dosyanın bilgisini okur; testlerde değiştirilebilir.
+type ProbeFunc func(path string) (converter.FileInfo, error)
+
+// CollectPaths dizindeki dosyaları sıralı olarak toplar. Gizli dosya ve klasörler (.git vb.) atlanır.
+func CollectPaths(root string, recursive bool) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return nil // Erişilemeyen dosyaları atla
+		}
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if !recursive && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Type().IsRegular() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dizin taranamadı: %w", err)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Scan dosyaları verilen worker sayısıyla paralel okur; sonuç giriş sırasını korur.
+func Scan(paths []string, workers int, probe ProbeFunc) []Entry {
+	if probe == nil {
+		probe = converter.GetFileInfo
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	entries := make([]Entry, len(paths))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				entries[i] = entryFromInfo(paths[i], probe)
+			}
+		}()
+	}
+	for i := range paths {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return entries
+}
+
+func entryFromInfo(path string, probe ProbeFunc) Entry {
+	info, err := probe(path)
+	entry := Entry{
+		Path:     path,
+		Format:   strings.ToLower(info.Format),
+		Category: info.Category,
+		Size:     info.Size,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		if entry.Category == "" {
+			entry.Category = "unknown"
+		}
+		return entry
+	}
+	entry.Width = info.Width
+	entry.Height = info.Height
+	entry.Duration = info.DurationS
+	entry.VideoCodec = info.VideoCodec
+	entry.AudioCodec = info.AudioCodec
+	entry.Error = info.ProbeError
+	return entry
+}
+
+// BuildReport girişlerden istatistikleri hesaplayıp raporu oluşturur.
+func BuildReport(root string, recursive bool, entries []Entry, now time.Time) Report {
+	if entries == nil {
+		entries = []Entry{}
+	}
+	return Report{
+		Root:        root,
+		GeneratedAt: now.Format(time.RFC3339),
+		Recursive:   recursive,
+		Entries:     entries,
+		Stats:       ComputeStats(entries),
+	}
+}
+
+// ComputeStats kategori/format/codec toplamlarını, en büyük dosyaları ve çözülemeyen dosyaları hesaplar.
+func ComputeStats(entries []Entry) Stats {
+	stats := Stats{Largest: []Entry{}, Failures: []Entry{}}
+	byCategory := map[string]*Group{}
+	byFormat := map[string]*Group{}
+	byVideo := map[string]*Group{}
+	byAudio := map[string]*Group{}
+
+	for _, e := range entries {
+		stats.Files++
+		stats.Size += e.Size
+		stats.Duration += e.Duration
+		if e.Error != "" {
+			stats.Failed++
+			stats.Failures = append(stats.Failures, e)
+		}
+		addToGroup(byCategory, e.Category, e)
+		addToGroup(byFormat, valueOr(e.Format, "-"), e)
+		if e.VideoCodec != "" {
+			addToGroup(byVideo, e.VideoCodec, e)
+		}
+		if e.AudioCodec != "" {
+			addToGroup(byAudio, e.AudioCodec, e)
+		}
+	}
+
+	stats.ByCategory = sortedGroups(byCategory)
+	stats.ByFormat = sortedGroups(byFormat)
+	stats.ByVideoCodec = sortedGroups(byVideo)
+	stats.ByAudioCodec = sortedGroups(byAudio)
+
+	largest := make([]Entry, len(entries))
+	copy(largest, entries)
+	sort.SliceStable(largest, func(i, j int) bool { return largest[i].Size > largest[j].Size })
+	if len(largest) > LargestLimit {
+		largest = largest[:LargestLimit]
+	}
+	stats.Largest = append(stats.Largest, largest...)
+	return stats
+}
+
+func addToGroup(groups map[string]*Group, key string, e Entry) {
+	g, ok := groups[key]
+	if !ok {
+		g = &Group{Key: key}
+		groups[key] = g
+	}
+	g.Count++
+	g.Size += e.Size
+	g.Duration += e.Duration
+}
+
+// sortedGroups grupları boyuta göre azalan, eşitlikte anahtara göre sıralar (kararlı çıktı).
+func sortedGroups(groups map[string]*Group) []Group {
+	list := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		list = append(list, *g)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Size != list[j].Size {
+			return list[i].Size > list[j].Size
+		}
+		return list[i].Key < list[j].Key
+	})
+	return list
+}
+
+func valueOr(value string, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}
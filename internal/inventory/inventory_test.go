@@ -0,0 +1,116 @@
+package inventory
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+)
+
+func writeFile(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollectPathsSkipsHiddenAndRespectsRecursive(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "b.mp4"))
+	writeFile(t, filepath.Join(dir, "a.png"))
+	writeFile(t, filepath.Join(dir, ".DS_Store"))
+	writeFile(t, filepath.Join(dir, ".git", "config"))
+	writeFile(t, filepath.Join(dir, "sub", "c.mp3"))
+
+	flat, err := CollectPaths(dir, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(flat) != 2 || filepath.Base(flat[0]) != "a.png" || filepath.Base(flat[1]) != "b.mp4" {
+		t.Fatalf("unexpected flat paths: %v", flat)
+	}
+	all, _ := CollectPaths(dir, true)
+	if len(all) != 3 {
+		t.Fatalf("expected 3 paths with recursive scan, got %v", all)
+	}
+}
+
+func TestScanKeepsOrderAndReportsErrors(t *testing.T) {
+	paths := []string{"a.mp4", "b.jpg", "missing.png", "c.mp3"}
+	probe := func(path string) (converter.FileInfo, error) {
+		switch path {
+		case "a.mp4":
+			return converter.FileInfo{Format: "MP4", Category: "video", Size: 1000, Width: 1920, Height: 1080, DurationS: 60, VideoCodec: "h264", AudioCodec: "aac"}, nil
+		case "b.jpg":
+			return converter.FileInfo{Format: "JPG", Category: "image", Size: 10, ProbeError: "görsel çözülemedi"}, nil
+		case "c.mp3":
+			return converter.FileInfo{Format: "MP3", Category: "audio", Size: 300, DurationS: 30, AudioCodec: "mp3"}, nil
+		}
+		return converter.FileInfo{}, errors.New("dosya bulunamadı")
+	}
+	entries := Scan(paths, 3, probe)
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 entries, got %d", len(entries))
+	}
+	for i, p := range paths {
+		if entries[i].Path != p {
+			t.Fatalf("order not preserved: %v", entries)
+		}
+	}
+	if entries[0].Format != "mp4" || entries[0].VideoCodec != "h264" || entries[0].Duration != 60 {
+		t.Fatalf("unexpected video entry: %+v", entries[0])
+	}
+	if entries[1].Error == "" || entries[2].Error == "" || entries[2].Category != "unknown" {
+		t.Fatalf("expected errors for undecodable and missing files: %+v", entries[1:3])
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	entries := []Entry{
+		{Path: "a.mp4", Format: "mp4", Category: "video", Size: 1000, Duration: 60, VideoCodec: "h264", AudioCodec: "aac"},
+		{Path: "b.mkv", Format: "mkv", Category: "video", Size: 3000, Duration: 120, VideoCodec: "hevc", AudioCodec: "aac"},
+		{Path: "c.mp3", Format: "mp3", Category: "audio", Size: 200, Duration: 30, AudioCodec: "mp3"},
+		{Path: "d.jpg", Format: "jpg", Category: "image", Size: 50, Error: "bozuk"},
+	}
+	stats := ComputeStats(entries)
+	if stats.Files != 4 || stats.Size != 4250 || stats.Duration != 210 || stats.Failed != 1 {
+		t.Fatalf("unexpected totals: %+v", stats)
+	}
+	if stats.ByCategory[0].Key != "video" || stats.ByCategory[0].Count != 2 || stats.ByCategory[0].Size != 4000 {
+		t.Fatalf("unexpected category groups: %+v", stats.ByCategory)
+	}
+	if len(stats.ByAudioCodec) != 2 || stats.ByAudioCodec[0].Key != "aac" || stats.ByAudioCodec[0].Count != 2 {
+		t.Fatalf("unexpected audio codec groups: %+v", stats.ByAudioCodec)
+	}
+	if stats.Largest[0].Path != "b.mkv" || len(stats.Failures) != 1 || stats.Failures[0].Path != "d.jpg" {
+		t.Fatalf("unexpected largest/failures: %+v %+v", stats.Largest, stats.Failures)
+	}
+}
+
+func TestComputeStatsLimitsLargest(t *testing.T) {
+	entries := make([]Entry, LargestLimit+5)
+	for i := range entries {
+		entries[i] = Entry{Path: strings.Repeat("x", i+1), Size: int64(i)}
+	}
+	stats := ComputeStats(entries)
+	if len(stats.Largest) != LargestLimit || stats.Largest[0].Size != int64(LargestLimit+4) {
+		t.Fatalf("unexpected largest list: %+v", stats.Largest)
+	}
+}
+
+func TestBuildReportEmpty(t *testing.T) {
+	report := BuildReport("/tmp/x", true, nil, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if report.Entries == nil || report.Stats.Largest == nil || report.Stats.Failures == nil {
+		t.Fatalf("empty report should use empty slices: %+v", report)
+	}
+	if report.GeneratedAt != "2026-01-02T03:04:05Z" {
+		t.Fatalf("unexpected timestamp: %s", report.GeneratedAt)
+	}
+}
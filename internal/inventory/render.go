@@ -0,0 +1,194 @@
+package inventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatOff  = "off"
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+	FormatHTML = "html"
+)
+
+// NormalizeFormat rapor formatını normalize eder; geçersizse boş string döner.
+func NormalizeFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", FormatOff:
+		return FormatOff
+	case FormatJSON:
+		return FormatJSON
+	case FormatCSV:
+		return FormatCSV
+	case FormatHTML, "htm":
+		return FormatHTML
+	default:
+		return ""
+	}
+}
+
+// Render raporu istenen formatta metne çevirir.
+func Render(format string, report Report) (string, error) {
+	switch NormalizeFormat(format) {
+	case FormatOff:
+		return "", nil
+	case FormatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case FormatCSV:
+		return renderCSV(report)
+	case FormatHTML:
+		return renderHTML(report)
+	default:
+		return "", fmt.Errorf("gecersiz rapor formati: %s (json, csv, html)", format)
+	}
+}
+
+var csvHeader = []string{"path", "format", "category", "size_bytes", "width", "height", "duration_seconds", "video_codec", "audio_codec", "error"}
+
+func renderCSV(report Report) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader); err != nil {
+		return "", err
+	}
+	for _, e := range report.Entries {
+		row := []string{
+			e.Path,
+			e.Format,
+			e.Category,
+			strconv.FormatInt(e.Size, 10),
+			intOrEmpty(e.Width),
+			intOrEmpty(e.Height),
+			"",
+			e.VideoCodec,
+			e.AudioCodec,
+			e.Error,
+		}
+		if e.Duration > 0 {
+			row[6] = strconv.FormatFloat(e.Duration, 'f', 3, 64)
+		}
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+func intOrEmpty(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.Itoa(v)
+}
+
+// HumanSize byte değerini okunabilir hale getirir (ör: 1.5 MB).
+func HumanSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+// HumanDuration saniyeyi SS:DD:ss biçimine çevirir.
+func HumanDuration(seconds float64) string {
+	if seconds <= 0 {
+		return ""
+	}
+	total := int(seconds + 0.5)
+	return fmt.Sprintf("%02d:%02d:%02d", total/3600, (total%3600)/60, total%60)
+}
+
+var htmlTemplate = template.Must(template.New("inventory").Funcs(template.FuncMap{
+	"size":     HumanSize,
+	"duration": HumanDuration,
+	"dims": func(e Entry) string {
+		if e.Width == 0 || e.Height == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%dx%d", e.Width, e.Height)
+	},
+	"dict": func(pairs ...interface{}) map[string]interface{} {
+		m := make(map[string]interface{}, len(pairs)/2)
+		for i := 0; i+1 < len(pairs); i += 2 {
+			m[fmt.Sprint(pairs[i])] = pairs[i+1]
+		}
+		return m
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="tr">
+<head>
+<meta charset="utf-8">
+<title>Medya Envanteri — {{.Root}}</title>
+<style>
+body{font-family:-apple-system,Segoe UI,Roboto,sans-serif;margin:2rem;color:#1e293b;background:#f8fafc}
+h1{margin-bottom:.2rem}h2{margin-top:2rem;border-bottom:2px solid #e2e8f0;padding-bottom:.3rem}
+.meta{color:#64748b}
+.cards{display:flex;gap:1rem;flex-wrap:wrap;margin-top:1rem}
+.card{background:#fff;border:1px solid #e2e8f0;border-radius:8px;padding:.8rem 1.2rem;min-width:140px}
+.card b{display:block;font-size:1.4rem}
+table{border-collapse:collapse;width:100%;background:#fff;font-size:.9rem}
+th,td{border:1px solid #e2e8f0;padding:.35rem .6rem;text-align:left}
+th{background:#f1f5f9}
+td.num{text-align:right;font-variant-numeric:tabular-nums}
+tr.failed td{background:#fef2f2}
+.grid{display:grid;grid-template-columns:repeat(auto-fit,minmax(320px,1fr));gap:1rem}
+</style>
+</head>
+<body>
+<h1>Medya Envanteri</h1>
+<div class="meta">{{.Root}}{{if .Recursive}} (alt klasörler dahil){{end}} — {{.GeneratedAt}}</div>
+<div class="cards">
+<div class="card">Dosya<b>{{.Stats.Files}}</b></div>
+<div class="card">Toplam boyut<b>{{size .Stats.Size}}</b></div>
+<div class="card">Toplam süre<b>{{with duration .Stats.Duration}}{{.}}{{else}}-{{end}}</b></div>
+<div class="card">Çözülemeyen<b>{{.Stats.Failed}}</b></div>
+</div>
+<div class="grid">
+{{template "groups" (dict "Title" "Kategoriye göre" "Groups" .Stats.ByCategory)}}
+{{template "groups" (dict "Title" "Formata göre" "Groups" .Stats.ByFormat)}}
+{{template "groups" (dict "Title" "Video codec'e göre" "Groups" .Stats.ByVideoCodec)}}
+{{template "groups" (dict "Title" "Ses codec'e göre" "Groups" .Stats.ByAudioCodec)}}
+</div>
+<h2>En büyük dosyalar</h2>
+<table><tr><th>Dosya</th><th>Format</th><th>Boyut</th></tr>
+{{range .Stats.Largest}}<tr><td>{{.Path}}</td><td>{{.Format}}</td><td class="num">{{size .Size}}</td></tr>
+{{end}}</table>
+{{if .Stats.Failures}}<h2>Çözülemeyen dosyalar</h2>
+<table><tr><th>Dosya</th><th>Hata</th></tr>
+{{range .Stats.Failures}}<tr class="failed"><td>{{.Path}}</td><td>{{.Error}}</td></tr>
+{{end}}</table>{{end}}
+<h2>Tüm dosyalar</h2>
+<table><tr><th>Dosya</th><th>Format</th><th>Kategori</th><th>Boyut</th><th>Boyutlar</th><th>Süre</th><th>Video</th><th>Ses</th></tr>
+{{range .Entries}}<tr{{if .Error}} class="failed" title="{{.Error}}"{{end}}><td>{{.Path}}</td><td>{{.Format}}</td><td>{{.Category}}</td><td class="num">{{size .Size}}</td><td>{{dims .}}</td><td>{{duration .Duration}}</td><td>{{.VideoCodec}}</td><td>{{.AudioCodec}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+{{define "groups"}}{{if .Groups}}<div><h2>{{.Title}}</h2>
+<table><tr><th>Anahtar</th><th>Adet</th><th>Boyut</th><th>Süre</th></tr>
+{{range .Groups}}<tr><td>{{.Key}}</td><td class="num">{{.Count}}</td><td class="num">{{size .Size}}</td><td class="num">{{duration .Duration}}</td></tr>
+{{end}}</table></div>{{end}}{{end}}`))
+
+func renderHTML(report Report) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, report); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
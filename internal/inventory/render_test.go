@@ -0,0 +1,83 @@
+package inventory
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleReport() Report {
+	entries := []Entry{
+		{Path: "a.mp4", Format: "mp4", Category: "video", Size: 2048, Width: 1920, Height: 1080, Duration: 61.5, VideoCodec: "h264", AudioCodec: "aac"},
+		{Path: "b,c.jpg", Format: "jpg", Category: "image", Size: 10, Error: "görsel çözülemedi"},
+	}
+	return BuildReport("./arsiv", true, entries, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func TestNormalizeFormat(t *testing.T) {
+	cases := map[string]string{"": FormatOff, "JSON": FormatJSON, "csv": FormatCSV, "htm": FormatHTML, "xml": ""}
+	for in, want := range cases {
+		if got := NormalizeFormat(in); got != want {
+			t.Fatalf("NormalizeFormat(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRenderCSV(t *testing.T) {
+	text, err := Render(FormatCSV, sampleReport())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header + 2 rows, got %q", text)
+	}
+	if lines[1] != "a.mp4,mp4,video,2048,1920,1080,61.500,h264,aac," {
+		t.Fatalf("unexpected csv row: %s", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], `"b,c.jpg",jpg,image,10,`) {
+		t.Fatalf("csv should quote commas: %s", lines[2])
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	text, err := Render(FormatJSON, sampleReport())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal([]byte(text), &decoded); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if decoded.Stats.Files != 2 || decoded.Stats.Failed != 1 || len(decoded.Entries) != 2 {
+		t.Fatalf("unexpected decoded report: %+v", decoded.Stats)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	report := sampleReport()
+	report.Entries[0].Path = "<script>.mp4"
+	report.Stats = ComputeStats(report.Entries)
+	text, err := Render(FormatHTML, report)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"<!DOCTYPE html>", "Medya Envanteri", "Çözülemeyen dosyalar", "1920x1080", "00:01:02", "&lt;script&gt;.mp4"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("html report missing %q", want)
+		}
+	}
+	if strings.Contains(text, "<script>") {
+		t.Fatalf("html report should escape file names")
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	cases := map[int64]string{512: "512 B", 2048: "2.0 KB", 5 * 1024 * 1024: "5.0 MB"}
+	for in, want := range cases {
+		if got := HumanSize(in); got != want {
+			t.Fatalf("HumanSize(%d) = %q, want %q", in, got, want)
+		}
+	}
+}
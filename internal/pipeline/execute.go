@@ -19,6 +19,10 @@ type ExecuteConfig struct {
 	MetadataMode   string
 	OnConflict     string
 	KeepTemps      bool
+	// NameTemplate son çıktının adlandırma şablonudur; boşsa spec'teki name_template kullanılır.
+	NameTemplate string
+	// Index dizin/glob girdisinde dosyanın 1'den başlayan sırasıdır ({index}).
+	Index int
 }
 
 // Result pipeline çalıştırma sonucunu tutar.
@@ -40,6 +44,10 @@ type StepResult struct {
 	Duration time.Duration
 	Success  bool
 	Error    string
+	// InputSize ve OutputSize başarılı step'in girdi/çıktı boyutlarıdır; ara dosyalar
+	// silindikten sonra da raporlanabilmesi için step bitince ölçülür.
+	InputSize  int64
+	OutputSize int64
 }
 
 // Execute spec'i sırayla çalıştırır.
@@ -57,6 +65,22 @@ func Execute(spec Spec, cfg ExecuteConfig) (Result, error) {
 		metadataMode = converter.MetadataAuto
 	}
 
+	templateRaw := spec.NameTemplate
+	if strings.TrimSpace(cfg.NameTemplate) != "" {
+		templateRaw = cfg.NameTemplate
+	}
+	nameTmpl, err := converter.ParseNameTemplate(templateRaw)
+	if err != nil {
+		return Result{}, err
+	}
+	if nameTmpl != nil && strings.TrimSpace(spec.Output) != "" {
+		return Result{}, fmt.Errorf("output ve name_template birlikte kullanilamaz")
+	}
+	naming := stepNaming{
+		Template: nameTmpl,
+		Data:     converter.TemplateData{InputPath: spec.Input, Index: cfg.Index, Quality: cfg.DefaultQuality},
+	}
+
 	startedAt := time.Now()
 	result := Result{
 		Input:     spec.Input,
@@ -81,7 +105,7 @@ func Execute(spec Spec, cfg ExecuteConfig) (Result, error) {
 		switch stepType {
 		case StepConvert:
 			to := converter.NormalizeFormat(step.To)
-			output, err = buildStepOutput(currentInput, i, to, step, spec, cfg.OutputDir, tempDir, conflict, len(spec.Steps))
+			output, err = buildStepOutput(currentInput, i, to, step, spec, cfg.OutputDir, tempDir, conflict, len(spec.Steps), naming)
 			if err != nil {
 				sr := StepResult{
 					Index:    i + 1,
@@ -129,7 +153,40 @@ func Execute(spec Spec, cfg ExecuteConfig) (Result, error) {
 				Verbose:      cfg.Verbose,
 				MetadataMode: stepMetadata,
 			}
-			err = conv.Convert(currentInput, output, opts)
+			if strings.TrimSpace(step.TargetSize) != "" {
+				opts.TargetSize, err = converter.ParseSize(step.TargetSize)
+			}
+			if err == nil {
+				opts.Transform, err = step.TransformSpec()
+			}
+			if err == nil {
+				err = converter.ConvertAtomic(conv, currentInput, output, opts)
+			}
+			if err != nil {
+				sr := StepResult{
+					Index:    i + 1,
+					Type:     stepType,
+					Input:    currentInput,
+					Output:   output,
+					Duration: time.Since(stepStart),
+					Success:  false,
+					Error:    err.Error(),
+				}
+				result.Steps = append(result.Steps, sr)
+				result.EndedAt = time.Now()
+				result.Duration = result.EndedAt.Sub(result.StartedAt)
+				return result, err
+			}
+
+		case StepWatermark:
+			videoOut := converter.DetectFormat(currentInput)
+			if step.To != "" {
+				videoOut = converter.NormalizeFormat(step.To)
+			}
+			output, err = buildStepOutput(currentInput, i, videoOut, step, spec, cfg.OutputDir, tempDir, conflict, len(spec.Steps), naming)
+			if err == nil {
+				err = runWatermark(currentInput, output, step, cfg, metadataMode)
+			}
 			if err != nil {
 				sr := StepResult{
 					Index:    i + 1,
@@ -151,7 +208,7 @@ func Execute(spec Spec, cfg ExecuteConfig) (Result, error) {
 			if step.To != "" {
 				audioOut = converter.NormalizeFormat(step.To)
 			}
-			output, err = buildStepOutput(currentInput, i, audioOut, step, spec, cfg.OutputDir, tempDir, conflict, len(spec.Steps))
+			output, err = buildStepOutput(currentInput, i, audioOut, step, spec, cfg.OutputDir, tempDir, conflict, len(spec.Steps), naming)
 			if err != nil {
 				sr := StepResult{
 					Index:    i + 1,
@@ -186,12 +243,14 @@ func Execute(spec Spec, cfg ExecuteConfig) (Result, error) {
 		}
 
 		sr := StepResult{
-			Index:    i + 1,
-			Type:     stepType,
-			Input:    currentInput,
-			Output:   output,
-			Duration: time.Since(stepStart),
-			Success:  true,
+			Index:      i + 1,
+			Type:       stepType,
+			Input:      currentInput,
+			Output:     output,
+			Duration:   time.Since(stepStart),
+			Success:    true,
+			InputSize:  fileSize(currentInput),
+			OutputSize: fileSize(output),
 		}
 		result.Steps = append(result.Steps, sr)
 		currentInput = output
@@ -203,7 +262,22 @@ func Execute(spec Spec, cfg ExecuteConfig) (Result, error) {
 	return result, nil
 }
 
-func buildStepOutput(currentInput string, stepIndex int, to string, step Step, spec Spec, outputDir string, tempDir string, conflict string, totalSteps int) (string, error) {
+// fileSize dosya boyutunu döner; okunamazsa 0 döner.
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// stepNaming son adımın çıktı adını üreten şablon ve değişkenleridir; Template nil olabilir.
+type stepNaming struct {
+	Template *converter.NameTemplate
+	Data     converter.TemplateData
+}
+
+func buildStepOutput(currentInput string, stepIndex int, to string, step Step, spec Spec, outputDir string, tempDir string, conflict string, totalSteps int, naming stepNaming) (string, error) {
 	if strings.TrimSpace(step.Output) != "" {
 		return step.Output, nil
 	}
@@ -215,6 +289,22 @@ func buildStepOutput(currentInput string, stepIndex int, to string, step Step, s
 
 	if isLast {
 		out := converter.BuildOutputPath(currentInput, outputDir, to, "")
+		if naming.Template != nil {
+			// Şablon ara dosyaya değil pipeline'ın özgün girdisine göre çözülür.
+			data := naming.Data
+			data.TargetFormat = to
+			if step.Quality > 0 {
+				data.Quality = step.Quality
+			}
+			templated, err := naming.Template.OutputPath(outputDir, data)
+			if err != nil {
+				return "", err
+			}
+			if err := os.MkdirAll(filepath.Dir(templated), 0755); err != nil {
+				return "", err
+			}
+			out = templated
+		}
 		resolved, skip, err := converter.ResolveOutputPathConflict(out, conflict)
 		if err != nil {
 			return "", err
@@ -233,6 +323,37 @@ func buildStepOutput(currentInput string, stepIndex int, to string, step Step, s
 	return filepath.Join(tempDir, filename), nil
 }
 
+func runWatermark(input string, output string, step Step, cfg ExecuteConfig, defaultMetadataMode string) error {
+	from := converter.DetectFormat(input)
+	to := converter.DetectFormat(output)
+	if !converter.IsVideoFormat(from) || !converter.IsVideoFormat(to) {
+		return fmt.Errorf("watermark sadece video dosyalarina uygulanabilir: %s", input)
+	}
+	conv, err := converter.FindConverter(from, to)
+	if err != nil {
+		return err
+	}
+
+	quality := cfg.DefaultQuality
+	if step.Quality > 0 {
+		quality = step.Quality
+	}
+	metadataMode := defaultMetadataMode
+	if m := converter.NormalizeMetadataMode(step.MetadataMode); m != "" {
+		metadataMode = m
+	}
+	wm := *step.Watermark
+	if err := converter.ValidateWatermarkSpec(&wm); err != nil {
+		return err
+	}
+	return converter.ConvertAtomic(conv, input, output, converter.Options{
+		Quality:      quality,
+		Verbose:      cfg.Verbose,
+		MetadataMode: metadataMode,
+		Watermark:    &wm,
+	})
+}
+
 func runAudioNormalize(input string, output string, step Step, defaultMetadataMode string, verbose bool) error {
 	ffmpegPath, err := exec.LookPath("ffmpeg")
 	if err != nil {
@@ -267,7 +388,7 @@ func runAudioNormalize(input string, output string, step Step, defaultMetadataMo
 	args = append(args, converter.MetadataFFmpegArgs(metadataMode)...)
 	args = append(args, output)
 
-	cmd := exec.Command(ffmpegPath, args...)
+	cmd := converter.Command(ffmpegPath, args...)
 	if out, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("audio-normalize ffmpeg hatasi: %s\n%s", err.Error(), string(out))
 	}
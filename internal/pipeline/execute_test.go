@@ -53,3 +53,33 @@ func TestExecuteInvalidSpec(t *testing.T) {
 		t.Fatalf("expected validation error")
 	}
 }
+
+func TestExecuteNameTemplate(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(input, []byte("hello pipeline"), 0644); err != nil {
+		t.Fatalf("write input failed: %v", err)
+	}
+
+	spec := Spec{
+		Input:        input,
+		NameTemplate: "out/{name}_{index:02}.{ext}",
+		Steps:        []Step{{Type: StepConvert, To: "md"}},
+	}
+	result, err := Execute(spec, ExecuteConfig{
+		OutputDir:    dir,
+		MetadataMode: "auto",
+		OnConflict:   "versioned",
+		Index:        3,
+	})
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	want := filepath.Join(dir, "out", "notes_03.md")
+	if result.FinalOutput != want {
+		t.Fatalf("expected %s, got %s", want, result.FinalOutput)
+	}
+	if _, err := os.Stat(want); err != nil {
+		t.Fatalf("final output not found: %v", err)
+	}
+}
@@ -4,12 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/report"
 )
 
 const (
-	ReportOff  = "off"
-	ReportTXT  = "txt"
-	ReportJSON = "json"
+	ReportOff      = "off"
+	ReportTXT      = "txt"
+	ReportJSON     = "json"
+	ReportCSV      = report.FormatCSV
+	ReportJUnit    = report.FormatJUnit
+	ReportMarkdown = report.FormatMarkdown
+	ReportHTML     = report.FormatHTML
 )
 
 // NormalizeReportFormat formatı normalize eder.
@@ -22,7 +29,7 @@ func NormalizeReportFormat(format string) string {
 	case ReportJSON:
 		return ReportJSON
 	default:
-		return ""
+		return report.NormalizeFormat(format)
 	}
 }
 
@@ -39,11 +46,96 @@ func RenderReport(format string, result Result) (string, error) {
 			return "", err
 		}
 		return string(data), nil
+	case ReportCSV, ReportJUnit, ReportMarkdown, ReportHTML:
+		return report.Render(format, reportDocument([]Result{result}))
+	default:
+		return "", fmt.Errorf("gecersiz report formati: %s", format)
+	}
+}
+
+// RenderReports dizin/glob girdisinde her dosyanın sonucunu tek raporda birleştirir.
+// JSON çıktısı sonuç dizisidir, TXT çıktısı dosya raporlarını art arda yazar.
+func RenderReports(format string, results []Result) (string, error) {
+	switch NormalizeReportFormat(format) {
+	case ReportOff:
+		return "", nil
+	case ReportTXT:
+		parts := make([]string, 0, len(results))
+		for _, result := range results {
+			parts = append(parts, renderTXT(result))
+		}
+		return strings.Join(parts, "\n"), nil
+	case ReportJSON:
+		if results == nil {
+			results = []Result{}
+		}
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	case ReportCSV, ReportJUnit, ReportMarkdown, ReportHTML:
+		return report.Render(format, reportDocument(results))
 	default:
 		return "", fmt.Errorf("gecersiz report formati: %s", format)
 	}
 }
 
+// reportDocument pipeline sonuçlarını ortak rapor modeline çevirir; her step bir satırdır.
+// Başarısız step'ten sonraki step'ler çalışmadığı için raporda yer almaz.
+func reportDocument(results []Result) report.Document {
+	doc := report.Document{Title: "Pipeline Report", Suite: "pipeline"}
+	failedInputs := 0
+	for _, result := range results {
+		if doc.StartedAt.IsZero() || result.StartedAt.Before(doc.StartedAt) {
+			doc.StartedAt = result.StartedAt
+		}
+		if result.EndedAt.After(doc.EndedAt) {
+			doc.EndedAt = result.EndedAt
+		}
+		failed := false
+		for _, s := range result.Steps {
+			item := report.Item{
+				Group:      result.Input,
+				Step:       fmt.Sprintf("#%d %s", s.Index, s.Type),
+				Input:      s.Input,
+				Output:     s.Output,
+				Format:     converter.DetectFormat(s.Output),
+				Status:     report.StatusSuccess,
+				Attempts:   1,
+				Duration:   s.Duration,
+				InputSize:  s.InputSize,
+				OutputSize: s.OutputSize,
+			}
+			doc.Total++
+			if s.Success {
+				doc.Succeeded++
+			} else {
+				item.Status = report.StatusFailed
+				item.Error = s.Error
+				doc.Failed++
+				failed = true
+			}
+			if s.Success && s.Output != result.FinalOutput {
+				// Ara çıktılar geçici klasördedir ve çalışma bitince silinir
+				item.Note = "ara çıktı"
+			}
+			doc.Items = append(doc.Items, item)
+		}
+		if failed {
+			failedInputs++
+		}
+	}
+	if !doc.StartedAt.IsZero() {
+		doc.Duration = doc.EndedAt.Sub(doc.StartedAt)
+	}
+	doc.Extra = []report.Field{
+		{Label: "Inputs", Value: fmt.Sprint(len(results))},
+		{Label: "Failed inputs", Value: fmt.Sprint(failedInputs)},
+	}
+	return doc
+}
+
 func renderTXT(result Result) string {
 	var b strings.Builder
 	b.WriteString("Pipeline Report\n")
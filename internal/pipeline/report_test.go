@@ -1,6 +1,9 @@
 package pipeline
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestNormalizeReportFormat(t *testing.T) {
 	if got := NormalizeReportFormat(""); got != ReportOff {
@@ -39,3 +42,72 @@ func TestRenderReport(t *testing.T) {
 		t.Fatalf("expected json report")
 	}
 }
+
+func TestRenderReportsMultipleInputs(t *testing.T) {
+	results := []Result{
+		{Input: "a.md", FinalOutput: "a.html"},
+		{Input: "b.md", FinalOutput: "b.html"},
+	}
+
+	txt, err := RenderReports(ReportTXT, results)
+	if err != nil {
+		t.Fatalf("RenderReports txt failed: %v", err)
+	}
+	if strings.Count(txt, "Pipeline Report") != 2 {
+		t.Fatalf("expected one section per input:\n%s", txt)
+	}
+
+	js, err := RenderReports(ReportJSON, results)
+	if err != nil {
+		t.Fatalf("RenderReports json failed: %v", err)
+	}
+	if !strings.HasPrefix(js, "[") || !strings.Contains(js, `"b.html"`) {
+		t.Fatalf("expected json array report:\n%s", js)
+	}
+}
+
+func TestRenderReportsExtraFormats(t *testing.T) {
+	results := []Result{
+		{
+			Input:       "a.md",
+			FinalOutput: "out/a.html",
+			Steps: []StepResult{
+				{Index: 1, Type: "convert", Input: "a.md", Output: "out/a.html", Success: true, InputSize: 10, OutputSize: 40},
+			},
+		},
+		{
+			Input: "b.md",
+			Steps: []StepResult{
+				{Index: 1, Type: "convert", Input: "b.md", Output: "tmp/b.html", Success: true},
+				{Index: 2, Type: "convert", Input: "tmp/b.html", Output: "out/b.pdf", Error: "pdf hatası"},
+			},
+		},
+	}
+
+	out, err := RenderReports(ReportCSV, results)
+	if err != nil {
+		t.Fatalf("RenderReports csv failed: %v", err)
+	}
+	if !strings.HasPrefix(out, "input,step,output") || strings.Count(out, "\n") != 4 {
+		t.Fatalf("expected one csv row per step:\n%s", out)
+	}
+	if !strings.Contains(out, "a.md,#1 convert,out/a.html,html,success,1,0,10,40,30") {
+		t.Fatalf("unexpected csv row:\n%s", out)
+	}
+
+	out, err = RenderReports(NormalizeReportFormat("junit"), results)
+	if err != nil {
+		t.Fatalf("RenderReports junit failed: %v", err)
+	}
+	if !strings.Contains(out, `classname="b.md" name="#2 convert"`) || !strings.Contains(out, "pdf hatası") {
+		t.Fatalf("unexpected junit report:\n%s", out)
+	}
+
+	out, err = RenderReport(ReportHTML, results[0])
+	if err != nil {
+		t.Fatalf("RenderReport html failed: %v", err)
+	}
+	if !strings.Contains(out, "Pipeline Report") || !strings.Contains(out, "300.0%") {
+		t.Fatalf("unexpected html report:\n%s", out)
+	}
+}
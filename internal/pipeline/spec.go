@@ -5,19 +5,31 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 )
 
 const (
 	StepConvert        = "convert"
 	StepAudioNormalize = "audio-normalize"
+	StepWatermark      = "watermark"
 )
 
 // Spec pipeline tanımını temsil eder.
 type Spec struct {
+	// Input tek dosya, dizin veya glob deseni olabilir; dizin/glob girdisinde
+	// pipeline seçilen her dosya için ayrı çalışır.
 	Input string `json:"input"`
 	// Output son adım için nihai çıktı dosya yolunu zorlar.
 	Output string `json:"output,omitempty"`
-	Steps  []Step `json:"steps"`
+	// NameTemplate son çıktının adlandırma şablonudur (ör: "{name}_{index:03}.{ext}").
+	NameTemplate string `json:"name_template,omitempty"`
+	// Recursive dizin girdisinde alt dizinlerin de taranmasını sağlar.
+	Recursive bool `json:"recursive,omitempty"`
+	// Filter dizin/glob girdisinde dosya seçim kurallarıdır (batch/watch ile aynı motor).
+	Filter *filter.Spec `json:"filter,omitempty"`
+	Steps  []Step       `json:"steps"`
 }
 
 // Step pipeline içindeki tek bir adımı temsil eder.
@@ -27,6 +39,15 @@ type Step struct {
 	// convert
 	To      string `json:"to,omitempty"`
 	Quality int    `json:"quality,omitempty"`
+	// TargetSize çıktı için hedef boyuttur (ör: "25mb"); video ve kayıplı seste iki geçişli encode yapılır.
+	TargetSize string `json:"target_size,omitempty"`
+	// Video kırpma/döndürme: crop "W:H:X:Y" veya "WxH+X+Y", aspect "9:16", rotate derece, flip horizontal/vertical/both.
+	Crop     string `json:"crop,omitempty"`
+	Aspect   string `json:"aspect,omitempty"`
+	Gravity  string `json:"gravity,omitempty"`
+	Rotate   int    `json:"rotate,omitempty"`
+	Flip     string `json:"flip,omitempty"`
+	AutoCrop bool   `json:"auto_crop,omitempty"`
 
 	// Ortak
 	Output       string `json:"output,omitempty"`
@@ -36,6 +57,14 @@ type Step struct {
 	TargetLUFS float64 `json:"target_lufs,omitempty"`
 	TargetTP   float64 `json:"target_tp,omitempty"`
 	TargetLRA  float64 `json:"target_lra,omitempty"`
+
+	// watermark
+	Watermark *converter.WatermarkSpec `json:"watermark,omitempty"`
+}
+
+// TransformSpec convert adımının kırpma/döndürme alanlarını converter spec'ine çevirir; alan yoksa nil döner.
+func (s Step) TransformSpec() (*converter.TransformSpec, error) {
+	return converter.BuildTransformSpec(s.Crop, s.Aspect, s.Gravity, s.Rotate, s.Flip, s.AutoCrop)
 }
 
 // LoadSpec JSON spec dosyasını yükler.
@@ -65,6 +94,17 @@ func ValidateSpec(s Spec) error {
 	if len(s.Steps) == 0 {
 		return fmt.Errorf("en az bir step gerekli")
 	}
+	if _, err := converter.ParseNameTemplate(s.NameTemplate); err != nil {
+		return fmt.Errorf("name_template gecersiz: %w", err)
+	}
+	if strings.TrimSpace(s.NameTemplate) != "" && strings.TrimSpace(s.Output) != "" {
+		return fmt.Errorf("output ve name_template birlikte kullanilamaz")
+	}
+	if s.Filter != nil {
+		if _, err := filter.Compile(*s.Filter); err != nil {
+			return fmt.Errorf("filter gecersiz: %w", err)
+		}
+	}
 
 	for i, step := range s.Steps {
 		t := strings.ToLower(strings.TrimSpace(step.Type))
@@ -76,8 +116,27 @@ func ValidateSpec(s Spec) error {
 			if strings.TrimSpace(step.To) == "" {
 				return fmt.Errorf("step[%d] convert icin to zorunlu", i)
 			}
+			if strings.TrimSpace(step.TargetSize) != "" {
+				if _, err := converter.ParseSize(step.TargetSize); err != nil {
+					return fmt.Errorf("step[%d] target_size gecersiz: %w", i, err)
+				}
+			}
+			if _, err := step.TransformSpec(); err != nil {
+				return fmt.Errorf("step[%d] kirpma/dondurme gecersiz: %w", i, err)
+			}
 		case StepAudioNormalize:
 			// opsiyonel alanlar runtime'da defaultlanır.
+		case StepWatermark:
+			if step.Watermark.IsZero() {
+				return fmt.Errorf("step[%d] watermark icin image, text veya burn zorunlu", i)
+			}
+			wm := *step.Watermark
+			if err := converter.ValidateWatermarkSpec(&wm); err != nil {
+				return fmt.Errorf("step[%d] watermark gecersiz: %w", i, err)
+			}
+			if to := strings.TrimSpace(step.To); to != "" && !converter.IsVideoFormat(to) {
+				return fmt.Errorf("step[%d] watermark icin to video formati olmali: %s", i, step.To)
+			}
 		default:
 			return fmt.Errorf("step[%d] desteklenmeyen type: %s", i, step.Type)
 		}
@@ -85,3 +144,40 @@ func ValidateSpec(s Spec) error {
 
 	return nil
 }
+
+// ResolveInputs spec girdisini çalıştırılacak dosya listesine açar. Dizin girdisi filtre
+// kurallarıyla taranır, glob deseni genişletilir; tek dosya olduğu gibi döner.
+// multi dizin/glob girdisinde true olur; bu modda sabit çıktı yolları kullanılamaz.
+func ResolveInputs(s Spec) (inputs []string, multi bool, err error) {
+	info, statErr := os.Stat(s.Input)
+	isDir := statErr == nil && info.IsDir()
+	isGlob := statErr != nil && strings.ContainsAny(s.Input, "*?[")
+	if !isDir && !isGlob {
+		return []string{s.Input}, false, nil
+	}
+
+	if strings.TrimSpace(s.Output) != "" {
+		return nil, true, fmt.Errorf("dizin/glob girdisinde output kullanilamaz")
+	}
+	for i, step := range s.Steps {
+		if strings.TrimSpace(step.Output) != "" {
+			return nil, true, fmt.Errorf("step[%d] dizin/glob girdisinde output kullanilamaz", i)
+		}
+	}
+
+	var f *filter.Filter
+	if s.Filter != nil {
+		if f, err = filter.Compile(*s.Filter); err != nil {
+			return nil, true, fmt.Errorf("filter gecersiz: %w", err)
+		}
+	}
+	if isDir {
+		inputs, err = f.Collect(s.Input, s.Recursive, nil)
+	} else {
+		inputs, err = f.ExpandGlob(s.Input)
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	return inputs, true, nil
+}
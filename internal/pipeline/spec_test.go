@@ -4,6 +4,9 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 )
 
 func TestLoadSpec(t *testing.T) {
@@ -44,3 +47,133 @@ func TestValidateSpecErrors(t *testing.T) {
 		t.Fatalf("expected error for convert without to")
 	}
 }
+
+func TestValidateSpecTargetSize(t *testing.T) {
+	valid := Spec{Input: "in.mov", Steps: []Step{{Type: "convert", To: "mp4", TargetSize: "25mb"}}}
+	if err := ValidateSpec(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := Spec{Input: "in.mov", Steps: []Step{{Type: "convert", To: "mp4", TargetSize: "yirmi"}}}
+	if err := ValidateSpec(invalid); err == nil {
+		t.Fatalf("expected error for invalid target_size")
+	}
+}
+
+func TestValidateSpecWatermark(t *testing.T) {
+	s := Spec{
+		Input: "in.mp4",
+		Steps: []Step{{Type: StepWatermark, Watermark: &converter.WatermarkSpec{Burn: "timecode", Position: "top-right"}}},
+	}
+	if err := ValidateSpec(s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.Steps[0].Watermark = nil
+	if err := ValidateSpec(s); err == nil {
+		t.Fatalf("expected error for empty watermark")
+	}
+
+	s.Steps[0].Watermark = &converter.WatermarkSpec{Text: "x", Position: "nowhere"}
+	if err := ValidateSpec(s); err == nil {
+		t.Fatalf("expected error for invalid position")
+	}
+
+	s.Steps[0].Watermark = &converter.WatermarkSpec{Text: "x"}
+	s.Steps[0].To = "mp3"
+	if err := ValidateSpec(s); err == nil {
+		t.Fatalf("expected error for non-video target")
+	}
+}
+
+func TestValidateSpecTransform(t *testing.T) {
+	s := Spec{
+		Input: "in.mp4",
+		Steps: []Step{{Type: StepConvert, To: "mp4", Aspect: "9:16", Gravity: "top", Rotate: 90}},
+	}
+	if err := ValidateSpec(s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.Steps[0].Crop = "1280x"
+	if err := ValidateSpec(s); err == nil {
+		t.Fatalf("expected error for invalid crop")
+	}
+
+	s.Steps[0].Crop = "640:360"
+	s.Steps[0].AutoCrop = true
+	if err := ValidateSpec(s); err == nil {
+		t.Fatalf("expected error for crop with auto_crop")
+	}
+}
+
+func TestResolveInputsDirectoryWithFilter(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.md", "b.md", "draft-c.md", "notes.txt", "sub/d.md"} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("mkdir failed: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("# x"), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+
+	spec := Spec{
+		Input:     dir,
+		Recursive: true,
+		Filter:    &filter.Spec{Include: []string{"*.md"}, Exclude: []string{"draft-*"}},
+		Steps:     []Step{{Type: StepConvert, To: "html"}},
+	}
+	if err := ValidateSpec(spec); err != nil {
+		t.Fatalf("ValidateSpec failed: %v", err)
+	}
+	inputs, multi, err := ResolveInputs(spec)
+	if err != nil {
+		t.Fatalf("ResolveInputs failed: %v", err)
+	}
+	want := []string{filepath.Join(dir, "a.md"), filepath.Join(dir, "b.md"), filepath.Join(dir, "sub", "d.md")}
+	if !multi || len(inputs) != len(want) {
+		t.Fatalf("unexpected inputs: %v (multi=%v)", inputs, multi)
+	}
+	for i := range want {
+		if inputs[i] != want[i] {
+			t.Fatalf("unexpected inputs: %v, want %v", inputs, want)
+		}
+	}
+
+	spec.Output = filepath.Join(dir, "out.html")
+	if _, _, err := ResolveInputs(spec); err == nil {
+		t.Fatalf("expected error for fixed output with directory input")
+	}
+
+	single := Spec{Input: filepath.Join(dir, "a.md"), Steps: spec.Steps}
+	inputs, multi, err = ResolveInputs(single)
+	if err != nil || multi || len(inputs) != 1 {
+		t.Fatalf("unexpected single input result: %v %v %v", inputs, multi, err)
+	}
+}
+
+func TestValidateSpecFilter(t *testing.T) {
+	err := ValidateSpec(Spec{
+		Input:  "in",
+		Filter: &filter.Spec{MinSize: "abc"},
+		Steps:  []Step{{Type: StepConvert, To: "html"}},
+	})
+	if err == nil {
+		t.Fatalf("expected error for invalid filter")
+	}
+}
+
+func TestValidateSpecNameTemplate(t *testing.T) {
+	steps := []Step{{Type: StepConvert, To: "html"}}
+	if err := ValidateSpec(Spec{Input: "in", NameTemplate: "{name}_{index:03}", Steps: steps}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ValidateSpec(Spec{Input: "in", NameTemplate: "{size}", Steps: steps}); err == nil {
+		t.Fatalf("expected error for unknown template variable")
+	}
+	if err := ValidateSpec(Spec{Input: "in", Output: "out.html", NameTemplate: "{name}", Steps: steps}); err == nil {
+		t.Fatalf("expected error when output and name_template are both set")
+	}
+}
@@ -25,6 +25,19 @@ type Definition struct {
 	Unit         string
 	DPI          *float64
 	MetadataMode string
+	SampleRate   *int
+	BitDepth     string
+	Channels     *int
+	AudioBitrate string
+	BitrateMode  string
+	AudioCodec   string
+	VideoCodec   string
+	CRF          *int
+	VideoBitrate string
+	EncodePreset string
+	PixFmt       string
+	// Watermark video çıktılarına uygulanacak logo/yazı/burn ayarlarıdır (ör. marka logosu).
+	Watermark *converter.WatermarkSpec
 }
 
 var builtins = map[string]Definition{
@@ -48,6 +61,16 @@ var builtins = map[string]Definition{
 		Report:       batch.ReportTXT,
 		MetadataMode: converter.MetadataPreserve,
 	},
+	"review-copy": {
+		Name:         "review-copy",
+		Quality:      intPtr(70),
+		OnConflict:   converter.ConflictVersioned,
+		Retry:        intPtr(1),
+		RetryDelay:   durationPtr(500 * time.Millisecond),
+		Report:       batch.ReportOff,
+		MetadataMode: converter.MetadataStrip,
+		Watermark:    &converter.WatermarkSpec{Burn: converter.WatermarkBurnBoth},
+	},
 	"archive-lossless": {
 		Name:         "archive-lossless",
 		Quality:      intPtr(100),
@@ -74,7 +97,7 @@ func Resolve(name string) (Definition, error) {
 
 // Names built-in profil isimlerini döner.
 func Names() []string {
-	return []string{"social-story", "podcast-clean", "archive-lossless"}
+	return []string{"social-story", "podcast-clean", "review-copy", "archive-lossless"}
 }
 
 func intPtr(v int) *int { return &v }
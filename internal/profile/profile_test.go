@@ -3,7 +3,7 @@ package profile
 import "testing"
 
 func TestResolveBuiltins(t *testing.T) {
-	tests := []string{"social-story", "podcast-clean", "archive-lossless"}
+	tests := []string{"social-story", "podcast-clean", "review-copy", "archive-lossless"}
 	for _, name := range tests {
 		p, err := Resolve(name)
 		if err != nil {
@@ -28,3 +28,13 @@ func TestNamesCount(t *testing.T) {
 		t.Fatalf("expected at least 3 built-in profiles")
 	}
 }
+
+func TestReviewCopyBurnsTimecode(t *testing.T) {
+	p, err := Resolve("review-copy")
+	if err != nil {
+		t.Fatalf("Resolve(review-copy) failed: %v", err)
+	}
+	if p.Watermark.IsZero() || p.Watermark.Burn == "" {
+		t.Fatalf("expected review-copy to carry a burn watermark, got %+v", p.Watermark)
+	}
+}
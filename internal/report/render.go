@@ -0,0 +1,445 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func renderCSV(doc Document) (string, error) {
+	steps := doc.hasSteps()
+	header := []string{"input"}
+	if steps {
+		header = append(header, "step")
+	}
+	header = append(header, "output", "format", "status", "attempts", "duration_ms", "input_size", "output_size", "size_delta", "reason", "error", "note")
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	for _, it := range doc.Items {
+		row := []string{it.Input}
+		if steps {
+			row = append(row, it.Step)
+		}
+		delta := ""
+		if d, ok := it.sizeDelta(); ok {
+			delta = strconv.FormatInt(d, 10)
+		}
+		row = append(row,
+			it.Output,
+			it.Format,
+			it.Status,
+			intOrEmpty(int64(it.Attempts)),
+			strconv.FormatInt(it.Duration.Milliseconds(), 10),
+			intOrEmpty(it.InputSize),
+			intOrEmpty(it.OutputSize),
+			delta,
+			it.Reason,
+			it.Error,
+			it.Note,
+		)
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+func intOrEmpty(v int64) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name      string      `xml:"name,attr"`
+	Tests     int         `xml:"tests,attr"`
+	Failures  int         `xml:"failures,attr"`
+	Errors    int         `xml:"errors,attr"`
+	Skipped   int         `xml:"skipped,attr"`
+	Time      string      `xml:"time,attr"`
+	Timestamp string      `xml:"timestamp,attr,omitempty"`
+	Cases     []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+func junitSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
+
+// renderJUnit her satırı bir testcase olarak yazar; başarısız satırlar failure,
+// atlananlar skipped olur. CI araçları raporu test sonucu gibi gösterebilir.
+func renderJUnit(doc Document) (string, error) {
+	suite := junitSuite{
+		Name:     doc.Suite,
+		Tests:    len(doc.Items),
+		Failures: doc.Failed,
+		Skipped:  doc.Skipped,
+		Time:     junitSeconds(doc.Duration),
+		Cases:    make([]junitCase, 0, len(doc.Items)),
+	}
+	if !doc.StartedAt.IsZero() {
+		suite.Timestamp = doc.StartedAt.Format("2006-01-02T15:04:05")
+	}
+	for _, it := range doc.Items {
+		tc := junitCase{
+			ClassName: it.Group,
+			Name:      it.testName(),
+			Time:      junitSeconds(it.Duration),
+			SystemOut: junitSystemOut(it),
+		}
+		switch it.Status {
+		case StatusFailed:
+			msg := it.Error
+			if msg == "" {
+				msg = "bilinmeyen hata"
+			}
+			tc.Failure = &junitMessage{Message: msg, Type: "conversion", Body: msg}
+		case StatusSkipped:
+			tc.Skipped = &junitMessage{Message: it.Reason}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	suites := junitSuites{
+		Name:     doc.Title,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Time:     suite.Time,
+		Suites:   []junitSuite{suite},
+	}
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(data) + "\n", nil
+}
+
+func junitSystemOut(it Item) string {
+	var lines []string
+	if it.Output != "" && it.Step != "" {
+		lines = append(lines, "output: "+it.Output)
+	}
+	if it.Attempts > 1 {
+		lines = append(lines, fmt.Sprintf("attempts: %d", it.Attempts))
+	}
+	if it.OutputSize > 0 {
+		lines = append(lines, fmt.Sprintf("output_size: %d", it.OutputSize))
+	}
+	if it.Note != "" {
+		lines = append(lines, it.Note)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownCell tablo hücresini bozan karakterleri kaçırır.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r", "")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func renderMarkdown(doc Document) string {
+	var b strings.Builder
+	b.WriteString("# " + doc.Title + "\n\n")
+	b.WriteString("| | |\n|---|---|\n")
+	if !doc.StartedAt.IsZero() {
+		b.WriteString(fmt.Sprintf("| Started | %s |\n", doc.StartedAt.Format(time.RFC3339)))
+		b.WriteString(fmt.Sprintf("| Ended | %s |\n", doc.EndedAt.Format(time.RFC3339)))
+	}
+	b.WriteString(fmt.Sprintf("| Duration | %s |\n", humanDuration(doc.Duration)))
+	b.WriteString(fmt.Sprintf("| Total | %d |\n", doc.Total))
+	b.WriteString(fmt.Sprintf("| Succeeded | %d |\n", doc.Succeeded))
+	b.WriteString(fmt.Sprintf("| Skipped | %d |\n", doc.Skipped))
+	b.WriteString(fmt.Sprintf("| Failed | %d |\n", doc.Failed))
+	for _, f := range doc.Extra {
+		b.WriteString(fmt.Sprintf("| %s | %s |\n", markdownCell(f.Label), markdownCell(f.Value)))
+	}
+
+	steps := doc.hasSteps()
+	b.WriteString("\n## Items\n\n")
+	if steps {
+		b.WriteString("| Status | Input | Step | Output | Duration | Input size | Output size | Δ size | Details |\n")
+		b.WriteString("|---|---|---|---|---:|---:|---:|---:|---|\n")
+	} else {
+		b.WriteString("| Status | Input | Output | Format | Duration | Input size | Output size | Δ size | Details |\n")
+		b.WriteString("|---|---|---|---|---:|---:|---:|---:|---|\n")
+	}
+	for _, it := range doc.Items {
+		middle := markdownCell(it.Format)
+		if steps {
+			middle = markdownCell(it.Step)
+		}
+		cells := []string{
+			statusIcon(it.Status) + " " + it.Status,
+			"`" + markdownCell(it.Input) + "`",
+		}
+		if steps {
+			cells = append(cells, middle, codeOrDash(it.Output))
+		} else {
+			cells = append(cells, codeOrDash(it.Output), middle)
+		}
+		cells = append(cells,
+			humanDuration(it.Duration),
+			humanSize(it.InputSize),
+			humanSize(it.OutputSize),
+			it.deltaLabel(),
+			markdownCell(itemDetails(it)),
+		)
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	if doc.Failed > 0 {
+		b.WriteString("\n## Errors\n\n")
+		for _, it := range doc.Items {
+			if it.Status != StatusFailed {
+				continue
+			}
+			label := it.Input
+			if it.Step != "" {
+				label += " " + it.Step
+			} else if it.Output != "" {
+				label += " -> " + it.Output
+			}
+			b.WriteString(fmt.Sprintf("- `%s`: %s\n", markdownCell(label), markdownCell(it.Error)))
+		}
+	}
+	return b.String()
+}
+
+func codeOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return "`" + markdownCell(s) + "`"
+}
+
+func statusIcon(status string) string {
+	switch status {
+	case StatusSuccess:
+		return "✅"
+	case StatusSkipped:
+		return "⏭️"
+	default:
+		return "❌"
+	}
+}
+
+// itemDetails satırın deneme, atlanma sebebi, hata ve not bilgilerini tek metinde toplar.
+func itemDetails(it Item) string {
+	var parts []string
+	if it.Attempts > 1 {
+		parts = append(parts, fmt.Sprintf("attempts=%d", it.Attempts))
+	}
+	if it.Reason != "" {
+		parts = append(parts, "reason="+it.Reason)
+	}
+	if it.Error != "" {
+		parts = append(parts, "error="+it.Error)
+	}
+	if it.Note != "" {
+		parts = append(parts, it.Note)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// fileURL çıktı dosyası için file:// bağlantısı üretir; rapor başka bir klasöre
+// yazılsa da bağlantılar çalışır.
+func fileURL(path string) string {
+	if path == "" {
+		return ""
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	abs = filepath.ToSlash(abs)
+	if !strings.HasPrefix(abs, "/") {
+		// Windows sürücü harfli yollar (C:/...) için
+		abs = "/" + abs
+	}
+	return (&url.URL{Scheme: "file", Path: abs}).String()
+}
+
+// htmlChart özet grafiğindeki bir durum dilimidir.
+type htmlChart struct {
+	Label   string
+	Class   string
+	Count   int
+	Percent float64
+	Offset  float64
+}
+
+// htmlRow şablona verilen hazırlanmış satırdır.
+type htmlRow struct {
+	Item
+	Link    template.URL
+	Delta   string
+	Shrunk  bool
+	TimeBar float64
+	Details string
+}
+
+type htmlData struct {
+	Document
+	Chart       []htmlChart
+	Rows        []htmlRow
+	Failures    []htmlRow
+	HasSteps    bool
+	InputTotal  int64
+	OutputTotal int64
+	TotalDelta  string
+}
+
+func buildHTMLData(doc Document) htmlData {
+	data := htmlData{Document: doc, HasSteps: doc.hasSteps()}
+
+	var offset float64
+	for _, c := range []htmlChart{
+		{Label: "Başarılı", Class: "success", Count: doc.Succeeded},
+		{Label: "Atlanan", Class: "skipped", Count: doc.Skipped},
+		{Label: "Başarısız", Class: "failed", Count: doc.Failed},
+	} {
+		if doc.Total > 0 {
+			c.Percent = float64(c.Count) * 100 / float64(doc.Total)
+		}
+		c.Offset = offset
+		offset += c.Percent
+		data.Chart = append(data.Chart, c)
+	}
+
+	var longest time.Duration
+	for _, it := range doc.Items {
+		longest = max(longest, it.Duration)
+	}
+	for _, it := range doc.Items {
+		row := htmlRow{Item: it, Delta: it.deltaLabel(), Details: itemDetails(it)}
+		if it.Status == StatusSuccess && it.Output != "" {
+			row.Link = template.URL(fileURL(it.Output))
+		}
+		if d, ok := it.sizeDelta(); ok {
+			row.Shrunk = d < 0
+			data.InputTotal += it.InputSize
+			data.OutputTotal += it.OutputSize
+		}
+		if longest > 0 {
+			row.TimeBar = float64(it.Duration) * 100 / float64(longest)
+		}
+		data.Rows = append(data.Rows, row)
+		if it.Status == StatusFailed {
+			data.Failures = append(data.Failures, row)
+		}
+	}
+	if data.InputTotal > 0 {
+		total := Item{InputSize: data.InputTotal, OutputSize: data.OutputTotal}
+		data.TotalDelta = total.deltaLabel()
+	}
+	return data
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"size":     humanSize,
+	"duration": humanDuration,
+	"rfc3339":  func(t time.Time) string { return t.Format(time.RFC3339) },
+	"pct":      func(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) },
+}).Parse(`<!DOCTYPE html>
+<html lang="tr">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body{font-family:-apple-system,Segoe UI,Roboto,sans-serif;margin:2rem;color:#1e293b;background:#f8fafc}
+h1{margin-bottom:.2rem}h2{margin-top:2rem;border-bottom:2px solid #e2e8f0;padding-bottom:.3rem}
+.meta{color:#64748b}
+.cards{display:flex;gap:1rem;flex-wrap:wrap;margin-top:1rem}
+.card{background:#fff;border:1px solid #e2e8f0;border-radius:8px;padding:.8rem 1.2rem;min-width:140px}
+.card b{display:block;font-size:1.4rem}
+.chart{margin-top:1.5rem;background:#fff;border:1px solid #e2e8f0;border-radius:8px;padding:1rem}
+.legend{display:flex;gap:1.2rem;margin-top:.6rem;font-size:.9rem}
+.legend span::before{content:"";display:inline-block;width:.8rem;height:.8rem;border-radius:2px;margin-right:.35rem;vertical-align:-1px;background:currentColor}
+.success{color:#16a34a}.skipped{color:#94a3b8}.failed{color:#dc2626}
+rect.success{fill:#16a34a}rect.skipped{fill:#94a3b8}rect.failed{fill:#dc2626}
+table{border-collapse:collapse;width:100%;background:#fff;font-size:.9rem}
+th,td{border:1px solid #e2e8f0;padding:.35rem .6rem;text-align:left;vertical-align:top}
+th{background:#f1f5f9}
+td.num{text-align:right;font-variant-numeric:tabular-nums;white-space:nowrap}
+td.shrunk{color:#16a34a}td.grown{color:#b45309}
+tr.failed td{background:#fef2f2}tr.skipped td{color:#64748b}
+.bar{height:.35rem;background:#3b82f6;border-radius:2px;margin-top:.2rem}
+.status{font-weight:600;text-transform:uppercase;font-size:.75rem}
+pre{white-space:pre-wrap;margin:0;font-size:.85rem}
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="meta">{{if not .StartedAt.IsZero}}{{rfc3339 .StartedAt}} — {{rfc3339 .EndedAt}}{{end}}</div>
+<div class="cards">
+<div class="card">Toplam<b>{{.Total}}</b></div>
+<div class="card success">Başarılı<b>{{.Succeeded}}</b></div>
+<div class="card skipped">Atlanan<b>{{.Skipped}}</b></div>
+<div class="card failed">Başarısız<b>{{.Failed}}</b></div>
+<div class="card">Süre<b>{{duration .Duration}}</b></div>
+{{if .InputTotal}}<div class="card">Boyut<b>{{size .InputTotal}} → {{size .OutputTotal}}</b>{{.TotalDelta}}</div>{{end}}
+{{range .Extra}}<div class="card">{{.Label}}<b>{{.Value}}</b></div>{{end}}
+</div>
+<div class="chart">
+<svg width="100%" height="28" viewBox="0 0 100 4" preserveAspectRatio="none" role="img" aria-label="Durum dağılımı">
+{{range .Chart}}{{if .Count}}<rect class="{{.Class}}" x="{{pct .Offset}}" y="0" width="{{pct .Percent}}" height="4"><title>{{.Label}}: {{.Count}}</title></rect>{{end}}{{end}}
+</svg>
+<div class="legend">{{range .Chart}}<span class="{{.Class}}">{{.Label}}: {{.Count}} ({{pct .Percent}}%)</span>{{end}}</div>
+</div>
+{{if .Failures}}<h2>Hatalar</h2>
+<table><tr><th>Girdi</th>{{if .HasSteps}}<th>Step</th>{{end}}<th>Çıktı</th><th>Hata</th></tr>
+{{range .Failures}}<tr class="failed"><td>{{.Input}}</td>{{if $.HasSteps}}<td>{{.Step}}</td>{{end}}<td>{{.Output}}</td><td><pre>{{.Error}}</pre></td></tr>
+{{end}}</table>{{end}}
+<h2>İşler</h2>
+<table><tr><th>Durum</th><th>Girdi</th>{{if .HasSteps}}<th>Step</th>{{end}}<th>Çıktı</th><th>Format</th><th>Süre</th><th>Girdi boyutu</th><th>Çıktı boyutu</th><th>Fark</th><th>Ayrıntı</th></tr>
+{{range .Rows}}<tr class="{{.Status}}"><td class="status {{.Status}}">{{.Status}}</td><td>{{.Input}}</td>{{if $.HasSteps}}<td>{{.Step}}</td>{{end}}<td>{{if .Link}}<a href="{{.Link}}">{{.Output}}</a>{{else}}{{.Output}}{{end}}</td><td>{{.Format}}</td><td class="num">{{duration .Duration}}<div class="bar" style="width:{{pct .TimeBar}}%"></div></td><td class="num">{{size .InputSize}}</td><td class="num">{{size .OutputSize}}</td><td class="num {{if .Delta}}{{if .Shrunk}}shrunk{{else}}grown{{end}}{{end}}">{{.Delta}}</td><td>{{.Details}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+func renderHTML(doc Document) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, buildHTMLData(doc)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
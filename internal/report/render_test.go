@@ -0,0 +1,161 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleDocument() Document {
+	return Document{
+		Title:     "Batch Report",
+		Suite:     "batch",
+		StartedAt: time.Unix(0, 0),
+		EndedAt:   time.Unix(3, 0),
+		Duration:  3 * time.Second,
+		Total:     3,
+		Succeeded: 1,
+		Skipped:   1,
+		Failed:    1,
+		Extra:     []Field{{Label: "Cache hit", Value: "0"}},
+		Items: []Item{
+			{Group: "a.png", Input: "a.png", Output: "out/a.webp", Format: "webp", Status: StatusSuccess, Attempts: 1, Duration: 2 * time.Second, InputSize: 1000, OutputSize: 400},
+			{Group: "b.png", Input: "b.png", Output: "out/b.webp", Format: "webp", Status: StatusSkipped, Reason: "output_exists"},
+			{Group: "c.png", Input: "c.png", Output: "out/c.webp", Format: "webp", Status: StatusFailed, Attempts: 3, Duration: time.Second, InputSize: 500, Error: "decode <hata> | bozuk"},
+		},
+	}
+}
+
+func TestNormalizeFormat(t *testing.T) {
+	cases := map[string]string{
+		"CSV":       FormatCSV,
+		"junit-xml": FormatJUnit,
+		"md":        FormatMarkdown,
+		" html ":    FormatHTML,
+		"txt":       "",
+	}
+	for in, want := range cases {
+		if got := NormalizeFormat(in); got != want {
+			t.Fatalf("NormalizeFormat(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if _, err := Render("txt", Document{}); err == nil {
+		t.Fatalf("expected unsupported format to fail")
+	}
+}
+
+func TestRenderCSV(t *testing.T) {
+	out, err := Render(FormatCSV, sampleDocument())
+	if err != nil {
+		t.Fatalf("Render csv failed: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid csv: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("expected header + 3 rows, got %d", len(records))
+	}
+	if records[0][1] != "output" {
+		t.Fatalf("batch csv should not have a step column: %v", records[0])
+	}
+	// input, output, format, status, attempts, duration_ms, input_size, output_size, size_delta
+	if got := records[1]; got[5] != "2000" || got[8] != "-600" {
+		t.Fatalf("unexpected success row: %v", got)
+	}
+	if got := records[3]; got[3] != StatusFailed || got[10] != "decode <hata> | bozuk" {
+		t.Fatalf("unexpected failed row: %v", got)
+	}
+
+	doc := sampleDocument()
+	doc.Items[0].Step = "#1 convert"
+	out, err = Render(FormatCSV, doc)
+	if err != nil {
+		t.Fatalf("Render csv failed: %v", err)
+	}
+	if !strings.HasPrefix(out, "input,step,output") {
+		t.Fatalf("expected step column for pipeline rows:\n%s", out)
+	}
+}
+
+func TestRenderJUnit(t *testing.T) {
+	out, err := Render(FormatJUnit, sampleDocument())
+	if err != nil {
+		t.Fatalf("Render junit failed: %v", err)
+	}
+	var parsed struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				ClassName string    `xml:"classname,attr"`
+				Name      string    `xml:"name,attr"`
+				Failure   *struct{} `xml:"failure"`
+				Skipped   *struct{} `xml:"skipped"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("invalid junit xml: %v\n%s", err, out)
+	}
+	if parsed.Tests != 3 || parsed.Failures != 1 || len(parsed.Suites) != 1 || parsed.Suites[0].Name != "batch" {
+		t.Fatalf("unexpected junit summary: %+v", parsed)
+	}
+	cases := parsed.Suites[0].Cases
+	if cases[0].ClassName != "a.png" || cases[0].Name != "out/a.webp" || cases[0].Failure != nil {
+		t.Fatalf("unexpected success case: %+v", cases[0])
+	}
+	if cases[1].Skipped == nil || cases[2].Failure == nil {
+		t.Fatalf("expected skipped and failure elements: %+v", cases)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	out, err := Render(FormatMarkdown, sampleDocument())
+	if err != nil {
+		t.Fatalf("Render markdown failed: %v", err)
+	}
+	for _, want := range []string{"# Batch Report", "| Cache hit | 0 |", "-60.0%", "## Errors", `decode <hata> \| bozuk`} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("markdown missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	out, err := Render(FormatHTML, sampleDocument())
+	if err != nil {
+		t.Fatalf("Render html failed: %v", err)
+	}
+	for _, want := range []string{
+		"<svg",
+		`<rect class="failed"`,
+		"<h2>Hatalar</h2>",
+		"decode &lt;hata&gt; | bozuk",
+		`href="file:///`,
+		"out/a.webp</a>",
+		"-60.0%",
+		"1000 B → 400 B",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("html missing %q:\n%s", want, out)
+		}
+	}
+	// Başarısız ve atlanan çıktılar bağlantı olmamalı
+	if strings.Contains(out, "out/c.webp</a>") || strings.Contains(out, "out/b.webp</a>") {
+		t.Fatalf("only successful outputs should be linked")
+	}
+}
+
+func TestFileURL(t *testing.T) {
+	got := fileURL("/tmp/çıktı dir/a.webp")
+	if got != "file:///tmp/%C3%A7%C4%B1kt%C4%B1%20dir/a.webp" {
+		t.Fatalf("unexpected file url: %s", got)
+	}
+	if fileURL("") != "" {
+		t.Fatalf("expected empty url for empty path")
+	}
+}
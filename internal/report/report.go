@@ -0,0 +1,173 @@
+// Package report batch ve pipeline sonuçlarını ortak bir tablo modeline çevirip
+// CSV, JUnit XML, Markdown ve tek dosyalık HTML olarak yazar. TXT ve JSON raporları
+// kendi paketlerinde kalır; bu paket yalnızca ek formatları üretir.
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV      = "csv"
+	FormatJUnit    = "junit"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Item durumları.
+const (
+	StatusSuccess = "success"
+	StatusSkipped = "skipped"
+	StatusFailed  = "failed"
+)
+
+// NormalizeFormat bu paketin ürettiği formatları normalize eder; tanımıyorsa boş döner.
+func NormalizeFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case FormatCSV:
+		return FormatCSV
+	case FormatJUnit, "junit-xml", "xml":
+		return FormatJUnit
+	case FormatMarkdown, "md":
+		return FormatMarkdown
+	case FormatHTML, "htm":
+		return FormatHTML
+	default:
+		return ""
+	}
+}
+
+// Document rapora dönüştürülecek çalıştırmanın özeti ve satırlarıdır.
+type Document struct {
+	// Title rapor başlığıdır (ör: "Batch Report").
+	Title string
+	// Suite JUnit testsuite adıdır (ör: "batch").
+	Suite     string
+	StartedAt time.Time
+	EndedAt   time.Time
+	Duration  time.Duration
+	Total     int
+	Succeeded int
+	Skipped   int
+	Failed    int
+	// Extra özet tablosuna eklenecek ek satırlardır (ör: önbellek isabeti).
+	Extra []Field
+	Items []Item
+}
+
+// Field özet tablosunda etiket/değer çiftidir.
+type Field struct {
+	Label string
+	Value string
+}
+
+// Item raporun tek satırıdır: batch'te bir çıktı, pipeline'da bir step.
+type Item struct {
+	// Group satırın ait olduğu ana girdidir; JUnit'te classname olarak kullanılır.
+	Group string
+	// Step pipeline step etiketidir (ör: "#1 convert"); batch'te boştur.
+	Step       string
+	Input      string
+	Output     string
+	Format     string
+	Status     string
+	Attempts   int
+	Duration   time.Duration
+	InputSize  int64
+	OutputSize int64
+	// Reason atlanma sebebidir.
+	Reason string
+	Error  string
+	// Note satıra eklenecek ek bilgidir (ör: kaynak dosya eylemi).
+	Note string
+}
+
+// Render dokümanı istenen formatta metne çevirir.
+func Render(format string, doc Document) (string, error) {
+	switch NormalizeFormat(format) {
+	case FormatCSV:
+		return renderCSV(doc)
+	case FormatJUnit:
+		return renderJUnit(doc)
+	case FormatMarkdown:
+		return renderMarkdown(doc), nil
+	case FormatHTML:
+		return renderHTML(doc)
+	default:
+		return "", fmt.Errorf("gecersiz report formati: %s", format)
+	}
+}
+
+// hasSteps satırlardan en az biri pipeline step'i ise true döner.
+func (d Document) hasSteps() bool {
+	for _, it := range d.Items {
+		if it.Step != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// sizeDelta çıktı ile girdi boyutu arasındaki farkı döner; boyutlardan biri bilinmiyorsa ok=false.
+func (it Item) sizeDelta() (int64, bool) {
+	if it.InputSize <= 0 || it.OutputSize <= 0 {
+		return 0, false
+	}
+	return it.OutputSize - it.InputSize, true
+}
+
+// deltaLabel boyut farkını yüzde olarak döner (ör: "-42.1%").
+func (it Item) deltaLabel() string {
+	delta, ok := it.sizeDelta()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%+.1f%%", float64(delta)*100/float64(it.InputSize))
+}
+
+// testName JUnit testcase adıdır.
+func (it Item) testName() string {
+	switch {
+	case it.Step != "":
+		return it.Step
+	case it.Output != "":
+		return it.Output
+	case it.Format != "":
+		return it.Format
+	default:
+		return it.Input
+	}
+}
+
+// humanSize byte değerini okunabilir hale getirir (ör: 1.5 MB); 0 için boş döner.
+func humanSize(bytes int64) string {
+	if bytes <= 0 {
+		return ""
+	}
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+// humanDuration süreyi rapor tablolarına uygun kısalıkta yazar.
+func humanDuration(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "0s"
+	case d < time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	case d < time.Second:
+		return d.Round(time.Millisecond).String()
+	default:
+		return d.Round(10 * time.Millisecond).String()
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // Color ANSI renk kodları
@@ -125,12 +126,12 @@ func PrintTable(headers []string, rows [][]string) {
 	// Sütun genişliklerini hesapla
 	colWidths := make([]int, len(headers))
 	for i, h := range headers {
-		colWidths[i] = len(h)
+		colWidths[i] = utf8.RuneCountInString(h)
 	}
 	for _, row := range rows {
 		for i, cell := range row {
-			if i < len(colWidths) && len(cell) > colWidths[i] {
-				colWidths[i] = len(cell)
+			if i < len(colWidths) && utf8.RuneCountInString(cell) > colWidths[i] {
+				colWidths[i] = utf8.RuneCountInString(cell)
 			}
 		}
 	}
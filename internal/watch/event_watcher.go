@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 )
 
 // EventWatcher fsnotify ile event-driven izleme sağlar.
@@ -79,6 +81,9 @@ func (w *EventWatcher) Close() error {
 
 func (w *EventWatcher) Mode() string { return "event+polling" }
 
+// SetFilter dosya seçim kurallarını polling katmanına iletir.
+func (w *EventWatcher) SetFilter(f *filter.Filter) { w.poller.SetFilter(f) }
+
 func (w *EventWatcher) loop() {
 	for {
 		select {
@@ -3,10 +3,10 @@ package watch
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/mlihgenel/fileconverter-cli/internal/converter"
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 )
 
 type fileState struct {
@@ -23,6 +23,7 @@ type Engine interface {
 	Events() <-chan struct{}
 	Close() error
 	Mode() string
+	SetFilter(f *filter.Filter)
 }
 
 // Watcher polling tabanlı dosya izleyicisidir.
@@ -31,6 +32,8 @@ type Watcher struct {
 	From      string
 	Recursive bool
 	SettleFor time.Duration
+	// Filter dosya seçim kuralları; nil ise varsayılan kurallar uygulanır.
+	Filter *filter.Filter
 
 	states map[string]fileState
 }
@@ -94,7 +97,10 @@ func (w *Watcher) Poll(now time.Time) ([]string, error) {
 		if !state.Processed && now.Sub(state.LastChange) >= w.SettleFor {
 			state.Processed = true
 			w.states[path] = state
-			ready = append(ready, path)
+			// Boyut/süre kuralları dosya yazımı bittikten sonra probe ile kontrol edilir.
+			if w.Filter.MatchMedia(path) {
+				ready = append(ready, path)
+			}
 		}
 		return nil
 	})
@@ -120,29 +126,17 @@ func (w *Watcher) scan(onFile func(path string, info os.FileInfo) error) error {
 		return fmt.Errorf("watch yolu dizin olmalidir: %s", w.Root)
 	}
 
-	walkFn := func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return nil
-		}
-		if d.IsDir() {
-			if !w.Recursive && path != w.Root {
-				return filepath.SkipDir
-			}
-			return nil
-		}
+	return w.Filter.Walk(w.Root, w.Recursive, func(path, _ string, info os.FileInfo) error {
 		if !converter.HasFormatExtension(path, w.From) {
 			return nil
 		}
-		info, statErr := d.Info()
-		if statErr != nil {
-			return nil
-		}
 		return onFile(path, info)
-	}
-
-	return filepath.WalkDir(w.Root, walkFn)
+	})
 }
 
+// SetFilter dosya seçim kurallarını ayarlar.
+func (w *Watcher) SetFilter(f *filter.Filter) { w.Filter = f }
+
 // Events polling backend için event kanalı üretmez.
 func (w *Watcher) Events() <-chan struct{} { return nil }
 
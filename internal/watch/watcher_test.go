@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/mlihgenel/fileconverter-cli/internal/filter"
 )
 
 func TestWatcherBootstrapAndPoll(t *testing.T) {
@@ -91,3 +93,38 @@ func TestWatcherDetectsModifiedFile(t *testing.T) {
 		t.Fatalf("expected modified file ready once, got: %#v", ready)
 	}
 }
+
+func TestWatcherAppliesFilter(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".fileconverterignore"), []byte("tmp_*\n"), 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	w := NewWatcher(dir, "jpg", false, 100*time.Millisecond)
+	f, err := filter.Compile(filter.Spec{Exclude: []string{"*_thumb.jpg"}})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	w.SetFilter(f)
+	if err := w.Bootstrap(); err != nil {
+		t.Fatalf("bootstrap failed: %v", err)
+	}
+
+	for _, name := range []string{"photo.jpg", "photo_thumb.jpg", "tmp_upload.jpg", ".partial.jpg"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+
+	now := time.Now()
+	if _, err := w.Poll(now); err != nil {
+		t.Fatalf("poll failed: %v", err)
+	}
+	ready, err := w.Poll(now.Add(200 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("poll failed: %v", err)
+	}
+	if len(ready) != 1 || filepath.Base(ready[0]) != "photo.jpg" {
+		t.Fatalf("expected only photo.jpg to be ready, got %v", ready)
+	}
+}